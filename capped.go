@@ -0,0 +1,63 @@
+package storable
+
+import (
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// CappedOptions are the limits of a capped collection, once a limit is
+// reached the oldest documents are removed to make room for the new ones.
+type CappedOptions struct {
+	// MaxBytes is the maximum size of the collection in bytes, required.
+	MaxBytes int
+	// MaxDocs is the maximum number of documents, zero means no limit.
+	MaxDocs int
+}
+
+// CreateCapped creates the collection as a capped collection, capped
+// collections keep the insertion order and can be tailed with Store.Tail.
+func (s *Store) CreateCapped(opts CappedOptions) error {
+	sess, c := s.getSessionAndCollection()
+	defer sess.Close()
+
+	return c.Create(&mgo.CollectionInfo{
+		Capped:   true,
+		MaxBytes: opts.MaxBytes,
+		MaxDocs:  opts.MaxDocs,
+	})
+}
+
+// ConvertToCapped converts the existing collection into a capped collection,
+// the oldest documents are removed if they don't fit. The MaxDocs limit
+// requires MongoDB 6.0 or above when converting.
+func (s *Store) ConvertToCapped(opts CappedOptions) error {
+	sess, c := s.getSessionAndCollection()
+	defer sess.Close()
+
+	err := c.Database.Run(bson.D{
+		{Name: "convertToCapped", Value: c.Name},
+		{Name: "size", Value: opts.MaxBytes},
+	}, nil)
+
+	if err != nil || opts.MaxDocs == 0 {
+		return err
+	}
+
+	return c.Database.Run(bson.D{
+		{Name: "collMod", Value: c.Name},
+		{Name: "cappedMax", Value: opts.MaxDocs},
+	}, nil)
+}
+
+// IsCapped returns true if the collection is a capped collection.
+func (s *Store) IsCapped() (bool, error) {
+	sess, c := s.getSessionAndCollection()
+	defer sess.Close()
+
+	var res struct {
+		Capped bool `bson:"capped"`
+	}
+
+	err := c.Database.Run(bson.D{{Name: "collStats", Value: c.Name}}, &res)
+	return res.Capped, err
+}
@@ -0,0 +1,737 @@
+package storable
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+var (
+	// ErrChangeStreamClosed is returned when a closed ChangeStream is used
+	ErrChangeStreamClosed = errors.New("closed change stream")
+	// ErrOplogNotFound is returned when the polled oplog does not exist, as
+	// happens on standalone servers, see Store.EnableOplog.
+	ErrOplogNotFound = errors.New("Cannot poll a non existent oplog.")
+)
+
+// OperationType is the kind of write reported by a ChangeEvent.
+type OperationType string
+
+const (
+	InsertOperation  OperationType = "insert"
+	UpdateOperation  OperationType = "update"
+	ReplaceOperation OperationType = "replace"
+	DeleteOperation  OperationType = "delete"
+)
+
+const (
+	// DefaultOplogDatabase is the database of the oplog polled when change
+	// streams are not available.
+	DefaultOplogDatabase = "local"
+	// DefaultOplogCollection is the collection of the oplog polled when change
+	// streams are not available.
+	DefaultOplogCollection = "oplog.rs"
+	// DefaultPollInterval is the time waited between polls of the oplog.
+	DefaultPollInterval = time.Second
+)
+
+// changeStreamNotSupported are the error codes returned by the servers
+// without change streams: standalone servers and servers older than 3.6.
+var changeStreamNotSupported = map[int]bool{
+	40573: true, // $changeStream is only supported on replica sets
+	40324: true, // unrecognized pipeline stage name
+	16436: true, // unrecognized pipeline stage name, before 3.4
+}
+
+// ResumeToken identifies an event of a ChangeStream, a stream started with
+// WatchOptions.ResumeAfter returns the events following the given one. It
+// can be stored as any other document.
+type ResumeToken bson.M
+
+// WatchOptions are the options of Store.Watch.
+type WatchOptions struct {
+	// ResumeAfter resumes the stream after the event with the given token.
+	ResumeAfter ResumeToken
+	// FullDocument retrieves the current version of the document on the
+	// update events, by default only the changed fields are returned.
+	FullDocument bool
+	// BatchSize is the number of events returned on each batch, zero means
+	// the default batch size.
+	BatchSize int
+	// Polling forces the polling of the oplog, instead of being used only
+	// when the server does not support change streams.
+	Polling bool
+	// OplogDatabase and OplogCollection are the oplog-like collection polled
+	// when change streams are not available, by default local.oplog.rs or
+	// the oplog of the store if enabled, see Store.EnableOplog.
+	OplogDatabase, OplogCollection string
+	// PollInterval is the time waited between polls when no new events are
+	// found, by default DefaultPollInterval.
+	PollInterval time.Duration
+}
+
+// ChangeEvent is a write on the watched collection.
+type ChangeEvent struct {
+	// Token is the resume token of the event, see WatchOptions.ResumeAfter.
+	Token ResumeToken
+	// Operation is the kind of write.
+	Operation OperationType
+	// Id is the id of the document written.
+	Id interface{}
+	// UpdatedFields are the fields set by an update, by dotted path.
+	UpdatedFields bson.M
+	// RemovedFields are the fields removed by an update.
+	RemovedFields []string
+	// ClusterTime is the time of the write in the oplog.
+	ClusterTime bson.MongoTimestamp
+	// Document is the document after an insert or a replace, and after an
+	// update when WatchOptions.FullDocument is set. It is empty on deletes
+	// or when the document does not exist anymore.
+	Document bson.Raw
+}
+
+// HasDocument returns true if the event contains the document.
+func (e *ChangeEvent) HasDocument() bool {
+	return e.Document.Kind == 0x03 && len(e.Document.Data) > 5
+}
+
+// Decode unmarshals the document of the event into doc.
+func (e *ChangeEvent) Decode(doc interface{}) error {
+	if !e.HasDocument() {
+		return ErrNotFound
+	}
+
+	return e.Document.Unmarshal(doc)
+}
+
+// changeSource is the source of the events of a ChangeStream.
+type changeSource interface {
+	next(e *ChangeEvent) bool
+	err() error
+	close() error
+}
+
+// ChangeStream iterates over the writes on a collection, it is returned by
+// Store.Watch. Next blocks until a new event is available, Close can be
+// called from a different goroutine to stop it.
+type ChangeStream struct {
+	session *mgo.Session
+	source  changeSource
+	token   ResumeToken
+	polling bool
+
+	m      sync.Mutex
+	closed bool
+}
+
+// Next waits for the next event and unmarshals it into e, it returns false
+// when the stream is closed or fails, see Err.
+func (cs *ChangeStream) Next(e *ChangeEvent) bool {
+	if cs.isClosed() {
+		return false
+	}
+
+	if !cs.source.next(e) {
+		return false
+	}
+
+	cs.token = e.Token
+	return true
+}
+
+// Err returns the error of the stream, if any.
+func (cs *ChangeStream) Err() error {
+	if cs.isClosed() {
+		return nil
+	}
+
+	return cs.source.err()
+}
+
+// ResumeToken returns the token of the last event returned by Next.
+func (cs *ChangeStream) ResumeToken() ResumeToken {
+	return cs.token
+}
+
+// IsPolling returns true if the events are read from the oplog, instead of
+// a change stream.
+func (cs *ChangeStream) IsPolling() bool {
+	return cs.polling
+}
+
+// Close stops the stream and releases its resources.
+func (cs *ChangeStream) Close() error {
+	cs.m.Lock()
+	defer cs.m.Unlock()
+	if cs.closed {
+		return ErrChangeStreamClosed
+	}
+
+	cs.closed = true
+	err := cs.source.close()
+	cs.session.Close()
+
+	return err
+}
+
+func (cs *ChangeStream) isClosed() bool {
+	cs.m.Lock()
+	defer cs.m.Unlock()
+
+	return cs.closed
+}
+
+// Watch returns a ChangeStream with the inserts, updates, replaces and
+// deletes of the documents matching the criteria of the query, the deletes
+// are always returned since the deleted document cannot be matched. The
+// stream starts at the current time, unless WatchOptions.ResumeAfter is set.
+//
+// The change streams require a replica set with MongoDB 3.6 or above, when
+// they are not supported the stream falls back to polling the oplog. The
+// stores with the oplog enabled always poll it, see Store.EnableOplog.
+//
+// When polling, the inserts and replaces are matched with the document of the
+// event, and the updates with operators with its postImage, written by the
+// stores with the oplog enabled. The updates of the oplog of the server have
+// no postImage, they are matched with the current version of the document,
+// so they are only returned if the document still matches.
+func (s *Store) Watch(q Query, opts WatchOptions) (*ChangeStream, error) {
+	if err := s.validate(q); err != nil {
+		return nil, err
+	}
+
+	q = s.scope(q)
+
+	if s.oplogCollection != "" && opts.OplogCollection == "" {
+		opts.Polling = true
+		opts.OplogDatabase, opts.OplogCollection = s.oplogDatabase, s.oplogCollection
+	}
+
+	sess, c := s.getSessionAndCollection()
+	cs := &ChangeStream{session: sess, token: opts.ResumeAfter}
+
+	_, resumePoll := opts.ResumeAfter["ts"]
+	if !opts.Polling && !resumePoll {
+		iter, err := changeStreamCmd(c, q, opts)
+		if err == nil {
+			cs.source = &streamSource{iter: iter}
+			return cs, nil
+		}
+
+		if qerr, ok := err.(*mgo.QueryError); !ok || !changeStreamNotSupported[qerr.Code] {
+			sess.Close()
+			return nil, err
+		}
+	}
+
+	source, err := newPollSource(c, q, opts)
+	if err != nil {
+		sess.Close()
+		return nil, err
+	}
+
+	cs.source, cs.polling = source, true
+	return cs, nil
+}
+
+// changeStreamCmd runs an aggregation with a $changeStream stage, followed by
+// a $match stage with the query criteria applied to the full document.
+func changeStreamCmd(c *mgo.Collection, q Query, opts WatchOptions) (*mgo.Iter, error) {
+	stage := bson.M{}
+	if opts.ResumeAfter != nil {
+		stage["resumeAfter"] = bson.M(opts.ResumeAfter)
+	}
+
+	// the criteria is matched against the full document, not available on
+	// the update events without the lookup
+	criteria := q.GetCriteria()
+	if opts.FullDocument || criteria != nil {
+		stage["fullDocument"] = "updateLookup"
+	}
+
+	pipeline := []bson.M{{"$changeStream": stage}}
+	if criteria != nil {
+		pipeline = append(pipeline, bson.M{"$match": bson.M{"$or": []bson.M{
+			{"operationType": DeleteOperation},
+			prefixCriteria(criteria, "fullDocument."),
+		}}})
+	}
+
+	cursor := bson.M{}
+	if opts.BatchSize != 0 {
+		cursor["batchSize"] = opts.BatchSize
+	}
+
+	cmd := bson.D{
+		{Name: "aggregate", Value: c.Name},
+		{Name: "pipeline", Value: pipeline},
+		{Name: "cursor", Value: cursor},
+	}
+
+	if q.GetCollation() != nil {
+		cmd = append(cmd, bson.DocElem{Name: "collation", Value: q.GetCollation()})
+	}
+
+	var res struct {
+		Cursor struct {
+			FirstBatch []bson.Raw `bson:"firstBatch"`
+			Id         int64      `bson:"id"`
+		} `bson:"cursor"`
+	}
+
+	if err := c.Database.Run(cmd, &res); err != nil {
+		return nil, err
+	}
+
+	return c.NewIter(c.Database.Session, res.Cursor.FirstBatch, res.Cursor.Id, nil), nil
+}
+
+// prefixCriteria returns a copy of the criteria with the given prefix on the
+// field names, the logical operators are followed.
+func prefixCriteria(criteria bson.M, prefix string) bson.M {
+	r := make(bson.M, len(criteria))
+	for k, v := range criteria {
+		switch {
+		case k == "$and" || k == "$or" || k == "$nor":
+			r[k] = prefixClauses(v, prefix)
+		case strings.HasPrefix(k, "$"):
+			r[k] = v
+		default:
+			r[prefix+k] = v
+		}
+	}
+
+	return r
+}
+
+func prefixClauses(v interface{}, prefix string) interface{} {
+	switch clauses := v.(type) {
+	case []bson.M:
+		r := make([]bson.M, len(clauses))
+		for i, c := range clauses {
+			r[i] = prefixCriteria(c, prefix)
+		}
+
+		return r
+	case []interface{}:
+		r := make([]interface{}, len(clauses))
+		for i, c := range clauses {
+			if m, ok := c.(bson.M); ok {
+				r[i] = prefixCriteria(m, prefix)
+			} else {
+				r[i] = c
+			}
+		}
+
+		return r
+	}
+
+	return v
+}
+
+type rawChangeEvent struct {
+	Id            bson.M              `bson:"_id"`
+	OperationType OperationType       `bson:"operationType"`
+	ClusterTime   bson.MongoTimestamp `bson:"clusterTime"`
+	DocumentKey   struct {
+		Id interface{} `bson:"_id"`
+	} `bson:"documentKey"`
+	FullDocument      bson.Raw `bson:"fullDocument"`
+	UpdateDescription struct {
+		UpdatedFields bson.M   `bson:"updatedFields"`
+		RemovedFields []string `bson:"removedFields"`
+	} `bson:"updateDescription"`
+}
+
+// streamSource reads the events from a change stream cursor.
+type streamSource struct {
+	iter *mgo.Iter
+}
+
+func (s *streamSource) next(e *ChangeEvent) bool {
+	var raw rawChangeEvent
+	if !s.iter.Next(&raw) {
+		return false
+	}
+
+	*e = ChangeEvent{
+		Token:         ResumeToken(raw.Id),
+		Operation:     raw.OperationType,
+		Id:            raw.DocumentKey.Id,
+		UpdatedFields: raw.UpdateDescription.UpdatedFields,
+		RemovedFields: raw.UpdateDescription.RemovedFields,
+		ClusterTime:   raw.ClusterTime,
+		Document:      raw.FullDocument,
+	}
+
+	return true
+}
+
+func (s *streamSource) err() error {
+	return s.iter.Err()
+}
+
+func (s *streamSource) close() error {
+	return s.iter.Close()
+}
+
+type oplogEntry struct {
+	Ts bson.MongoTimestamp `bson:"ts"`
+	Op string              `bson:"op"`
+	Ns string              `bson:"ns"`
+	O  bson.Raw            `bson:"o"`
+	O2 struct {
+		Id interface{} `bson:"_id"`
+	} `bson:"o2"`
+	// PostImage is the document after an update with operators, only on the
+	// entries written by the stores, see Store.EnableOplog.
+	PostImage bson.Raw `bson:"postImage"`
+
+	// Tx is true on the entries unpacked from an applyOps, the writes of a
+	// transaction, all of them sharing the ts. Index is its position.
+	Tx    bool `bson:"-"`
+	Index int  `bson:"-"`
+}
+
+// token returns the resume token of the entry.
+func (e *oplogEntry) token() ResumeToken {
+	if e.Tx {
+		return ResumeToken{"ts": e.Ts, "i": e.Index}
+	}
+
+	return ResumeToken{"ts": e.Ts}
+}
+
+type applyOps struct {
+	ApplyOps []oplogEntry `bson:"applyOps"`
+}
+
+// pollSource reads the events polling an oplog-like collection, with the
+// format of local.oplog.rs: ts, op (i, u, d or c), ns, o and o2. The applyOps
+// commands, used by the transactions, are unpacked.
+type pollSource struct {
+	collection *mgo.Collection
+	oplog      *mgo.Collection
+	criteria   bson.M
+	opts       WatchOptions
+	last       bson.MongoTimestamp
+	// skip is the index of the last entry returned of the applyOps at last,
+	// -1 if none
+	skip    int
+	pending []oplogEntry
+	lastErr error
+	done    chan struct{}
+	once    sync.Once
+}
+
+func newPollSource(c *mgo.Collection, q Query, opts WatchOptions) (*pollSource, error) {
+	if opts.OplogDatabase == "" {
+		opts.OplogDatabase = DefaultOplogDatabase
+	}
+
+	if opts.OplogCollection == "" {
+		opts.OplogCollection = DefaultOplogCollection
+	}
+
+	if opts.PollInterval == 0 {
+		opts.PollInterval = DefaultPollInterval
+	}
+
+	p := &pollSource{
+		collection: c,
+		oplog:      c.Database.Session.DB(opts.OplogDatabase).C(opts.OplogCollection),
+		criteria:   q.GetCriteria(),
+		opts:       opts,
+		skip:       -1,
+		done:       make(chan struct{}),
+	}
+
+	names, err := p.oplog.Database.CollectionNames()
+	if err != nil {
+		return nil, err
+	}
+
+	if !containsString(names, p.oplog.Name) {
+		return nil, ErrOplogNotFound
+	}
+
+	if ts, ok := opts.ResumeAfter["ts"].(bson.MongoTimestamp); ok {
+		p.last = ts
+		if i, ok := opts.ResumeAfter["i"].(int); ok {
+			p.skip = i
+		}
+
+		return p, nil
+	}
+
+	var last oplogEntry
+	err = p.oplog.Find(nil).Sort("-$natural").Select(bson.M{"ts": 1}).One(&last)
+	if err != nil && err != mgo.ErrNotFound {
+		return nil, err
+	}
+
+	p.last = last.Ts
+	return p, nil
+}
+
+func (p *pollSource) next(e *ChangeEvent) bool {
+	for {
+		for len(p.pending) != 0 {
+			entry := p.pending[0]
+			p.pending = p.pending[1:]
+			p.last, p.skip = entry.Ts, -1
+			if entry.Tx {
+				p.skip = entry.Index
+			}
+
+			ok, err := p.event(&entry, e)
+			if err != nil {
+				p.lastErr = err
+				return false
+			}
+
+			if ok {
+				return true
+			}
+		}
+
+		if err := p.poll(); err != nil {
+			p.lastErr = err
+			return false
+		}
+
+		if len(p.pending) != 0 {
+			continue
+		}
+
+		select {
+		case <-p.done:
+			return false
+		case <-time.After(p.opts.PollInterval):
+		}
+	}
+}
+
+func (p *pollSource) poll() error {
+	// the rest of a partially returned applyOps is read again
+	ts := bson.M{"$gt": p.last}
+	if p.skip >= 0 {
+		ts = bson.M{"$gte": p.last}
+	}
+
+	q := p.oplog.Find(bson.M{
+		"ts": ts,
+		"$or": []bson.M{
+			{"ns": p.collection.FullName, "op": bson.M{"$in": []string{"i", "u", "d"}}},
+			{"op": "c", "o.applyOps.ns": p.collection.FullName},
+		},
+	}).Sort("$natural")
+
+	if p.opts.BatchSize != 0 {
+		q.Limit(p.opts.BatchSize)
+	}
+
+	var entries []oplogEntry
+	if err := q.All(&entries); err != nil {
+		return err
+	}
+
+	var err error
+	p.pending, err = p.unpack(entries)
+	return err
+}
+
+// unpack returns the entries of the collection, replacing the applyOps by the
+// writes they contain. The writes already returned are skipped.
+func (p *pollSource) unpack(entries []oplogEntry) ([]oplogEntry, error) {
+	var result []oplogEntry
+	for _, entry := range entries {
+		if entry.Op != "c" {
+			if entry.Ts != p.last || p.skip < 0 {
+				result = append(result, entry)
+			}
+
+			continue
+		}
+
+		var cmd applyOps
+		if err := entry.O.Unmarshal(&cmd); err != nil {
+			return nil, err
+		}
+
+		for i, op := range cmd.ApplyOps {
+			if op.Ns != p.collection.FullName || entry.Ts == p.last && i <= p.skip {
+				continue
+			}
+
+			op.Ts, op.Tx, op.Index = entry.Ts, true, i
+			result = append(result, op)
+		}
+	}
+
+	return result, nil
+}
+
+// event converts an oplog entry into a ChangeEvent, false is returned if
+// the document does not match the criteria.
+func (p *pollSource) event(entry *oplogEntry, e *ChangeEvent) (bool, error) {
+	*e = ChangeEvent{
+		Token:       entry.token(),
+		ClusterTime: entry.Ts,
+	}
+
+	var o bson.M
+	if err := entry.O.Unmarshal(&o); err != nil {
+		return false, err
+	}
+
+	switch entry.Op {
+	case "i":
+		e.Operation, e.Id, e.Document = InsertOperation, o["_id"], entry.O
+	case "d":
+		e.Operation, e.Id = DeleteOperation, o["_id"]
+		return true, nil
+	case "u":
+		e.Id = entry.O2.Id
+		if !isUpdateDoc(o) {
+			e.Operation, e.Document = ReplaceOperation, entry.O
+			break
+		}
+
+		e.Operation = UpdateOperation
+		e.UpdatedFields, e.RemovedFields = updateDescription(o)
+		if entry.PostImage.Kind == 0x03 {
+			if p.opts.FullDocument {
+				e.Document = entry.PostImage
+			}
+
+			return p.matchesImage(entry.PostImage)
+		}
+
+		if p.opts.FullDocument {
+			if err := p.lookup(e); err != nil {
+				return false, err
+			}
+		}
+
+		// the update alone cannot be matched, without postImage the current
+		// version of the document is matched
+		return p.matches(e.Id)
+	}
+
+	return p.matchesImage(entry.O)
+}
+
+func (p *pollSource) lookup(e *ChangeEvent) error {
+	err := p.collection.FindId(e.Id).One(&e.Document)
+	if err == mgo.ErrNotFound {
+		return nil
+	}
+
+	return err
+}
+
+// matches returns true if the current version of the document matches the
+// criteria.
+func (p *pollSource) matches(id interface{}) (bool, error) {
+	if p.criteria == nil {
+		return true, nil
+	}
+
+	n, err := p.collection.Find(bson.M{
+		"$and": []bson.M{{"_id": id}, p.criteria},
+	}).Count()
+
+	return n != 0, err
+}
+
+// matchesImage returns true if the given version of a document matches the
+// criteria, matched by the server replacing a document of the oplog.
+func (p *pollSource) matchesImage(image bson.Raw) (bool, error) {
+	if p.criteria == nil {
+		return true, nil
+	}
+
+	var docs []bson.Raw
+	err := p.oplog.Pipe([]bson.M{
+		{"$limit": 1},
+		{"$replaceRoot": bson.M{"newRoot": bson.M{"$literal": image}}},
+		{"$match": p.criteria},
+	}).All(&docs)
+
+	return len(docs) != 0, err
+}
+
+func (p *pollSource) err() error {
+	return p.lastErr
+}
+
+func (p *pollSource) close() error {
+	p.once.Do(func() { close(p.done) })
+	return nil
+}
+
+func isUpdateDoc(o bson.M) bool {
+	for k := range o {
+		if strings.HasPrefix(k, "$") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// updateDescription returns the updated and removed fields of an oplog
+// update, both the $set/$unset format and the diff format of MongoDB 5.0
+// are supported.
+func updateDescription(o bson.M) (updated bson.M, removed []string) {
+	updated = bson.M{}
+	if diff, ok := o["diff"].(bson.M); ok {
+		if u, ok := diff["u"].(bson.M); ok {
+			updated = u
+		}
+
+		if i, ok := diff["i"].(bson.M); ok {
+			for k, v := range i {
+				updated[k] = v
+			}
+		}
+
+		if d, ok := diff["d"].(bson.M); ok {
+			for k := range d {
+				removed = append(removed, k)
+			}
+		}
+
+		sort.Strings(removed)
+		return
+	}
+
+	if set, ok := o["$set"].(bson.M); ok {
+		updated = set
+	}
+
+	if unset, ok := o["$unset"].(bson.M); ok {
+		for k := range unset {
+			removed = append(removed, k)
+		}
+	}
+
+	sort.Strings(removed)
+	return
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
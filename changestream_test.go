@@ -0,0 +1,120 @@
+package storable
+
+import (
+	. "gopkg.in/check.v1"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+func (s *BaseSuite) TestPrefixCriteria(c *C) {
+	criteria := bson.M{
+		"foo":    "foo",
+		"$and":   []bson.M{{"qux": 1}, {"$or": []interface{}{bson.M{"bar": 2}}}},
+		"$where": "true",
+	}
+
+	c.Assert(prefixCriteria(criteria, "fullDocument."), DeepEquals, bson.M{
+		"fullDocument.foo": "foo",
+		"$and": []bson.M{
+			{"fullDocument.qux": 1},
+			{"$or": []interface{}{bson.M{"fullDocument.bar": 2}}},
+		},
+		"$where": "true",
+	})
+}
+
+func (s *BaseSuite) TestUpdateDescription(c *C) {
+	updated, removed := updateDescription(bson.M{
+		"$set":   bson.M{"foo": 1},
+		"$unset": bson.M{"qux": true, "bar": true},
+	})
+
+	c.Assert(updated, DeepEquals, bson.M{"foo": 1})
+	c.Assert(removed, DeepEquals, []string{"bar", "qux"})
+
+	updated, removed = updateDescription(bson.M{
+		"$v":   2,
+		"diff": bson.M{"u": bson.M{"foo": 1}, "i": bson.M{"bar": 2}, "d": bson.M{"qux": false}},
+	})
+
+	c.Assert(updated, DeepEquals, bson.M{"foo": 1, "bar": 2})
+	c.Assert(removed, DeepEquals, []string{"qux"})
+}
+
+func (s *BaseSuite) TestPollSource_Event(c *C) {
+	p := &pollSource{}
+	id := bson.NewObjectId()
+
+	var e ChangeEvent
+	entry := &oplogEntry{Ts: 42, Op: "u"}
+	entry.O = rawDoc(c, bson.M{"_id": id, "foo": "foo"})
+	entry.O2.Id = id
+
+	ok, err := p.event(entry, &e)
+	c.Assert(err, IsNil)
+	c.Assert(ok, Equals, true)
+	c.Assert(e.Operation, Equals, ReplaceOperation)
+	c.Assert(e.Id, Equals, id)
+	c.Assert(e.Token, DeepEquals, ResumeToken{"ts": bson.MongoTimestamp(42)})
+
+	var doc struct{ Foo string }
+	c.Assert(e.Decode(&doc), IsNil)
+	c.Assert(doc.Foo, Equals, "foo")
+
+	entry = &oplogEntry{Ts: 43, Op: "d", O: rawDoc(c, bson.M{"_id": id})}
+	ok, err = p.event(entry, &e)
+	c.Assert(err, IsNil)
+	c.Assert(ok, Equals, true)
+	c.Assert(e.Operation, Equals, DeleteOperation)
+	c.Assert(e.HasDocument(), Equals, false)
+	c.Assert(e.Decode(&doc), Equals, ErrNotFound)
+
+	p.opts.FullDocument = true
+	entry = &oplogEntry{Ts: 44, Op: "u"}
+	entry.O = rawDoc(c, bson.M{"$set": bson.M{"foo": "qux"}})
+	entry.O2.Id = id
+	entry.PostImage = rawDoc(c, bson.M{"_id": id, "foo": "qux"})
+
+	ok, err = p.event(entry, &e)
+	c.Assert(err, IsNil)
+	c.Assert(ok, Equals, true)
+	c.Assert(e.Operation, Equals, UpdateOperation)
+	c.Assert(e.Decode(&doc), IsNil)
+	c.Assert(doc.Foo, Equals, "qux")
+}
+
+func (s *BaseSuite) TestPollSource_Unpack(c *C) {
+	p := &pollSource{collection: &mgo.Collection{FullName: "db.foo"}, skip: -1}
+	foo, bar := bson.NewObjectId(), bson.NewObjectId()
+
+	entries := []oplogEntry{
+		{Ts: 1, Op: "i", Ns: "db.foo", O: rawDoc(c, bson.M{"_id": foo})},
+		{Ts: 2, Op: "c", Ns: "admin.$cmd", O: rawDoc(c, bson.M{"applyOps": []bson.M{
+			{"op": "i", "ns": "db.foo", "o": bson.M{"_id": bar}},
+			{"op": "i", "ns": "db.qux", "o": bson.M{"_id": bar}},
+			{"op": "d", "ns": "db.foo", "o": bson.M{"_id": foo}},
+		}})},
+	}
+
+	result, err := p.unpack(entries)
+	c.Assert(err, IsNil)
+	c.Assert(result, HasLen, 3)
+	c.Assert(result[0].token(), DeepEquals, ResumeToken{"ts": bson.MongoTimestamp(1)})
+	c.Assert(result[1].Op, Equals, "i")
+	c.Assert(result[1].token(), DeepEquals, ResumeToken{"ts": bson.MongoTimestamp(2), "i": 0})
+	c.Assert(result[2].Op, Equals, "d")
+	c.Assert(result[2].token(), DeepEquals, ResumeToken{"ts": bson.MongoTimestamp(2), "i": 2})
+
+	p.last, p.skip = 2, 0
+	result, err = p.unpack(entries[1:])
+	c.Assert(err, IsNil)
+	c.Assert(result, HasLen, 1)
+	c.Assert(result[0].Index, Equals, 2)
+}
+
+func rawDoc(c *C, doc bson.M) bson.Raw {
+	data, err := bson.Marshal(doc)
+	c.Assert(err, IsNil)
+
+	return bson.Raw{Kind: 0x03, Data: data}
+}
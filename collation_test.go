@@ -0,0 +1,112 @@
+package storable
+
+import (
+	. "gopkg.in/check.v1"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+var caseInsensitive = &mgo.Collation{Locale: "en", Strength: 2}
+
+func (s *BaseSuite) newCollationStore(c *C) *Store {
+	st := NewStore(s.db, "test")
+	c.Assert(st.EnsureIndex(mgo.Index{
+		Key:       []string{AscIndex.Key(NewField("firstname", "string"))},
+		Collation: caseInsensitive,
+	}), IsNil)
+
+	for _, name := range []string{"foo", "FOO", "bar", "item10", "item9"} {
+		c.Assert(st.Insert(NewPerson(name)), IsNil)
+	}
+
+	return st
+}
+
+func (s *BaseSuite) TestStore_FindCollation(c *C) {
+	st := s.newCollationStore(c)
+
+	q := NewBaseQuery()
+	q.AddCriteria(bson.M{"firstname": "Foo"})
+	q.Collation(caseInsensitive)
+
+	var result []*Person
+	c.Assert(st.MustFind(q).All(&result), IsNil)
+	c.Assert(result, HasLen, 2)
+
+	count, err := st.Count(q)
+	c.Assert(err, IsNil)
+	c.Assert(count, Equals, 2)
+}
+
+func (s *BaseSuite) TestStore_FindCollationNumericOrdering(c *C) {
+	st := s.newCollationStore(c)
+
+	q := NewBaseQuery()
+	q.AddCriteria(bson.M{"firstname": bson.M{"$regex": "^item"}})
+	q.Sort(Sort{{NewField("firstname", "string"), Asc}})
+	q.Collation(&mgo.Collation{Locale: "en", NumericOrdering: true})
+
+	rs := st.MustFind(q)
+
+	var p Person
+	found, err := rs.Next(&p)
+	c.Assert(err, IsNil)
+	c.Assert(found, Equals, true)
+	c.Assert(p.FirstName, Equals, "item9")
+	c.Assert(rs.Close(), IsNil)
+}
+
+func (s *BaseSuite) TestStore_DistinctCollation(c *C) {
+	st := s.newCollationStore(c)
+
+	q := NewBaseQuery()
+	q.AddCriteria(bson.M{"firstname": bson.M{"$in": []string{"foo", "bar"}}})
+
+	var names []string
+	c.Assert(st.Distinct(q, NewField("firstname", "string"), &names), IsNil)
+	c.Assert(names, HasLen, 2)
+
+	// FOO is matched too, but is not distinct from foo
+	q.Collation(caseInsensitive)
+	c.Assert(st.Distinct(q, NewField("firstname", "string"), &names), IsNil)
+	c.Assert(names, HasLen, 2)
+}
+
+func (s *BaseSuite) TestStore_RawUpdateAndDeleteCollation(c *C) {
+	st := s.newCollationStore(c)
+
+	q := NewBaseQuery()
+	q.AddCriteria(bson.M{"firstname": "Foo"})
+	q.Collation(caseInsensitive)
+
+	c.Assert(st.RawUpdate(q, bson.M{"lastname": "qux"}, true), IsNil)
+
+	lastname := NewBaseQuery()
+	lastname.AddCriteria(bson.M{"lastname": "qux"})
+	c.Assert(st.MustCount(lastname), Equals, 2)
+
+	c.Assert(st.RawDelete(q, false), IsNil)
+	c.Assert(st.MustCount(lastname), Equals, 1)
+
+	q = NewBaseQuery()
+	q.AddCriteria(bson.M{"firstname": "qux"})
+	q.Collation(caseInsensitive)
+	c.Assert(st.RawDelete(q, false), Equals, mgo.ErrNotFound)
+}
+
+func (s *BaseSuite) TestStore_FindAndModifyCollation(c *C) {
+	st := s.newCollationStore(c)
+
+	q := NewBaseQuery()
+	q.AddCriteria(bson.M{"firstname": "BAR"})
+	q.Collation(caseInsensitive)
+
+	var result *Person
+	info, err := st.FindAndModify(q, mgo.Change{Remove: true}, &result)
+	c.Assert(err, IsNil)
+	c.Assert(info.Removed, Equals, 1)
+	c.Assert(result.FirstName, Equals, "bar")
+
+	_, err = st.FindAndModify(q, mgo.Change{Remove: true}, &result)
+	c.Assert(err, Equals, ErrNotFound)
+}
@@ -0,0 +1,389 @@
+package storable
+
+import (
+	"time"
+
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// mgo.Query does not support collations nor transactions, the queries with
+// collation and the operations inside a transaction are executed using the
+// database commands instead.
+
+// runCmd runs the given command on the database of the collection, inside the
+// given transaction if any.
+func runCmd(c *mgo.Collection, tx *Tx, cmd bson.D, result interface{}) error {
+	if tx != nil {
+		return tx.run(c.Database, cmd, result)
+	}
+
+	return c.Database.Run(cmd, result)
+}
+
+// findCmd is a find command, used by ResultSet instead of mgo.Query.
+type findCmd struct {
+	collection *mgo.Collection
+	query      Query
+	tx         *Tx
+}
+
+// doc returns the find command document, also used by Store.Explain.
+func (f *findCmd) doc() bson.D {
+	q := f.query
+	cmd := bson.D{
+		{Name: "find", Value: f.collection.Name},
+		{Name: "filter", Value: criteriaOrEmpty(q)},
+	}
+
+	if q.GetCollation() != nil {
+		cmd = append(cmd, bson.DocElem{Name: "collation", Value: q.GetCollation()})
+	}
+
+	if !q.GetSort().IsEmpty() {
+		cmd = append(cmd, bson.DocElem{Name: "sort", Value: q.GetSort().toDoc()})
+	}
+
+	if p := q.GetProjection(); p != nil {
+		cmd = append(cmd, bson.DocElem{Name: "projection", Value: p})
+	}
+
+	if q.GetSkip() != 0 {
+		cmd = append(cmd, bson.DocElem{Name: "skip", Value: q.GetSkip()})
+	}
+
+	if q.GetLimit() != 0 {
+		cmd = append(cmd, bson.DocElem{Name: "limit", Value: q.GetLimit()})
+	}
+
+	opts := q.GetOptions()
+	if opts.BatchSize != 0 {
+		cmd = append(cmd, bson.DocElem{Name: "batchSize", Value: opts.BatchSize})
+	}
+
+	if opts.NoCursorTimeout {
+		cmd = append(cmd, bson.DocElem{Name: "noCursorTimeout", Value: true})
+	}
+
+	return append(cmd, optionsDoc(opts)...)
+}
+
+func (f *findCmd) iter() *mgo.Iter {
+	var res struct {
+		Cursor struct {
+			FirstBatch []bson.Raw `bson:"firstBatch"`
+			Id         int64      `bson:"id"`
+		} `bson:"cursor"`
+	}
+
+	err := runCmd(f.collection, f.tx, f.doc(), &res)
+	if err != nil || f.tx == nil {
+		return f.collection.NewIter(f.collection.Database.Session, res.Cursor.FirstBatch, res.Cursor.Id, err)
+	}
+
+	docs, err := f.getMore(res.Cursor.FirstBatch, res.Cursor.Id)
+	return f.collection.NewIter(f.collection.Database.Session, docs, 0, err)
+}
+
+// getMore reads the following batches of the cursor with the given id inside
+// the transaction, mgo does not send the transaction on its getMore. The
+// documents are appended to docs.
+func (f *findCmd) getMore(docs []bson.Raw, id int64) ([]bson.Raw, error) {
+	for id != 0 {
+		cmd := bson.D{
+			{Name: "getMore", Value: id},
+			{Name: "collection", Value: f.collection.Name},
+		}
+
+		if n := f.query.GetOptions().BatchSize; n != 0 {
+			cmd = append(cmd, bson.DocElem{Name: "batchSize", Value: n})
+		}
+
+		var res struct {
+			Cursor struct {
+				NextBatch []bson.Raw `bson:"nextBatch"`
+				Id        int64      `bson:"id"`
+			} `bson:"cursor"`
+		}
+
+		if err := f.tx.run(f.collection.Database, cmd, &res); err != nil {
+			return docs, err
+		}
+
+		docs, id = append(docs, res.Cursor.NextBatch...), res.Cursor.Id
+	}
+
+	return docs, nil
+}
+
+func (f *findCmd) count() (int, error) {
+	if f.tx != nil {
+		return f.countAggregate()
+	}
+
+	q := f.query
+	cmd := bson.D{
+		{Name: "count", Value: f.collection.Name},
+		{Name: "query", Value: criteriaOrEmpty(q)},
+		{Name: "skip", Value: q.GetSkip()},
+		{Name: "limit", Value: q.GetLimit()},
+	}
+
+	cmd = append(cmd, collationOption(q)...)
+	cmd = append(cmd, optionsDoc(q.GetOptions())...)
+
+	var res struct {
+		N int `bson:"n"`
+	}
+
+	err := f.collection.Database.Run(cmd, &res)
+	return res.N, err
+}
+
+// countAggregate counts using an aggregation, the count command is not
+// allowed inside a transaction.
+func (f *findCmd) countAggregate() (int, error) {
+	q := f.query
+	pipeline := []bson.M{{"$match": criteriaOrEmpty(q)}}
+	if q.GetSkip() != 0 {
+		pipeline = append(pipeline, bson.M{"$skip": q.GetSkip()})
+	}
+
+	if q.GetLimit() != 0 {
+		pipeline = append(pipeline, bson.M{"$limit": q.GetLimit()})
+	}
+
+	cmd := bson.D{
+		{Name: "aggregate", Value: f.collection.Name},
+		{Name: "pipeline", Value: append(pipeline, bson.M{"$count": "n"})},
+		{Name: "cursor", Value: bson.M{}},
+	}
+
+	cmd = append(cmd, collationOption(q)...)
+	cmd = append(cmd, optionsDoc(q.GetOptions())...)
+
+	var res struct {
+		Cursor struct {
+			FirstBatch []struct {
+				N int `bson:"n"`
+			} `bson:"firstBatch"`
+		} `bson:"cursor"`
+	}
+
+	if err := runCmd(f.collection, f.tx, cmd, &res); err != nil {
+		return 0, err
+	}
+
+	if len(res.Cursor.FirstBatch) == 0 {
+		return 0, nil
+	}
+
+	return res.Cursor.FirstBatch[0].N, nil
+}
+
+func distinctCmd(c *mgo.Collection, tx *Tx, q Query, key string, result interface{}) error {
+	cmd := bson.D{
+		{Name: "distinct", Value: c.Name},
+		{Name: "key", Value: key},
+		{Name: "query", Value: criteriaOrEmpty(q)},
+	}
+
+	cmd = append(cmd, collationOption(q)...)
+
+	var res struct {
+		Values bson.Raw `bson:"values"`
+	}
+
+	if err := runCmd(c, tx, cmd, &res); err != nil {
+		return err
+	}
+
+	return res.Values.Unmarshal(result)
+}
+
+func insertCmd(c *mgo.Collection, tx *Tx, doc interface{}) error {
+	cmd := bson.D{
+		{Name: "insert", Value: c.Name},
+		{Name: "documents", Value: []interface{}{doc}},
+	}
+
+	_, err := runWriteCmd(c, tx, cmd, true)
+	return err
+}
+
+func updateCmd(c *mgo.Collection, tx *Tx, q Query, update interface{}, multi bool) error {
+	stmt := bson.M{"q": criteriaOrEmpty(q), "u": update, "multi": multi}
+	if q.GetCollation() != nil {
+		stmt["collation"] = q.GetCollation()
+	}
+
+	cmd := bson.D{
+		{Name: "update", Value: c.Name},
+		{Name: "updates", Value: []bson.M{stmt}},
+	}
+
+	_, err := runWriteCmd(c, tx, cmd, multi)
+	return err
+}
+
+// upsertCmd replaces the document matching the query, inserting it if it
+// does not exist, returns true if the document existed.
+func upsertCmd(c *mgo.Collection, tx *Tx, q Query, doc interface{}) (bool, error) {
+	cmd := bson.D{
+		{Name: "update", Value: c.Name},
+		{Name: "updates", Value: []bson.M{{
+			"q":      criteriaOrEmpty(q),
+			"u":      doc,
+			"upsert": true,
+		}}},
+	}
+
+	res, err := runWriteCmd(c, tx, cmd, true)
+	if err != nil {
+		return false, err
+	}
+
+	return len(res.Upserted) == 0, nil
+}
+
+func deleteCmd(c *mgo.Collection, tx *Tx, q Query, multi bool) error {
+	limit := 1
+	if multi {
+		limit = 0
+	}
+
+	stmt := bson.M{"q": criteriaOrEmpty(q), "limit": limit}
+	if q.GetCollation() != nil {
+		stmt["collation"] = q.GetCollation()
+	}
+
+	cmd := bson.D{
+		{Name: "delete", Value: c.Name},
+		{Name: "deletes", Value: []bson.M{stmt}},
+	}
+
+	_, err := runWriteCmd(c, tx, cmd, multi)
+	return err
+}
+
+func findAndModifyCmd(c *mgo.Collection, tx *Tx, q Query, change mgo.Change, result interface{}) (*mgo.ChangeInfo, error) {
+	cmd := bson.D{
+		{Name: "findAndModify", Value: c.Name},
+		{Name: "query", Value: criteriaOrEmpty(q)},
+	}
+
+	cmd = append(cmd, collationOption(q)...)
+
+	if !q.GetSort().IsEmpty() {
+		cmd = append(cmd, bson.DocElem{Name: "sort", Value: q.GetSort().toDoc()})
+	}
+
+	if p := q.GetProjection(); p != nil {
+		cmd = append(cmd, bson.DocElem{Name: "fields", Value: p})
+	}
+
+	if change.Remove {
+		cmd = append(cmd, bson.DocElem{Name: "remove", Value: true})
+	} else {
+		cmd = append(cmd,
+			bson.DocElem{Name: "update", Value: change.Update},
+			bson.DocElem{Name: "new", Value: change.ReturnNew},
+			bson.DocElem{Name: "upsert", Value: change.Upsert},
+		)
+	}
+
+	var res struct {
+		Value     bson.Raw `bson:"value"`
+		LastError struct {
+			N               int         `bson:"n"`
+			UpdatedExisting bool        `bson:"updatedExisting"`
+			Upserted        interface{} `bson:"upserted"`
+		} `bson:"lastErrorObject"`
+	}
+
+	if err := runCmd(c, tx, cmd, &res); err != nil {
+		return nil, err
+	}
+
+	info := &mgo.ChangeInfo{Matched: res.LastError.N, UpsertedId: res.LastError.Upserted}
+	if change.Remove {
+		info.Removed = info.Matched
+	} else if res.LastError.UpdatedExisting {
+		info.Updated = info.Matched
+	}
+
+	if res.Value.Kind != 0x03 {
+		if info.Matched == 0 {
+			return nil, mgo.ErrNotFound
+		}
+
+		return info, nil
+	}
+
+	return info, res.Value.Unmarshal(result)
+}
+
+type writeResult struct {
+	N           int        `bson:"n"`
+	Upserted    []bson.Raw `bson:"upserted"`
+	WriteErrors []struct {
+		Code   int    `bson:"code"`
+		ErrMsg string `bson:"errmsg"`
+	} `bson:"writeErrors"`
+}
+
+// runWriteCmd runs a write command, as mgo does, mgo.ErrNotFound is returned
+// when a single document write does not match any document.
+func runWriteCmd(c *mgo.Collection, tx *Tx, cmd bson.D, multi bool) (*writeResult, error) {
+	var res writeResult
+	if err := runCmd(c, tx, cmd, &res); err != nil {
+		return nil, err
+	}
+
+	if len(res.WriteErrors) != 0 {
+		e := res.WriteErrors[0]
+		return nil, &mgo.QueryError{Code: e.Code, Message: e.ErrMsg}
+	}
+
+	if !multi && res.N == 0 {
+		return nil, mgo.ErrNotFound
+	}
+
+	return &res, nil
+}
+
+// optionsDoc returns the options shared by the find and count commands.
+func optionsDoc(opts QueryOptions) bson.D {
+	var d bson.D
+	if len(opts.Hint) != 0 {
+		d = append(d, bson.DocElem{Name: "hint", Value: indexKeyDoc(opts.Hint)})
+	}
+
+	if opts.MaxTime != 0 {
+		d = append(d, bson.DocElem{Name: "maxTimeMS", Value: int64(opts.MaxTime / time.Millisecond)})
+	}
+
+	if opts.Comment != "" {
+		d = append(d, bson.DocElem{Name: "comment", Value: opts.Comment})
+	}
+
+	return d
+}
+
+// collationOption returns the collation option of the query, if any.
+func collationOption(q Query) bson.D {
+	if q.GetCollation() == nil {
+		return nil
+	}
+
+	return bson.D{{Name: "collation", Value: q.GetCollation()}}
+}
+
+func criteriaOrEmpty(q Query) bson.M {
+	c := q.GetCriteria()
+	if c == nil {
+		return bson.M{}
+	}
+
+	return c
+}
@@ -26,6 +26,13 @@ func (f Field) String() string {
 	return f.bson
 }
 
+// Positional returns a Field to be used on a Select, projecting only the
+// first element of the array matching the query, using the positional $
+// operator: http://docs.mongodb.org/manual/reference/operator/projection/positional/
+func (f Field) Positional() Field {
+	return NewField(f.bson+".$", f.typ)
+}
+
 type Map struct {
 	bson string
 	typ  string
@@ -58,6 +65,9 @@ type Dir int
 const (
 	Asc  Dir = 1
 	Desc Dir = -1
+	// TextScore sorts by the relevance score of a $text search, the field is
+	// where the score is projected.
+	TextScore Dir = 2
 )
 
 type Sort []FieldSort
@@ -72,8 +82,11 @@ func (s Sort) ToList() []string {
 	var fields []string
 	for _, fs := range s {
 		f := ""
-		if fs.D == Desc {
+		switch fs.D {
+		case Desc:
 			f += "-"
+		case TextScore:
+			f += "$textScore:"
 		}
 
 		f += fs.F.String()
@@ -84,6 +97,22 @@ func (s Sort) ToList() []string {
 	return fields
 }
 
+// toDoc returns the sort as an ordered document, as is expected by the
+// commands.
+func (s Sort) toDoc() bson.D {
+	var d bson.D
+	for _, fs := range s {
+		var v interface{} = int(fs.D)
+		if fs.D == TextScore {
+			v = textScoreMeta()
+		}
+
+		d = append(d, bson.DocElem{Name: fs.F.String(), Value: v})
+	}
+
+	return d
+}
+
 // IsEmpty returns if this sort map is empty or not
 func (s Sort) IsEmpty() bool {
 	return len(s) == 0
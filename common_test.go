@@ -44,6 +44,9 @@ func (s *BaseSuite) TestSort_ToList(c *C) {
 
 	sort = Sort{{NewField("foo", ""), Asc}, {NewField("qux", ""), Desc}}
 	c.Assert(sort.ToList(), DeepEquals, []string{"foo", "-qux"})
+
+	sort = Sort{{NewField("score", ""), TextScore}}
+	c.Assert(sort.ToList(), DeepEquals, []string{"$textScore:score"})
 }
 
 func (s *BaseSuite) TestSort_IsEmpty(c *C) {
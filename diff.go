@@ -0,0 +1,211 @@
+package storable
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+
+	"gopkg.in/mgo.v2/bson"
+)
+
+// ChangeOp is the kind of a change, named as the operations of RFC 6902.
+type ChangeOp string
+
+const (
+	// AddOp is a path present only on the new document.
+	AddOp ChangeOp = "add"
+	// RemoveOp is a path present only on the old document.
+	RemoveOp ChangeOp = "remove"
+	// ReplaceOp is a path with a different value on each document.
+	ReplaceOp ChangeOp = "replace"
+)
+
+// Change is a change on a path between two versions of a document.
+type Change struct {
+	Op ChangeOp `bson:"op"`
+	// Path is the dotted BSON path of the change, as Field.String, the
+	// elements of the arrays are referred by its index, eg: "items.2.name".
+	Path string `bson:"path"`
+	// Old is the value on the old document, nil on AddOp.
+	Old interface{} `bson:"old,omitempty"`
+	// New is the value on the new document, nil on RemoveOp.
+	New interface{} `bson:"new,omitempty"`
+}
+
+// Pointer returns the path of the change as a JSON Pointer (RFC 6901), eg:
+// "/items/2/name".
+func (c *Change) Pointer() string {
+	parts := strings.Split(c.Path, ".")
+	for i, p := range parts {
+		p = strings.Replace(p, "~", "~0", -1)
+		parts[i] = strings.Replace(p, "/", "~1", -1)
+	}
+
+	return "/" + strings.Join(parts, "/")
+}
+
+// Changes is the list of changes between two versions of a document.
+type Changes []*Change
+
+// Diff returns the changes turning the old document into the new one,
+// comparing the documents as they are stored, so the inline fields are
+// flattened and the paths are the ones of the generated schema. Nested
+// documents and maps are compared key by key, arrays are compared element by
+// element when they keep or grow its length and replaced when they shrink.
+func Diff(old, new DocumentBase) (Changes, error) {
+	o, err := marshalDoc(old)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := marshalDoc(new)
+	if err != nil {
+		return nil, err
+	}
+
+	return diffDocs(nil, "", o, n), nil
+}
+
+// Paths returns the paths of the changes.
+func (cs Changes) Paths() []string {
+	paths := make([]string, len(cs))
+	for i, c := range cs {
+		paths[i] = c.Path
+	}
+
+	return paths
+}
+
+// Update returns the update applying the changes, using the $set and $unset
+// operators. An empty update is returned if there are no changes.
+func (cs Changes) Update() bson.M {
+	set, unset := bson.M{}, bson.M{}
+	for _, c := range cs {
+		if c.Op == RemoveOp {
+			unset[c.Path] = ""
+		} else {
+			set[c.Path] = c.New
+		}
+	}
+
+	update := bson.M{}
+	if len(set) != 0 {
+		update["$set"] = set
+	}
+
+	if len(unset) != 0 {
+		update["$unset"] = unset
+	}
+
+	return update
+}
+
+// MarshalJSONPatch returns the changes as a JSON Patch document (RFC 6902),
+// the values are encoded on the given Extended JSON mode:
+//
+//	[{"op":"replace","path":"/items/2/name","value":"foo"}]
+func (cs Changes) MarshalJSONPatch(mode ExtJSONMode) ([]byte, error) {
+	e := &extJSONEncoder{mode: mode}
+	e.buf.WriteString("[")
+	for i, c := range cs {
+		if i != 0 {
+			e.buf.WriteString(",")
+		}
+
+		e.buf.WriteString(`{"op":`)
+		e.writeString(string(c.Op))
+		e.buf.WriteString(`,"path":`)
+		e.writeString(c.Pointer())
+		if c.Op != RemoveOp {
+			e.buf.WriteString(`,"value":`)
+			if err := e.encode(c.New); err != nil {
+				return nil, err
+			}
+		}
+
+		e.buf.WriteString("}")
+	}
+
+	e.buf.WriteString("]")
+	return e.buf.Bytes(), nil
+}
+
+// marshalDoc returns the given document as is stored, the nested documents
+// are returned as bson.D and the arrays as []interface{}.
+func marshalDoc(doc interface{}) (bson.D, error) {
+	data, err := bson.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	var d bson.D
+	return d, bson.Unmarshal(data, &d)
+}
+
+func diffValues(cs Changes, path string, old, new interface{}) Changes {
+	switch o := old.(type) {
+	case bson.D:
+		if n, ok := new.(bson.D); ok {
+			return diffDocs(cs, path, o, n)
+		}
+	case []interface{}:
+		if n, ok := new.([]interface{}); ok {
+			return diffArrays(cs, path, o, n)
+		}
+	}
+
+	if !reflect.DeepEqual(old, new) {
+		cs = append(cs, &Change{Op: ReplaceOp, Path: path, Old: old, New: new})
+	}
+
+	return cs
+}
+
+func diffDocs(cs Changes, path string, old, new bson.D) Changes {
+	olds := old.Map()
+	for _, e := range new {
+		p := joinPath(path, e.Name)
+		if v, ok := olds[e.Name]; ok {
+			cs = diffValues(cs, p, v, e.Value)
+		} else {
+			cs = append(cs, &Change{Op: AddOp, Path: p, New: e.Value})
+		}
+	}
+
+	news := new.Map()
+	for _, e := range old {
+		if _, ok := news[e.Name]; !ok {
+			p := joinPath(path, e.Name)
+			cs = append(cs, &Change{Op: RemoveOp, Path: p, Old: e.Value})
+		}
+	}
+
+	return cs
+}
+
+func diffArrays(cs Changes, path string, old, new []interface{}) Changes {
+	// $unset leaves a null on the removed elements, so the whole array is
+	// replaced
+	if len(new) < len(old) {
+		return append(cs, &Change{Op: ReplaceOp, Path: path, Old: old, New: new})
+	}
+
+	for i, v := range old {
+		cs = diffValues(cs, joinPath(path, strconv.Itoa(i)), v, new[i])
+	}
+
+	for i := len(old); i < len(new); i++ {
+		p := joinPath(path, strconv.Itoa(i))
+		cs = append(cs, &Change{Op: AddOp, Path: p, New: new[i]})
+	}
+
+	return cs
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
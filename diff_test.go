@@ -0,0 +1,103 @@
+package storable
+
+import (
+	. "gopkg.in/check.v1"
+	"gopkg.in/mgo.v2/bson"
+)
+
+type diffFixture struct {
+	Document   `bson:",inline"`
+	Name       string
+	Nested     struct{ Foo, Bar string }
+	diffInline `bson:",inline"`
+	Items      []bson.M
+	Map        map[string]int `bson:",omitempty"`
+}
+
+type diffInline struct {
+	Qux int
+}
+
+func newDiffFixture() *diffFixture {
+	doc := &diffFixture{Name: "foo", Items: []bson.M{{"foo": 1}}}
+	doc.SetId(bson.NewObjectId())
+	doc.Nested.Foo = "foo"
+
+	return doc
+}
+
+func (s *BaseSuite) TestDiff(c *C) {
+	old := newDiffFixture()
+	old.Map = map[string]int{"foo": 1}
+
+	doc := *old
+	doc.Nested.Bar = "bar"
+	doc.Qux = 42
+	doc.Items = []bson.M{{"foo": 2}, {"bar": 1}}
+	doc.Map = nil
+
+	changes, err := Diff(old, &doc)
+	c.Assert(err, IsNil)
+	c.Assert(changes, DeepEquals, Changes{
+		{Op: ReplaceOp, Path: "nested.bar", Old: "", New: "bar"},
+		{Op: ReplaceOp, Path: "qux", Old: 0, New: 42},
+		{Op: ReplaceOp, Path: "items.0.foo", Old: 1, New: 2},
+		{Op: AddOp, Path: "items.1", New: bson.D{{Name: "bar", Value: 1}}},
+		{Op: RemoveOp, Path: "map", Old: bson.D{{Name: "foo", Value: 1}}},
+	})
+}
+
+func (s *BaseSuite) TestDiff_ShrinkArray(c *C) {
+	old := newDiffFixture()
+	doc := *old
+	doc.Items = []bson.M{}
+
+	changes, err := Diff(old, &doc)
+	c.Assert(err, IsNil)
+	c.Assert(changes.Paths(), DeepEquals, []string{"items"})
+	c.Assert(changes.Update(), DeepEquals, bson.M{
+		"$set": bson.M{"items": []interface{}{}},
+	})
+}
+
+func (s *BaseSuite) TestDiff_Equal(c *C) {
+	old := newDiffFixture()
+	doc := *old
+
+	changes, err := Diff(old, &doc)
+	c.Assert(err, IsNil)
+	c.Assert(changes, HasLen, 0)
+	c.Assert(changes.Update(), DeepEquals, bson.M{})
+}
+
+func (s *BaseSuite) TestChanges_Update(c *C) {
+	changes := Changes{
+		{Op: ReplaceOp, Path: "foo.bar", Old: 1, New: 2},
+		{Op: AddOp, Path: "qux", New: "foo"},
+		{Op: RemoveOp, Path: "baz", Old: true},
+	}
+
+	c.Assert(changes.Update(), DeepEquals, bson.M{
+		"$set":   bson.M{"foo.bar": 2, "qux": "foo"},
+		"$unset": bson.M{"baz": ""},
+	})
+}
+
+func (s *BaseSuite) TestChanges_MarshalJSONPatch(c *C) {
+	changes := Changes{
+		{Op: ReplaceOp, Path: "items.2.name", Old: "bar", New: "foo"},
+		{Op: AddOp, Path: "a/b.c~d", New: bson.D{{Name: "foo", Value: 1}}},
+		{Op: RemoveOp, Path: "qux", Old: 1},
+	}
+
+	json, err := changes.MarshalJSONPatch(Relaxed)
+	c.Assert(err, IsNil)
+	c.Assert(string(json), Equals, `[`+
+		`{"op":"replace","path":"/items/2/name","value":"foo"},`+
+		`{"op":"add","path":"/a~1b/c~0d","value":{"foo":1}},`+
+		`{"op":"remove","path":"/qux"}]`)
+
+	json, err = Changes{}.MarshalJSONPatch(Relaxed)
+	c.Assert(err, IsNil)
+	c.Assert(string(json), Equals, `[]`)
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"gopkg.in/src-d/storable.v1"
+	"gopkg.in/src-d/storable.v1/operators"
 )
 
 //go:generate storable gen
@@ -46,6 +47,21 @@ func (p *Product) BeforeSave() error {
 	return nil
 }
 
+//storable:finders
+type ProductFinders interface {
+	FindByNameAndStatus(name string, s Status) (*Product, error)
+	CountByTagsContains(tag string) (int, error)
+}
+
+func (q *ProductQuery) scopePublished() {
+	q.AddCriteria(operators.Eq(Schema.Product.Status, Published))
+}
+
+//storable:scope
+func (q *ProductQuery) cheaperThan(amount float64) {
+	q.AddCriteria(operators.Lt(Schema.Product.Price.Amount, amount))
+}
+
 type Status int
 
 const (
@@ -1,6 +1,8 @@
 package example
 
 import (
+	"context"
+	"reflect"
 	"time"
 
 	"gopkg.in/mgo.v2"
@@ -17,6 +19,18 @@ func NewProductStore(db *mgo.Database) *ProductStore {
 	return &ProductStore{*storable.NewStore(db, "products")}
 }
 
+// WithContext returns a copy of the store using the given context, see
+// storable.Store.WithContext.
+func (s *ProductStore) WithContext(ctx context.Context) *ProductStore {
+	return &ProductStore{*s.Store.WithContext(ctx)}
+}
+
+// InTx returns a copy of the store running its operations, including the
+// hooks, inside the given transaction. See storable.WithTransaction.
+func (s *ProductStore) InTx(tx *storable.Tx) *ProductStore {
+	return &ProductStore{*s.Store.InTx(tx)}
+}
+
 // New returns a new instance of Product.
 func (s *ProductStore) New(name string, price Price, createdAt time.Time) (doc *Product, err error) {
 	doc, err = newProduct(name, price, createdAt)
@@ -48,6 +62,17 @@ func (s *ProductStore) MustFind(query *ProductQuery) *ProductResultSet {
 	return &ProductResultSet{ResultSet: *resultSet}
 }
 
+// Tail performs a find on the capped collection using the given query,
+// waiting for new documents up to the given timeout. See storable.Store.Tail.
+func (s *ProductStore) Tail(query *ProductQuery, timeout time.Duration) (*ProductResultSet, error) {
+	resultSet, err := s.Store.Tail(query, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProductResultSet{ResultSet: *resultSet}, nil
+}
+
 // FindOne performs a find on the collection using the given query returning
 // the first document from the resultset.
 func (s *ProductStore) FindOne(query *ProductQuery) (*Product, error) {
@@ -69,6 +94,16 @@ func (s *ProductStore) MustFindOne(query *ProductQuery) *Product {
 	return doc
 }
 
+// FindAndModify atomically modifies the first document matching the given
+// query and returns it, before the change or after it if change.ReturnNew is
+// set. See storable.Store.FindAndModify.
+func (s *ProductStore) FindAndModify(query *ProductQuery, change mgo.Change) (*Product, error) {
+	var result *Product
+	_, err := s.Store.FindAndModify(query, change, &result)
+
+	return result, err
+}
+
 // Insert insert the given document on the collection, trigger BeforeInsert and
 // AfterInsert if any. Throws ErrNonNewDocument if doc is a non-new document.
 func (s *ProductStore) Insert(doc *Product) error {
@@ -93,6 +128,19 @@ func (s *ProductStore) Update(doc *Product) error {
 	return nil
 }
 
+// UpdateDiff update the given document on the collection writing only the
+// changes from old, trigger BeforeUpdate and AfterUpdate if any. See
+// storable.Store.UpdateDiff.
+func (s *ProductStore) UpdateDiff(old, doc *Product) (storable.Changes, error) {
+
+	changes, err := s.Store.UpdateDiff(old, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
 // Save insert or update the given document on the collection using Upsert,
 // trigger BeforeUpdate and AfterUpdate if the document is non-new and
 // BeforeInsert and AfterInset if is new.
@@ -105,6 +153,25 @@ func (s *ProductStore) Save(doc *Product) (updated bool, err error) {
 	return
 }
 
+// CountByTagsContains implements the finder declared on ProductFinders.
+func (s *ProductStore) CountByTagsContains(tag string) (int, error) {
+	q := s.Query()
+	q.AddCriteria(operators.Eq(Schema.Product.Tags, tag))
+
+	return s.Store.Count(q)
+}
+
+// FindByNameAndStatus implements the finder declared on ProductFinders.
+func (s *ProductStore) FindByNameAndStatus(name string, arg1 Status) (*Product, error) {
+	q := s.Query()
+	q.AddCriteria(operators.Eq(Schema.Product.Name, name))
+	q.AddCriteria(operators.Eq(Schema.Product.Status, arg1))
+
+	return s.FindOne(q)
+}
+
+var _ ProductFinders = (*ProductStore)(nil)
+
 type ProductQuery struct {
 	storable.BaseQuery
 }
@@ -120,6 +187,23 @@ func (q *ProductQuery) FindById(ids ...bson.ObjectId) *ProductQuery {
 	return q
 }
 
+// Clone returns a copy of the query, see storable.BaseQuery.Clone.
+func (q *ProductQuery) Clone() *ProductQuery {
+	return &ProductQuery{*q.BaseQuery.Clone()}
+}
+
+// Published applies the scope scopePublished to the query.
+func (q *ProductQuery) Published() *ProductQuery {
+	q.scopePublished()
+	return q
+}
+
+// CheaperThan applies the scope cheaperThan to the query.
+func (q *ProductQuery) CheaperThan(amount float64) *ProductQuery {
+	q.cheaperThan(amount)
+	return q
+}
+
 type ProductResultSet struct {
 	storable.ResultSet
 	last    *Product
@@ -181,6 +265,70 @@ func (r *ProductResultSet) ForEach(f func(*Product) error) error {
 	return nil
 }
 
+// Watch returns a stream with the changes on the documents matching the given
+// query, the current version of the document is retrieved on every update.
+// See storable.Store.Watch.
+func (s *ProductStore) Watch(query *ProductQuery, opts storable.WatchOptions) (*ProductChangeStream, error) {
+	opts.FullDocument = true
+	cs, err := s.Store.Watch(query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProductChangeStream{ChangeStream: cs}, nil
+}
+
+type ProductChangeStream struct {
+	*storable.ChangeStream
+	event   storable.ChangeEvent
+	last    *Product
+	lastErr error
+}
+
+// Next waits for the next event, to be read with the Get method. Returns
+// false when the stream is closed or fails.
+func (cs *ProductChangeStream) Next() (returned bool) {
+	cs.event, cs.last, cs.lastErr = storable.ChangeEvent{}, nil, nil
+	if !cs.ChangeStream.Next(&cs.event) {
+		cs.lastErr = cs.ChangeStream.Err()
+		return false
+	}
+
+	if cs.event.HasDocument() {
+		cs.lastErr = cs.event.Decode(&cs.last)
+	}
+
+	return true
+}
+
+// Get returns the event and the document retrieved with the Next method, the
+// document is nil on the deletes or if it does not exist anymore.
+func (cs *ProductChangeStream) Get() (*storable.ChangeEvent, *Product, error) {
+	return &cs.event, cs.last, cs.lastErr
+}
+
+// ForEach waits for the events calling to the given function, until the
+// stream is closed or storable.ErrStop is returned.
+func (cs *ProductChangeStream) ForEach(f func(*storable.ChangeEvent, *Product) error) error {
+	for cs.Next() {
+		event, doc, err := cs.Get()
+		if err != nil {
+			return err
+		}
+
+		err = f(event, doc)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return cs.lastErr
+}
+
 type schema struct {
 	Product *schemaProduct
 }
@@ -216,3 +364,26 @@ var Schema = schema{
 		Tags:     storable.NewField("tags", "string"),
 	},
 }
+
+func init() {
+	storable.Register(&storable.ModelInfo{
+		Name:       "Product",
+		Collection: "products",
+		Type:       reflect.TypeOf(Product{}),
+		Schema:     Schema.Product,
+		Fields: []*storable.FieldInfo{
+			{Name: "Id", Path: "_id", Type: "bson.ObjectId", Findable: true},
+			{Name: "Status", Path: "status", Type: "Status", Findable: true},
+			{Name: "CreatedAt", Path: "createdat", Type: "time.Time", Findable: true},
+			{Name: "UpdatedAt", Path: "updatedat", Type: "time.Time", Findable: true},
+			{Name: "Name", Path: "name", Type: "string", Findable: true},
+			{Name: "Price", Path: "price", Type: "Price", Findable: true, Fields: []*storable.FieldInfo{
+				{Name: "Amount", Path: "price.amount", Type: "float64", Findable: true},
+				{Name: "Discount", Path: "price.discount", Type: "float64", Findable: true},
+			}},
+			{Name: "Discount", Path: "discount", Type: "float64", Findable: true},
+			{Name: "Url", Path: "url", Type: "string", Findable: true},
+			{Name: "Tags", Path: "tags", Type: "[]string", Findable: true},
+		},
+	})
+}
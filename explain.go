@@ -0,0 +1,122 @@
+package storable
+
+import (
+	"time"
+
+	"gopkg.in/mgo.v2/bson"
+)
+
+// Stages of the query plans scanning the documents.
+const (
+	CollScanStage = "COLLSCAN"
+	IxScanStage   = "IXSCAN"
+	IdHackStage   = "IDHACK"
+)
+
+// Plan is a summary of the winning plan of a query, as is returned by the
+// explain command with the executionStats verbosity.
+type Plan struct {
+	// Stage is the stage scanning the documents, eg: IXSCAN or COLLSCAN.
+	Stage string
+	// Stages are all the stages of the winning plan, from the root.
+	Stages []string
+	// IndexName is the name of the index used, if any.
+	IndexName string
+	// Returned is the number of documents returned.
+	Returned int
+	// KeysExamined is the number of index keys examined.
+	KeysExamined int
+	// DocsExamined is the number of documents examined.
+	DocsExamined int
+	// ExecutionTime is the time taken to execute the query.
+	ExecutionTime time.Duration
+}
+
+// UsesIndex returns if the plan uses an index, none of its stages is a
+// collection scan.
+func (p *Plan) UsesIndex() bool {
+	for _, s := range p.Stages {
+		if s == CollScanStage {
+			return false
+		}
+	}
+
+	return p.IndexName != "" || p.Stage == IdHackStage
+}
+
+// Explain returns the winning plan of the query, the query is executed to
+// collect the execution stats.
+func (s *Store) Explain(q Query) (*Plan, error) {
+	if err := s.validate(q); err != nil {
+		return nil, err
+	}
+
+	q = s.scope(q)
+
+	sess, c := s.getSessionAndCollection()
+	defer sess.Close()
+
+	cmd := bson.D{
+		{Name: "explain", Value: (&findCmd{collection: c, query: q}).doc()},
+		{Name: "verbosity", Value: "executionStats"},
+	}
+
+	var res struct {
+		QueryPlanner struct {
+			WinningPlan bson.M `bson:"winningPlan"`
+		} `bson:"queryPlanner"`
+		ExecutionStats struct {
+			Returned            int `bson:"nReturned"`
+			KeysExamined        int `bson:"totalKeysExamined"`
+			DocsExamined        int `bson:"totalDocsExamined"`
+			ExecutionTimeMillis int `bson:"executionTimeMillis"`
+		} `bson:"executionStats"`
+	}
+
+	if err := c.Database.Run(cmd, &res); err != nil {
+		return nil, err
+	}
+
+	p := &Plan{
+		Returned:      res.ExecutionStats.Returned,
+		KeysExamined:  res.ExecutionStats.KeysExamined,
+		DocsExamined:  res.ExecutionStats.DocsExamined,
+		ExecutionTime: time.Duration(res.ExecutionStats.ExecutionTimeMillis) * time.Millisecond,
+	}
+
+	p.walk(res.QueryPlanner.WinningPlan)
+	return p, nil
+}
+
+// walk reads the stages of a plan, the scanning stage is the first leaf.
+func (p *Plan) walk(stage bson.M) {
+	if plan, ok := stage["queryPlan"].(bson.M); ok {
+		// plans of the slot based execution engine
+		stage = plan
+	}
+
+	name, _ := stage["stage"].(string)
+	p.Stages = append(p.Stages, name)
+	if index, ok := stage["indexName"].(string); ok && p.IndexName == "" {
+		p.IndexName = index
+	}
+
+	var children []interface{}
+	if input, ok := stage["inputStage"]; ok {
+		children = append(children, input)
+	}
+
+	if inputs, ok := stage["inputStages"].([]interface{}); ok {
+		children = append(children, inputs...)
+	}
+
+	if len(children) == 0 && p.Stage == "" {
+		p.Stage = name
+	}
+
+	for _, child := range children {
+		if doc, ok := child.(bson.M); ok {
+			p.walk(doc)
+		}
+	}
+}
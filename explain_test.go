@@ -0,0 +1,63 @@
+package storable
+
+import (
+	. "gopkg.in/check.v1"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+func (s *BaseSuite) TestPlan_Walk(c *C) {
+	p := &Plan{}
+	p.walk(bson.M{
+		"stage": "FETCH",
+		"inputStage": bson.M{
+			"stage":     "IXSCAN",
+			"indexName": "firstname_1",
+		},
+	})
+
+	c.Assert(p.Stage, Equals, IxScanStage)
+	c.Assert(p.Stages, DeepEquals, []string{"FETCH", "IXSCAN"})
+	c.Assert(p.IndexName, Equals, "firstname_1")
+	c.Assert(p.UsesIndex(), Equals, true)
+}
+
+func (s *BaseSuite) TestPlan_WalkInputStages(c *C) {
+	p := &Plan{}
+	p.walk(bson.M{"queryPlan": bson.M{
+		"stage": "OR",
+		"inputStages": []interface{}{
+			bson.M{"stage": "IXSCAN", "indexName": "firstname_1"},
+			bson.M{"stage": "COLLSCAN"},
+		},
+	}})
+
+	c.Assert(p.Stage, Equals, IxScanStage)
+	c.Assert(p.Stages, DeepEquals, []string{"OR", "IXSCAN", "COLLSCAN"})
+	c.Assert(p.UsesIndex(), Equals, false)
+}
+
+func (s *BaseSuite) TestStore_Explain(c *C) {
+	st := NewStore(s.db, "test")
+	st.Insert(NewPerson("foo"))
+	st.Insert(NewPerson("bar"))
+
+	q := NewBaseQuery()
+	q.AddCriteria(bson.M{"firstname": "foo"})
+
+	p, err := st.Explain(q)
+	c.Assert(err, IsNil)
+	c.Assert(p.Stage, Equals, CollScanStage)
+	c.Assert(p.UsesIndex(), Equals, false)
+	c.Assert(p.Returned, Equals, 1)
+	c.Assert(p.DocsExamined, Equals, 2)
+
+	c.Assert(st.EnsureIndex(mgo.Index{Key: []string{"firstname"}}), IsNil)
+
+	p, err = st.Explain(q)
+	c.Assert(err, IsNil)
+	c.Assert(p.Stage, Equals, IxScanStage)
+	c.Assert(p.IndexName, Equals, "firstname_1")
+	c.Assert(p.UsesIndex(), Equals, true)
+	c.Assert(p.KeysExamined, Equals, 1)
+}
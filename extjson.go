@@ -0,0 +1,1063 @@
+package storable
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// ErrInvalidExtJSON is returned by ParseExtJSON when the given document is not
+// a valid query representation.
+var ErrInvalidExtJSON = errors.New("invalid extended JSON query")
+
+// ExtJSONMode is the format used to represent the BSON values.
+// https://docs.mongodb.com/manual/reference/mongodb-extended-json/
+type ExtJSONMode int
+
+const (
+	// Canonical preserves the type information of every value, at the expense
+	// of readability.
+	Canonical ExtJSONMode = iota
+	// Relaxed uses native JSON numbers and ISO-8601 dates when possible, some
+	// type information is lost.
+	Relaxed
+	// Shell uses the syntax of the mongo shell, eg: ObjectId("...").
+	Shell
+)
+
+// MarshalExtJSON returns a representation of the query as an Extended JSON
+// document, including the sort, skip, limit, projection and collation of the
+// query:
+//
+//	{"filter": {...}, "sort": {...}, "projection": {...}, "skip": 10, "limit": 5}
+//
+// The keys of the documents are sorted to produce always the same output for
+// the same query.
+func (q *BaseQuery) MarshalExtJSON(mode ExtJSONMode) ([]byte, error) {
+	e := &extJSONEncoder{mode: mode}
+	e.buf.WriteString(`{"filter":`)
+	if err := e.encode(criteriaOrEmpty(q)); err != nil {
+		return nil, err
+	}
+
+	if !q.GetSort().IsEmpty() {
+		e.buf.WriteString(`,"sort":`)
+		if err := e.encode(q.GetSort().toDoc()); err != nil {
+			return nil, err
+		}
+	}
+
+	if p := q.GetProjection(); p != nil {
+		e.buf.WriteString(`,"projection":`)
+		if err := e.encode(p); err != nil {
+			return nil, err
+		}
+	}
+
+	if q.skip != 0 {
+		e.buf.WriteString(`,"skip":`)
+		e.encode(q.skip)
+	}
+
+	if q.limit != 0 {
+		e.buf.WriteString(`,"limit":`)
+		e.encode(q.limit)
+	}
+
+	if q.collation != nil {
+		e.buf.WriteString(`,"collation":`)
+		if err := e.encode(collationDoc(q.collation)); err != nil {
+			return nil, err
+		}
+	}
+
+	e.buf.WriteString("}")
+	return e.buf.Bytes(), nil
+}
+
+var shellIdentifier = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// ShellString returns the query as a find command on the given collection
+// using the syntax of the mongo shell, ready to be pasted on it:
+//
+//	db.products.find({"name":"foo"}).sort({"price":-1}).limit(10)
+func (q *BaseQuery) ShellString(collection string) (string, error) {
+	e := &extJSONEncoder{mode: Shell}
+	if shellIdentifier.MatchString(collection) {
+		e.buf.WriteString("db." + collection)
+	} else {
+		e.buf.WriteString("db.getCollection(" + strconv.Quote(collection) + ")")
+	}
+
+	e.buf.WriteString(".find(")
+	if err := e.encode(criteriaOrEmpty(q)); err != nil {
+		return "", err
+	}
+
+	if p := q.GetProjection(); p != nil {
+		e.buf.WriteString(", ")
+		if err := e.encode(p); err != nil {
+			return "", err
+		}
+	}
+
+	e.buf.WriteString(")")
+	if !q.GetSort().IsEmpty() {
+		e.buf.WriteString(".sort(")
+		if err := e.encode(q.GetSort().toDoc()); err != nil {
+			return "", err
+		}
+
+		e.buf.WriteString(")")
+	}
+
+	if q.skip != 0 {
+		fmt.Fprintf(&e.buf, ".skip(%d)", q.skip)
+	}
+
+	if q.limit != 0 {
+		fmt.Fprintf(&e.buf, ".limit(%d)", q.limit)
+	}
+
+	if q.collation != nil {
+		e.buf.WriteString(".collation(")
+		if err := e.encode(collationDoc(q.collation)); err != nil {
+			return "", err
+		}
+
+		e.buf.WriteString(")")
+	}
+
+	return e.buf.String(), nil
+}
+
+type extJSONEncoder struct {
+	buf  bytes.Buffer
+	mode ExtJSONMode
+}
+
+func (e *extJSONEncoder) encode(v interface{}) error {
+	switch v := v.(type) {
+	case nil:
+		e.buf.WriteString("null")
+	case bson.M:
+		return e.encodeMap(v)
+	case map[string]interface{}:
+		return e.encodeMap(v)
+	case bson.D:
+		return e.encodeDoc(v)
+	case []interface{}:
+		return e.encodeArray(reflect.ValueOf(v))
+	case string:
+		e.writeString(v)
+	case bool:
+		e.buf.WriteString(strconv.FormatBool(v))
+	case int:
+		if v >= math.MinInt32 && v <= math.MaxInt32 {
+			e.encodeInt32(int64(v))
+		} else {
+			e.encodeInt64(int64(v))
+		}
+	case int8:
+		e.encodeInt32(int64(v))
+	case int16:
+		e.encodeInt32(int64(v))
+	case int32:
+		e.encodeInt32(int64(v))
+	case int64:
+		e.encodeInt64(v)
+	case float32:
+		e.encodeDouble(float64(v))
+	case float64:
+		e.encodeDouble(v)
+	case time.Time:
+		e.encodeDate(v)
+	case bson.ObjectId:
+		if e.mode == Shell {
+			fmt.Fprintf(&e.buf, "ObjectId(%q)", v.Hex())
+		} else {
+			fmt.Fprintf(&e.buf, `{"$oid":%q}`, v.Hex())
+		}
+	case bson.RegEx:
+		e.encodeRegEx(v)
+	case bson.JavaScript:
+		e.buf.WriteString(`{"$code":`)
+		e.writeString(v.Code)
+		if v.Scope != nil {
+			e.buf.WriteString(`,"$scope":`)
+			if err := e.encode(v.Scope); err != nil {
+				return err
+			}
+		}
+
+		e.buf.WriteString("}")
+	case []byte:
+		e.encodeBinary(0x00, v)
+	case bson.Binary:
+		e.encodeBinary(v.Kind, v.Data)
+	case bson.MongoTimestamp:
+		t, i := uint32(uint64(v)>>32), uint32(v)
+		if e.mode == Shell {
+			fmt.Fprintf(&e.buf, "Timestamp(%d, %d)", t, i)
+		} else {
+			fmt.Fprintf(&e.buf, `{"$timestamp":{"t":%d,"i":%d}}`, t, i)
+		}
+	case bson.Symbol:
+		e.buf.WriteString(`{"$symbol":`)
+		e.writeString(string(v))
+		e.buf.WriteString("}")
+	case bson.Decimal128:
+		if e.mode == Shell {
+			fmt.Fprintf(&e.buf, "NumberDecimal(%q)", v.String())
+		} else {
+			fmt.Fprintf(&e.buf, `{"$numberDecimal":%q}`, v.String())
+		}
+	case bson.Getter:
+		value, err := v.GetBSON()
+		if err != nil {
+			return err
+		}
+
+		return e.encode(value)
+	default:
+		return e.encodeValue(v)
+	}
+
+	return nil
+}
+
+func (e *extJSONEncoder) encodeValue(v interface{}) error {
+	switch {
+	case v == bson.MinKey:
+		e.writeConst("MinKey", `{"$minKey":1}`)
+		return nil
+	case v == bson.MaxKey:
+		e.writeConst("MaxKey", `{"$maxKey":1}`)
+		return nil
+	case v == bson.Undefined:
+		e.writeConst("undefined", `{"$undefined":true}`)
+		return nil
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if rv.IsNil() {
+			return e.encode(nil)
+		}
+
+		return e.encode(rv.Elem().Interface())
+	case reflect.Map:
+		if rv.Type().Key().Kind() == reflect.String {
+			return e.encodeMapValue(rv)
+		}
+	case reflect.Slice, reflect.Array:
+		if rv.Type().Elem().Kind() != reflect.Uint8 {
+			return e.encodeArray(rv)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32:
+		return e.encode(int(rv.Int()))
+	case reflect.Int64:
+		return e.encode(rv.Int())
+	case reflect.Float32, reflect.Float64:
+		return e.encode(rv.Float())
+	case reflect.String:
+		return e.encode(rv.String())
+	case reflect.Bool:
+		return e.encode(rv.Bool())
+	}
+
+	// any other value is encoded as mgo does, and the result is encoded again
+	raw, err := bson.Marshal(bson.M{"v": v})
+	if err != nil {
+		return err
+	}
+
+	var doc bson.D
+	if err := bson.Unmarshal(raw, &doc); err != nil {
+		return err
+	}
+
+	return e.encode(doc[0].Value)
+}
+
+func (e *extJSONEncoder) encodeMap(m map[string]interface{}) error {
+	return e.encodeMapValue(reflect.ValueOf(m))
+}
+
+func (e *extJSONEncoder) encodeMapValue(m reflect.Value) error {
+	keys := make([]string, 0, m.Len())
+	values := make(map[string]reflect.Value, m.Len())
+	for _, k := range m.MapKeys() {
+		keys = append(keys, k.String())
+		values[k.String()] = m.MapIndex(k)
+	}
+
+	sort.Strings(keys)
+
+	var d bson.D
+	for _, k := range keys {
+		d = append(d, bson.DocElem{Name: k, Value: values[k].Interface()})
+	}
+
+	return e.encodeDoc(d)
+}
+
+func (e *extJSONEncoder) encodeDoc(d bson.D) error {
+	e.buf.WriteString("{")
+	for i, elem := range d {
+		if i != 0 {
+			e.buf.WriteString(",")
+		}
+
+		e.writeString(elem.Name)
+		e.buf.WriteString(":")
+		if err := e.encode(elem.Value); err != nil {
+			return err
+		}
+	}
+
+	e.buf.WriteString("}")
+	return nil
+}
+
+func (e *extJSONEncoder) encodeArray(a reflect.Value) error {
+	e.buf.WriteString("[")
+	for i := 0; i < a.Len(); i++ {
+		if i != 0 {
+			e.buf.WriteString(",")
+		}
+
+		if err := e.encode(a.Index(i).Interface()); err != nil {
+			return err
+		}
+	}
+
+	e.buf.WriteString("]")
+	return nil
+}
+
+func (e *extJSONEncoder) encodeInt32(i int64) {
+	if e.mode == Canonical {
+		fmt.Fprintf(&e.buf, `{"$numberInt":"%d"}`, i)
+	} else {
+		e.buf.WriteString(strconv.FormatInt(i, 10))
+	}
+}
+
+func (e *extJSONEncoder) encodeInt64(i int64) {
+	switch e.mode {
+	case Canonical:
+		fmt.Fprintf(&e.buf, `{"$numberLong":"%d"}`, i)
+	case Shell:
+		fmt.Fprintf(&e.buf, `NumberLong("%d")`, i)
+	default:
+		e.buf.WriteString(strconv.FormatInt(i, 10))
+	}
+}
+
+func (e *extJSONEncoder) encodeDouble(f float64) {
+	var s string
+	special := true
+	switch {
+	case math.IsInf(f, 1):
+		s = "Infinity"
+	case math.IsInf(f, -1):
+		s = "-Infinity"
+	case math.IsNaN(f):
+		s = "NaN"
+	default:
+		special = false
+		s = strconv.FormatFloat(f, 'g', -1, 64)
+		if !strings.ContainsAny(s, ".e") {
+			s += ".0"
+		}
+	}
+
+	if e.mode == Canonical || (e.mode == Relaxed && special) {
+		fmt.Fprintf(&e.buf, `{"$numberDouble":%q}`, s)
+	} else {
+		e.buf.WriteString(s)
+	}
+}
+
+func (e *extJSONEncoder) encodeDate(t time.Time) {
+	ms := t.Unix()*1000 + int64(t.Nanosecond()/1e6)
+	iso := t.UTC().Format("2006-01-02T15:04:05.999Z07:00")
+
+	switch {
+	case e.mode == Shell:
+		fmt.Fprintf(&e.buf, "ISODate(%q)", iso)
+	case e.mode == Relaxed && t.Year() >= 1970 && t.Year() <= 9999:
+		fmt.Fprintf(&e.buf, `{"$date":%q}`, iso)
+	default:
+		fmt.Fprintf(&e.buf, `{"$date":{"$numberLong":"%d"}}`, ms)
+	}
+}
+
+func (e *extJSONEncoder) encodeRegEx(r bson.RegEx) {
+	if e.mode == Shell {
+		e.buf.WriteString("/" + strings.Replace(r.Pattern, "/", `\/`, -1) + "/" + r.Options)
+		return
+	}
+
+	e.buf.WriteString(`{"$regularExpression":{"pattern":`)
+	e.writeString(r.Pattern)
+	e.buf.WriteString(`,"options":`)
+	e.writeString(r.Options)
+	e.buf.WriteString("}}")
+}
+
+func (e *extJSONEncoder) encodeBinary(kind byte, data []byte) {
+	b64 := base64.StdEncoding.EncodeToString(data)
+	if e.mode == Shell {
+		fmt.Fprintf(&e.buf, "BinData(%d, %q)", kind, b64)
+		return
+	}
+
+	fmt.Fprintf(&e.buf, `{"$binary":{"base64":%q,"subType":"%02x"}}`, b64, kind)
+}
+
+func (e *extJSONEncoder) writeConst(shell, json string) {
+	if e.mode == Shell {
+		e.buf.WriteString(shell)
+	} else {
+		e.buf.WriteString(json)
+	}
+}
+
+func (e *extJSONEncoder) writeString(s string) {
+	b, _ := json.Marshal(s)
+	e.buf.Write(b)
+}
+
+// ParseExtJSON returns a BaseQuery from its Extended JSON representation, as
+// is returned by BaseQuery.MarshalExtJSON. Both canonical and relaxed formats
+// are supported, as well as the legacy format used by mongoexport.
+func ParseExtJSON(data []byte) (*BaseQuery, error) {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+
+	v, err := decodeJSON(d)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", ErrInvalidExtJSON, err)
+	}
+
+	doc, ok := v.(bson.D)
+	if !ok {
+		return nil, fmt.Errorf("%s: a document was expected", ErrInvalidExtJSON)
+	}
+
+	q := NewBaseQuery()
+	for _, elem := range doc {
+		if err := q.parseExtJSONElem(elem); err != nil {
+			return nil, fmt.Errorf("%s: %s: %s", ErrInvalidExtJSON, elem.Name, err)
+		}
+	}
+
+	return q, nil
+}
+
+func (q *BaseQuery) parseExtJSONElem(elem bson.DocElem) error {
+	switch elem.Name {
+	case "filter":
+		v, err := fromExtJSON(elem.Value)
+		if err != nil {
+			return err
+		}
+
+		return q.parseExtJSONFilter(v)
+	case "sort":
+		return q.parseExtJSONSort(elem.Value)
+	case "projection":
+		return q.parseExtJSONProjection(elem.Value)
+	case "collation":
+		return q.parseExtJSONCollation(elem.Value)
+	case "skip", "limit":
+		n, err := extJSONInt(elem.Value)
+		if err != nil {
+			return err
+		}
+
+		if elem.Name == "skip" {
+			q.Skip(n)
+		} else {
+			q.Limit(n)
+		}
+
+		return nil
+	}
+
+	return errors.New("unknown key")
+}
+
+func (q *BaseQuery) parseExtJSONFilter(v interface{}) error {
+	doc, ok := v.(bson.D)
+	if !ok {
+		return errors.New("a document was expected")
+	}
+
+	// the order of the fields of the clauses does not matter, only the one of
+	// the embedded documents
+	filter := doc.Map()
+	if and, ok := filter["$and"].([]interface{}); ok && len(filter) == 1 {
+		for _, clause := range and {
+			c, ok := clause.(bson.D)
+			if !ok {
+				return errors.New("$and clauses should be documents")
+			}
+
+			q.AddCriteria(c.Map())
+		}
+
+		return nil
+	}
+
+	if len(filter) != 0 {
+		q.AddCriteria(filter)
+	}
+
+	return nil
+}
+
+func (q *BaseQuery) parseExtJSONSort(v interface{}) error {
+	doc, ok := v.(bson.D)
+	if !ok {
+		return errors.New("a document was expected")
+	}
+
+	var s Sort
+	for _, elem := range doc {
+		if isTextScoreMeta(elem.Value) {
+			s = append(s, FieldSort{F: NewField(elem.Name, ""), D: TextScore})
+			continue
+		}
+
+		dir, err := extJSONInt(elem.Value)
+		if err != nil || (dir != int(Asc) && dir != int(Desc)) {
+			return fmt.Errorf("invalid direction for %q", elem.Name)
+		}
+
+		s = append(s, FieldSort{F: NewField(elem.Name, ""), D: Dir(dir)})
+	}
+
+	q.Sort(s)
+	return nil
+}
+
+func (q *BaseQuery) parseExtJSONProjection(v interface{}) error {
+	doc, ok := v.(bson.D)
+	if !ok {
+		return errors.New("a document was expected")
+	}
+
+	var s Select
+	for _, elem := range doc {
+		filter := Include
+		value, err := fromExtJSON(elem.Value)
+		if err != nil {
+			return err
+		}
+
+		switch value := value.(type) {
+		case bson.D:
+			q.Project(bson.M{elem.Name: value})
+			continue
+		case bool:
+			if !value {
+				filter = Exclude
+			}
+		default:
+			n, err := extJSONInt(value)
+			if err != nil {
+				return fmt.Errorf("invalid projection for %q", elem.Name)
+			}
+
+			if n == 0 {
+				filter = Exclude
+			}
+		}
+
+		s = append(s, FieldSelect{F: NewField(elem.Name, ""), D: filter})
+	}
+
+	if len(s) != 0 {
+		q.Select(s)
+	}
+
+	return nil
+}
+
+func (q *BaseQuery) parseExtJSONCollation(v interface{}) error {
+	v, err := fromExtJSON(v)
+	if err != nil {
+		return err
+	}
+
+	doc, ok := v.(bson.D)
+	if !ok {
+		return errors.New("a document was expected")
+	}
+
+	raw, err := bson.Marshal(doc)
+	if err != nil {
+		return err
+	}
+
+	c := &mgo.Collation{}
+	if err := bson.Unmarshal(raw, c); err != nil {
+		return err
+	}
+
+	q.Collation(c)
+	return nil
+}
+
+// collationDoc returns the collation as a document with only the non-default
+// options.
+func collationDoc(c *mgo.Collation) bson.D {
+	var d bson.D
+	raw, _ := bson.Marshal(c)
+	bson.Unmarshal(raw, &d)
+
+	return d
+}
+
+func extJSONInt(v interface{}) (int, error) {
+	v, err := fromExtJSON(v)
+	if err != nil {
+		return 0, err
+	}
+
+	switch n := v.(type) {
+	case int:
+		return n, nil
+	case int64:
+		return int(n), nil
+	case float64:
+		if n == math.Trunc(n) {
+			return int(n), nil
+		}
+	}
+
+	return 0, errors.New("an integer was expected")
+}
+
+// decodeJSON decodes the next JSON value, the objects are returned as bson.D
+// to keep the order of the keys and the numbers as json.Number.
+func decodeJSON(d *json.Decoder) (interface{}, error) {
+	t, err := d.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch t {
+	case json.Delim('{'):
+		doc := bson.D{}
+		for d.More() {
+			key, err := d.Token()
+			if err != nil {
+				return nil, err
+			}
+
+			value, err := decodeJSON(d)
+			if err != nil {
+				return nil, err
+			}
+
+			doc = append(doc, bson.DocElem{Name: key.(string), Value: value})
+		}
+
+		_, err := d.Token()
+		return doc, err
+	case json.Delim('['):
+		array := []interface{}{}
+		for d.More() {
+			value, err := decodeJSON(d)
+			if err != nil {
+				return nil, err
+			}
+
+			array = append(array, value)
+		}
+
+		_, err := d.Token()
+		return array, err
+	case json.Delim('}'), json.Delim(']'):
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	return t, nil
+}
+
+// extJSONWrapper returns the parser of the documents representing a typed
+// value with the given key, nil if the key does not belong to a typed value.
+func extJSONWrapper(key string) func(bson.D) (interface{}, error) {
+	switch key {
+	case "$oid":
+		return parseExtJSONObjectId
+	case "$date":
+		return parseExtJSONDate
+	case "$numberInt", "$numberLong", "$numberDouble", "$numberDecimal":
+		return parseExtJSONNumber
+	case "$regularExpression", "$regex":
+		return parseExtJSONRegEx
+	case "$binary":
+		return parseExtJSONBinary
+	case "$timestamp":
+		return parseExtJSONTimestamp
+	case "$code":
+		return parseExtJSONCode
+	case "$symbol":
+		return parseExtJSONSymbol
+	case "$minKey", "$maxKey", "$undefined":
+		return parseExtJSONConst
+	}
+
+	return nil
+}
+
+// fromExtJSON converts a value returned by decodeJSON to the types used by
+// mgo, the documents that are not typed values are returned as bson.D,
+// keeping the order of its fields, needed to match embedded documents.
+func fromExtJSON(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case bson.D:
+		if len(v) != 0 {
+			if parse := extJSONWrapper(v[0].Name); parse != nil {
+				value, err := parse(v)
+				if err != errNotExtJSONWrapper {
+					return value, err
+				}
+			}
+		}
+
+		d := make(bson.D, len(v))
+		for i, elem := range v {
+			value, err := fromExtJSON(elem.Value)
+			if err != nil {
+				return nil, err
+			}
+
+			d[i] = bson.DocElem{Name: elem.Name, Value: value}
+		}
+
+		return d, nil
+	case []interface{}:
+		a := make([]interface{}, len(v))
+		for i, elem := range v {
+			value, err := fromExtJSON(elem)
+			if err != nil {
+				return nil, err
+			}
+
+			a[i] = value
+		}
+
+		return a, nil
+	case json.Number:
+		return parseRelaxedNumber(string(v))
+	}
+
+	return v, nil
+}
+
+var errNotExtJSONWrapper = errors.New("not an extended JSON value")
+
+func parseRelaxedNumber(s string) (interface{}, error) {
+	if !strings.ContainsAny(s, ".eE") {
+		i, err := strconv.ParseInt(s, 10, 64)
+		if err == nil && i >= math.MinInt32 && i <= math.MaxInt32 {
+			return int(i), nil
+		}
+
+		if err == nil {
+			return i, nil
+		}
+	}
+
+	return strconv.ParseFloat(s, 64)
+}
+
+func wrapperValue(d bson.D, keys ...string) (map[string]interface{}, bool) {
+	if len(d) != len(keys) {
+		return nil, false
+	}
+
+	values := make(map[string]interface{}, len(d))
+	for _, elem := range d {
+		values[elem.Name] = elem.Value
+	}
+
+	for _, k := range keys {
+		if _, ok := values[k]; !ok {
+			return nil, false
+		}
+	}
+
+	return values, true
+}
+
+func wrapperString(d bson.D, key string) (string, bool) {
+	v, ok := wrapperValue(d, key)
+	if !ok {
+		return "", false
+	}
+
+	s, ok := v[key].(string)
+	return s, ok
+}
+
+func parseExtJSONObjectId(d bson.D) (interface{}, error) {
+	hex, ok := wrapperString(d, "$oid")
+	if !ok {
+		return nil, errNotExtJSONWrapper
+	}
+
+	if !bson.IsObjectIdHex(hex) {
+		return nil, fmt.Errorf("invalid ObjectId %q", hex)
+	}
+
+	return bson.ObjectIdHex(hex), nil
+}
+
+func parseExtJSONDate(d bson.D) (interface{}, error) {
+	v, ok := wrapperValue(d, "$date")
+	if !ok {
+		return nil, errNotExtJSONWrapper
+	}
+
+	switch date := v["$date"].(type) {
+	case string:
+		t, err := time.Parse(time.RFC3339Nano, date)
+		if err != nil {
+			return nil, err
+		}
+
+		return t.UTC(), nil
+	case bson.D, json.Number:
+		n, err := fromExtJSON(date)
+		if err != nil {
+			return nil, err
+		}
+
+		var ms int64
+		switch n := n.(type) {
+		case int:
+			ms = int64(n)
+		case int64:
+			ms = n
+		default:
+			return nil, errors.New("invalid $date")
+		}
+
+		return time.Unix(ms/1000, ms%1000*1e6).UTC(), nil
+	}
+
+	return nil, errors.New("invalid $date")
+}
+
+func parseExtJSONNumber(d bson.D) (interface{}, error) {
+	name := d[0].Name
+	s, ok := wrapperString(d, name)
+	if !ok {
+		return nil, errNotExtJSONWrapper
+	}
+
+	switch name {
+	case "$numberInt":
+		i, err := strconv.ParseInt(s, 10, 32)
+		return int(i), err
+	case "$numberLong":
+		return strconv.ParseInt(s, 10, 64)
+	case "$numberDecimal":
+		return bson.ParseDecimal128(s)
+	}
+
+	switch s {
+	case "Infinity":
+		return math.Inf(1), nil
+	case "-Infinity":
+		return math.Inf(-1), nil
+	case "NaN":
+		return math.NaN(), nil
+	}
+
+	return strconv.ParseFloat(s, 64)
+}
+
+func parseExtJSONRegEx(d bson.D) (interface{}, error) {
+	if d[0].Name == "$regex" {
+		// legacy format, only when is not a $regex query operator
+		v, ok := wrapperValue(d, "$regex", "$options")
+		if !ok {
+			return nil, errNotExtJSONWrapper
+		}
+
+		pattern, ok1 := v["$regex"].(string)
+		options, ok2 := v["$options"].(string)
+		if !ok1 || !ok2 {
+			return nil, errNotExtJSONWrapper
+		}
+
+		return bson.RegEx{Pattern: pattern, Options: options}, nil
+	}
+
+	v, ok := wrapperValue(d, "$regularExpression")
+	if !ok {
+		return nil, errNotExtJSONWrapper
+	}
+
+	re, ok := v["$regularExpression"].(bson.D)
+	if !ok {
+		return nil, errors.New("invalid $regularExpression")
+	}
+
+	fields, ok := wrapperValue(re, "pattern", "options")
+	if !ok {
+		return nil, errors.New("invalid $regularExpression")
+	}
+
+	pattern, ok1 := fields["pattern"].(string)
+	options, ok2 := fields["options"].(string)
+	if !ok1 || !ok2 {
+		return nil, errors.New("invalid $regularExpression")
+	}
+
+	return bson.RegEx{Pattern: pattern, Options: options}, nil
+}
+
+func parseExtJSONBinary(d bson.D) (interface{}, error) {
+	var b64, subType string
+	if v, ok := wrapperValue(d, "$binary", "$type"); ok {
+		// legacy format
+		b64, _ = v["$binary"].(string)
+		subType, _ = v["$type"].(string)
+	} else if v, ok := wrapperValue(d, "$binary"); ok {
+		bin, ok := v["$binary"].(bson.D)
+		if !ok || len(bin) != 2 {
+			return nil, errors.New("invalid $binary")
+		}
+
+		for _, elem := range bin {
+			switch elem.Name {
+			case "base64":
+				b64, _ = elem.Value.(string)
+			case "subType":
+				subType, _ = elem.Value.(string)
+			}
+		}
+	} else {
+		return nil, errNotExtJSONWrapper
+	}
+
+	data, err := base64.StdEncoding.DecodeString(b64)
+	if err != nil {
+		return nil, err
+	}
+
+	kind, err := strconv.ParseUint(subType, 16, 8)
+	if err != nil {
+		return nil, fmt.Errorf("invalid $binary subType %q", subType)
+	}
+
+	if kind == 0 {
+		return data, nil
+	}
+
+	return bson.Binary{Kind: byte(kind), Data: data}, nil
+}
+
+func parseExtJSONTimestamp(d bson.D) (interface{}, error) {
+	v, ok := wrapperValue(d, "$timestamp")
+	if !ok {
+		return nil, errNotExtJSONWrapper
+	}
+
+	ts, ok := v["$timestamp"].(bson.D)
+	if !ok || len(ts) != 2 {
+		return nil, errors.New("invalid $timestamp")
+	}
+
+	var t, i uint64
+	for _, elem := range ts {
+		n, ok := elem.Value.(json.Number)
+		if !ok {
+			return nil, errors.New("invalid $timestamp")
+		}
+
+		u, err := strconv.ParseUint(string(n), 10, 32)
+		if err != nil {
+			return nil, err
+		}
+
+		switch elem.Name {
+		case "t":
+			t = u
+		case "i":
+			i = u
+		}
+	}
+
+	return bson.MongoTimestamp(int64(t<<32 | i)), nil
+}
+
+func parseExtJSONCode(d bson.D) (interface{}, error) {
+	if v, ok := wrapperValue(d, "$code", "$scope"); ok {
+		code, ok := v["$code"].(string)
+		if !ok {
+			return nil, errors.New("invalid $code")
+		}
+
+		scope, err := fromExtJSON(v["$scope"])
+		if err != nil {
+			return nil, err
+		}
+
+		return bson.JavaScript{Code: code, Scope: scope}, nil
+	}
+
+	code, ok := wrapperString(d, "$code")
+	if !ok {
+		return nil, errNotExtJSONWrapper
+	}
+
+	return bson.JavaScript{Code: code}, nil
+}
+
+func parseExtJSONSymbol(d bson.D) (interface{}, error) {
+	s, ok := wrapperString(d, "$symbol")
+	if !ok {
+		return nil, errNotExtJSONWrapper
+	}
+
+	return bson.Symbol(s), nil
+}
+
+func parseExtJSONConst(d bson.D) (interface{}, error) {
+	if len(d) != 1 {
+		return nil, errNotExtJSONWrapper
+	}
+
+	switch d[0].Name {
+	case "$minKey":
+		return bson.MinKey, nil
+	case "$maxKey":
+		return bson.MaxKey, nil
+	}
+
+	return bson.Undefined, nil
+}
@@ -0,0 +1,203 @@
+package storable
+
+import (
+	"time"
+
+	. "gopkg.in/check.v1"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+var extJSONDate = time.Date(2016, time.January, 2, 3, 4, 5, 0, time.UTC)
+
+func newExtJSONQuery() *BaseQuery {
+	q := NewBaseQuery()
+	q.AddCriteria(bson.M{"_id": bson.ObjectIdHex("56f6a0a67edfc6d4ad4a3e35")})
+	q.AddCriteria(bson.M{
+		"count":   int64(42),
+		"price":   bson.M{"$gt": 10.5},
+		"created": bson.M{"$lt": extJSONDate},
+		"name":    bson.RegEx{Pattern: "^foo", Options: "i"},
+	})
+	q.Sort(Sort{{NewField("name", "string"), Asc}, {NewField("price", "float64"), Desc}})
+	q.Select(Select{{NewField("name", "string"), Include}})
+	q.Skip(10)
+	q.Limit(5)
+
+	return q
+}
+
+func (s *BaseSuite) TestBaseQuery_MarshalExtJSONCanonical(c *C) {
+	b, err := newExtJSONQuery().MarshalExtJSON(Canonical)
+	c.Assert(err, IsNil)
+	c.Assert(string(b), Equals, `{"filter":{"$and":[`+
+		`{"_id":{"$oid":"56f6a0a67edfc6d4ad4a3e35"}},`+
+		`{"count":{"$numberLong":"42"},`+
+		`"created":{"$lt":{"$date":{"$numberLong":"1451703845000"}}},`+
+		`"name":{"$regularExpression":{"pattern":"^foo","options":"i"}},`+
+		`"price":{"$gt":{"$numberDouble":"10.5"}}}]},`+
+		`"sort":{"name":{"$numberInt":"1"},"price":{"$numberInt":"-1"}},`+
+		`"projection":{"name":{"$numberInt":"1"}},`+
+		`"skip":{"$numberInt":"10"},"limit":{"$numberInt":"5"}}`,
+	)
+}
+
+func (s *BaseSuite) TestBaseQuery_MarshalExtJSONRelaxed(c *C) {
+	b, err := newExtJSONQuery().MarshalExtJSON(Relaxed)
+	c.Assert(err, IsNil)
+	c.Assert(string(b), Equals, `{"filter":{"$and":[`+
+		`{"_id":{"$oid":"56f6a0a67edfc6d4ad4a3e35"}},`+
+		`{"count":42,`+
+		`"created":{"$lt":{"$date":"2016-01-02T03:04:05Z"}},`+
+		`"name":{"$regularExpression":{"pattern":"^foo","options":"i"}},`+
+		`"price":{"$gt":10.5}}]},`+
+		`"sort":{"name":1,"price":-1},`+
+		`"projection":{"name":1},`+
+		`"skip":10,"limit":5}`,
+	)
+}
+
+func (s *BaseSuite) TestBaseQuery_MarshalExtJSONEmpty(c *C) {
+	b, err := NewBaseQuery().MarshalExtJSON(Relaxed)
+	c.Assert(err, IsNil)
+	c.Assert(string(b), Equals, `{"filter":{}}`)
+}
+
+func (s *BaseSuite) TestBaseQuery_ShellString(c *C) {
+	str, err := newExtJSONQuery().ShellString("products")
+	c.Assert(err, IsNil)
+	c.Assert(str, Equals, `db.products.find({"$and":[`+
+		`{"_id":ObjectId("56f6a0a67edfc6d4ad4a3e35")},`+
+		`{"count":NumberLong("42"),`+
+		`"created":{"$lt":ISODate("2016-01-02T03:04:05Z")},`+
+		`"name":/^foo/i,`+
+		`"price":{"$gt":10.5}}]}, {"name":1})`+
+		`.sort({"name":1,"price":-1}).skip(10).limit(5)`,
+	)
+}
+
+func (s *BaseSuite) TestBaseQuery_ShellStringCollectionName(c *C) {
+	str, err := NewBaseQuery().ShellString("my-products")
+	c.Assert(err, IsNil)
+	c.Assert(str, Equals, `db.getCollection("my-products").find({})`)
+}
+
+func (s *BaseSuite) TestParseExtJSON(c *C) {
+	for _, mode := range []ExtJSONMode{Canonical, Relaxed} {
+		expected := newExtJSONQuery()
+		b, err := expected.MarshalExtJSON(mode)
+		c.Assert(err, IsNil)
+
+		q, err := ParseExtJSON(b)
+		c.Assert(err, IsNil)
+
+		// the embedded documents are parsed as bson.D
+		expected.clauses[1]["price"] = bson.D{{Name: "$gt", Value: 10.5}}
+		expected.clauses[1]["created"] = bson.D{{Name: "$lt", Value: extJSONDate}}
+		if mode == Relaxed {
+			// relaxed numbers don't keep the original integer type
+			expected.clauses[1]["count"] = 42
+		}
+
+		c.Assert(q.GetCriteria(), DeepEquals, expected.GetCriteria())
+		c.Assert(q.GetSort(), HasLen, 2)
+		c.Assert(q.GetSort().ToList(), DeepEquals, []string{"name", "-price"})
+		c.Assert(q.GetSelect().ToMap(), DeepEquals, bson.M{"name": 1})
+		c.Assert(q.GetSkip(), Equals, 10)
+		c.Assert(q.GetLimit(), Equals, 5)
+	}
+}
+
+func (s *BaseSuite) TestParseExtJSONLegacy(c *C) {
+	q, err := ParseExtJSON([]byte(`{"filter":{
+		"created":{"$date":1451703845000},
+		"name":{"$regex":"^foo","$options":"i"},
+		"count":{"$gte":3}
+	}}`))
+
+	c.Assert(err, IsNil)
+	c.Assert(q.GetCriteria(), DeepEquals, bson.M{"$and": []bson.M{{
+		"created": extJSONDate,
+		"name":    bson.RegEx{Pattern: "^foo", Options: "i"},
+		"count":   bson.D{{Name: "$gte", Value: 3}},
+	}}})
+}
+
+func (s *BaseSuite) TestParseExtJSONEmbeddedDocument(c *C) {
+	q, err := ParseExtJSON([]byte(`{"filter":{
+		"address":{"zip":"08001","city":"Barcelona"}
+	}}`))
+
+	c.Assert(err, IsNil)
+	c.Assert(q.GetCriteria(), DeepEquals, bson.M{"$and": []bson.M{{
+		"address": bson.D{
+			{Name: "zip", Value: "08001"},
+			{Name: "city", Value: "Barcelona"},
+		},
+	}}})
+}
+
+func (s *BaseSuite) TestParseExtJSONErrors(c *C) {
+	for _, doc := range []string{
+		`[]`,
+		`{"filter":[]}`,
+		`{"filter":{},"foo":1}`,
+		`{"filter":{"_id":{"$oid":"foo"}}}`,
+		`{"sort":{"name":2}}`,
+		`{"limit":"foo"}`,
+		`{"filter":`,
+	} {
+		_, err := ParseExtJSON([]byte(doc))
+		c.Assert(err, ErrorMatches, ErrInvalidExtJSON.Error()+".*", Commentf(doc))
+	}
+}
+
+func (s *BaseSuite) TestParseExtJSONProjectionOperators(c *C) {
+	q, err := ParseExtJSON([]byte(`{"projection":{"name":1,"tags":{"$slice":-5}}}`))
+	c.Assert(err, IsNil)
+	c.Assert(q.GetProjection(), DeepEquals, bson.M{
+		"name": 1,
+		"tags": bson.D{{Name: "$slice", Value: -5}},
+	})
+}
+
+func (s *BaseSuite) TestParseExtJSONTextScore(c *C) {
+	q := NewBaseQuery()
+	q.AddCriteria(bson.M{"$text": bson.M{"$search": "foo"}})
+	q.Sort(Sort{{NewField("score", ""), TextScore}, {NewField("name", ""), Asc}})
+
+	b, err := q.MarshalExtJSON(Relaxed)
+	c.Assert(err, IsNil)
+	c.Assert(string(b), Equals, `{"filter":{"$and":[{"$text":{"$search":"foo"}}]},`+
+		`"sort":{"score":{"$meta":"textScore"},"name":1},`+
+		`"projection":{"score":{"$meta":"textScore"}}}`,
+	)
+
+	parsed, err := ParseExtJSON(b)
+	c.Assert(err, IsNil)
+	c.Assert(parsed.GetSort().ToList(), DeepEquals, []string{"$textScore:score", "name"})
+	c.Assert(parsed.GetProjection(), DeepEquals, bson.M{
+		"score": bson.D{{Name: "$meta", Value: "textScore"}},
+	})
+}
+
+func (s *BaseSuite) TestParseExtJSONCollation(c *C) {
+	q := NewBaseQuery()
+	q.AddCriteria(bson.M{"name": "foo"})
+	q.Collation(&mgo.Collation{Locale: "en", Strength: 2, NumericOrdering: true})
+
+	str, err := q.ShellString("products")
+	c.Assert(err, IsNil)
+	c.Assert(str, Equals, `db.products.find({"$and":[{"name":"foo"}]})`+
+		`.collation({"locale":"en","strength":2,"numericOrdering":true})`,
+	)
+
+	for _, mode := range []ExtJSONMode{Canonical, Relaxed} {
+		b, err := q.MarshalExtJSON(mode)
+		c.Assert(err, IsNil)
+
+		parsed, err := ParseExtJSON(b)
+		c.Assert(err, IsNil)
+		c.Assert(parsed.GetCollation(), DeepEquals, q.GetCollation())
+	}
+}
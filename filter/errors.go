@@ -0,0 +1,41 @@
+package filter
+
+import "fmt"
+
+// Code identifies the kind of an Error.
+type Code string
+
+const (
+	// SyntaxError the expression is malformed.
+	SyntaxError Code = "syntax_error"
+	// UnknownField the field is not part of the schema.
+	UnknownField Code = "unknown_field"
+	// FieldNotAllowed the field is part of the schema but is not whitelisted.
+	FieldNotAllowed Code = "field_not_allowed"
+	// OperatorNotAllowed the operator is not whitelisted or can't be used with
+	// the type of the field.
+	OperatorNotAllowed Code = "operator_not_allowed"
+	// InvalidValue the value can't be converted to the type of the field.
+	InvalidValue Code = "invalid_value"
+)
+
+// Error is returned when an expression can't be parsed, is meant to be
+// returned to the client, eg: as the body of a 400 response.
+type Error struct {
+	Code     Code     `json:"code"`
+	Message  string   `json:"message"`
+	Field    string   `json:"field,omitempty"`
+	Operator Operator `json:"operator,omitempty"`
+	Value    string   `json:"value,omitempty"`
+	// Position is the offset in bytes on the expression where the error was
+	// found.
+	Position int `json:"position"`
+}
+
+func newError(code Code, pos int, format string, args ...interface{}) *Error {
+	return &Error{Code: code, Position: pos, Message: fmt.Sprintf(format, args...)}
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s at position %d: %s", e.Code, e.Position, e.Message)
+}
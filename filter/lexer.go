@@ -0,0 +1,133 @@
+package filter
+
+import (
+	"strconv"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenWord
+	tokenString
+	tokenOperator
+	tokenColon
+	tokenComma
+	tokenLParen
+	tokenRParen
+)
+
+func (k tokenKind) String() string {
+	switch k {
+	case tokenEOF:
+		return "end of expression"
+	case tokenWord:
+		return "word"
+	case tokenString:
+		return "string"
+	case tokenOperator:
+		return "operator"
+	case tokenColon:
+		return `":"`
+	case tokenComma:
+		return `","`
+	case tokenLParen:
+		return `"("`
+	case tokenRParen:
+		return `")"`
+	}
+
+	return "unknown"
+}
+
+type token struct {
+	kind  tokenKind
+	value string
+	pos   int
+}
+
+// isKeyword returns if the token is the given keyword, keywords are case
+// insensitive.
+func (t token) isKeyword(keyword string) bool {
+	return t.kind == tokenWord && strings.EqualFold(t.value, keyword)
+}
+
+// lex splits the expression in tokens, the last one is always a tokenEOF.
+func lex(expr string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(expr); {
+		c := expr[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '"':
+			end, err := lexString(expr, i)
+			if err != nil {
+				return nil, err
+			}
+
+			value, err := strconv.Unquote(expr[i:end])
+			if err != nil {
+				return nil, newError(SyntaxError, i, "invalid string %s", expr[i:end])
+			}
+
+			tokens = append(tokens, token{tokenString, value, i})
+			i = end
+		case c == '(' || c == ')' || c == ',' || c == ':':
+			kind := map[byte]tokenKind{
+				'(': tokenLParen, ')': tokenRParen, ',': tokenComma, ':': tokenColon,
+			}[c]
+
+			tokens = append(tokens, token{kind, string(c), i})
+			i++
+		case strings.IndexByte("=!<>~", c) != -1:
+			end := i + 1
+			if end < len(expr) && expr[end] == '=' && c != '=' && c != '~' {
+				end++
+			}
+
+			op := expr[i:end]
+			if op == "!" {
+				return nil, newError(SyntaxError, i, `unexpected "!", expected "!="`)
+			}
+
+			tokens = append(tokens, token{tokenOperator, op, i})
+			i = end
+		case isWordChar(c):
+			end := i
+			for end < len(expr) && isWordChar(expr[end]) {
+				end++
+			}
+
+			tokens = append(tokens, token{tokenWord, expr[i:end], i})
+			i = end
+		default:
+			return nil, newError(SyntaxError, i, "unexpected character %q", c)
+		}
+	}
+
+	return append(tokens, token{tokenEOF, "", len(expr)}), nil
+}
+
+// lexString returns the position after the closing quote of the string
+// starting at pos.
+func lexString(expr string, pos int) (int, error) {
+	for i := pos + 1; i < len(expr); i++ {
+		switch expr[i] {
+		case '\\':
+			i++
+		case '"':
+			return i + 1, nil
+		}
+	}
+
+	return 0, newError(SyntaxError, pos, "unterminated string")
+}
+
+// isWordChar returns if the byte can be part of a bare word, any other value
+// should be quoted.
+func isWordChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' ||
+		strings.IndexByte("_.-+", c) != -1
+}
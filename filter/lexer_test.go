@@ -0,0 +1,63 @@
+package filter
+
+import (
+	"testing"
+
+	. "gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type FilterSuite struct{}
+
+var _ = Suite(&FilterSuite{})
+
+func (s *FilterSuite) TestLex(c *C) {
+	tokens, err := lex(`price.amount>=10 AND tags:in(a,"b c") OR name~"^foo\""`)
+	c.Assert(err, IsNil)
+
+	var kinds []tokenKind
+	var values []string
+	for _, t := range tokens {
+		kinds = append(kinds, t.kind)
+		values = append(values, t.value)
+	}
+
+	c.Assert(kinds, DeepEquals, []tokenKind{
+		tokenWord, tokenOperator, tokenWord, tokenWord,
+		tokenWord, tokenColon, tokenWord, tokenLParen, tokenWord, tokenComma,
+		tokenString, tokenRParen, tokenWord, tokenWord, tokenOperator,
+		tokenString, tokenEOF,
+	})
+
+	c.Assert(values, DeepEquals, []string{
+		"price.amount", ">=", "10", "AND", "tags", ":", "in", "(", "a", ",",
+		"b c", ")", "OR", "name", "~", `^foo"`, "",
+	})
+}
+
+func (s *FilterSuite) TestLexOperators(c *C) {
+	tokens, err := lex(`= != > >= < <= ~`)
+	c.Assert(err, IsNil)
+	c.Assert(tokens, HasLen, 8)
+
+	for i, op := range []string{"=", "!=", ">", ">=", "<", "<=", "~"} {
+		c.Assert(tokens[i].kind, Equals, tokenOperator)
+		c.Assert(tokens[i].value, Equals, op)
+	}
+}
+
+func (s *FilterSuite) TestLexErrors(c *C) {
+	for expr, pos := range map[string]int{
+		`name="foo`: 5,
+		`name!foo`:  4,
+		`name=foo;`: 8,
+		`name=$gt`:  5,
+		`name="\q"`: 5,
+	} {
+		_, err := lex(expr)
+		c.Assert(err, NotNil, Commentf(expr))
+		c.Assert(err.(*Error).Code, Equals, SyntaxError)
+		c.Assert(err.(*Error).Position, Equals, pos, Commentf(expr))
+	}
+}
@@ -0,0 +1,590 @@
+// Package filter parses filter expressions, as the ones received on the query
+// parameters of an HTTP API, into queries validated against the Schema of a
+// model:
+//
+//	price.amount>10 AND tags:in(a,b) AND name~"^foo"
+//
+// A comparison is a field followed by an operator and a value, or by a colon
+// and a function with its arguments. Comparisons can be joined with AND and OR,
+// AND having the higher precedence, and grouped with parentheses. Values
+// containing characters other than letters, digits, `_`, `.`, `-` and `+`
+// should be double quoted, eg: dates as `created>"2016-01-02T03:04:05Z"`.
+//
+// The regular expressions are run by the server, so the RegEx operator is
+// disabled by default, see Parser.RegEx.
+package filter
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"gopkg.in/mgo.v2/bson"
+	"gopkg.in/src-d/storable.v1"
+	"gopkg.in/src-d/storable.v1/operators"
+)
+
+// Operator is an operator supported by the expressions.
+type Operator string
+
+const (
+	Eq     Operator = "="
+	Ne     Operator = "!="
+	Gt     Operator = ">"
+	Gte    Operator = ">="
+	Lt     Operator = "<"
+	Lte    Operator = "<="
+	RegEx  Operator = "~"
+	In     Operator = "in"
+	Nin    Operator = "nin"
+	All    Operator = "all"
+	Exists Operator = "exists"
+)
+
+// DefaultMaxClauses is the default value of Parser.MaxClauses.
+const DefaultMaxClauses = 32
+
+// DefaultMaxDepth is the default value of Parser.MaxDepth.
+const DefaultMaxDepth = 8
+
+// DefaultMaxValues is the default value of Parser.MaxValues.
+const DefaultMaxValues = 64
+
+// DefaultMaxRegExLength is the default value of Parser.MaxRegExLength.
+const DefaultMaxRegExLength = 64
+
+// RegExMode is how the values of the RegEx operator are used.
+type RegExMode int
+
+const (
+	// RegExDisabled rejects the RegEx operator, the patterns are run by the
+	// PCRE engine of the server and a malicious one can backtrack
+	// catastrophically.
+	RegExDisabled RegExMode = iota
+	// RegExLiteral escapes the value, matching the strings containing it.
+	RegExLiteral
+	// RegExPattern uses the value as a regular expression, only for
+	// expressions coming from trusted sources.
+	RegExPattern
+)
+
+// Parser parses expressions for the fields of a schema.
+type Parser struct {
+	// MaxClauses is the maximum number of comparisons an expression can
+	// contain, zero means no limit.
+	MaxClauses int
+	// MaxDepth is the maximum number of nested parentheses an expression can
+	// contain, zero means no limit.
+	MaxDepth int
+	// MaxValues is the maximum number of values of the in, nin and all
+	// functions, zero means no limit.
+	MaxValues int
+	// RegEx is how the values of the RegEx operator are used, by default the
+	// operator is disabled.
+	RegEx RegExMode
+	// MaxRegExLength is the maximum length of the values of the RegEx
+	// operator, zero means no limit.
+	MaxRegExLength int
+
+	fields    map[string]storable.Field
+	allowed   map[string]bool
+	operators map[Operator]bool
+}
+
+// NewParser returns a new Parser for the fields of the given schema, usually
+// the schema of a model from the generated Schema variable, eg:
+// `NewParser(Schema.Product)`, or a *storable.ModelInfo from the registry. By
+// default every field of the schema, plus the _id, and every operator are
+// allowed.
+func NewParser(schema interface{}) *Parser {
+	p := &Parser{
+		MaxClauses:     DefaultMaxClauses,
+		MaxDepth:       DefaultMaxDepth,
+		MaxValues:      DefaultMaxValues,
+		MaxRegExLength: DefaultMaxRegExLength,
+		fields:         map[string]storable.Field{"_id": storable.IdField},
+	}
+
+	fields, _ := storable.SchemaFields(schema)
+	for _, f := range fields {
+		p.fields[f.String()] = f
+	}
+
+	return p
+}
+
+// Allow restricts the fields that can be used on the expressions to the given
+// ones.
+func (p *Parser) Allow(fields ...storable.Field) *Parser {
+	if p.allowed == nil {
+		p.allowed = make(map[string]bool)
+	}
+
+	for _, f := range fields {
+		p.allowed[f.String()] = true
+	}
+
+	return p
+}
+
+// AllowOperators restricts the operators that can be used on the expressions
+// to the given ones.
+func (p *Parser) AllowOperators(ops ...Operator) *Parser {
+	if p.operators == nil {
+		p.operators = make(map[Operator]bool)
+	}
+
+	for _, op := range ops {
+		p.operators[op] = true
+	}
+
+	return p
+}
+
+// Fields returns the names of the fields that can be used on the expressions.
+func (p *Parser) Fields() []string {
+	var names []string
+	for name := range p.fields {
+		if p.allowed == nil || p.allowed[name] {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// Parse parses the expression returning a BaseQuery, an empty expression
+// returns a query without criteria. The returned error, if any, is always an
+// *Error.
+func (p *Parser) Parse(expr string) (*storable.BaseQuery, error) {
+	q := storable.NewBaseQuery()
+	if err := p.ParseInto(q, expr); err != nil {
+		return nil, err
+	}
+
+	return q, nil
+}
+
+// ParseInto parses the expression adding its criteria to the given query,
+// allowing to use it with the queries of the generated stores.
+func (p *Parser) ParseInto(q *storable.BaseQuery, expr string) error {
+	clauses, err := p.parse(expr)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range clauses {
+		q.AddCriteria(c)
+	}
+
+	return nil
+}
+
+func (p *Parser) parse(expr string) ([]bson.M, error) {
+	tokens, err := lex(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &state{Parser: p, tokens: tokens}
+	if s.peek().kind == tokenEOF {
+		return nil, nil
+	}
+
+	clauses, err := s.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if t := s.peek(); t.kind != tokenEOF {
+		return nil, newError(SyntaxError, t.pos, "unexpected %s %q", t.kind, t.value)
+	}
+
+	return clauses, nil
+}
+
+type state struct {
+	*Parser
+	tokens  []token
+	pos     int
+	clauses int
+	depth   int
+}
+
+func (s *state) peek() token {
+	return s.tokens[s.pos]
+}
+
+func (s *state) next() token {
+	t := s.tokens[s.pos]
+	if t.kind != tokenEOF {
+		s.pos++
+	}
+
+	return t
+}
+
+func (s *state) expect(kind tokenKind) (token, error) {
+	t := s.next()
+	if t.kind != kind {
+		return t, newError(SyntaxError, t.pos, "expected %s, found %s", kind, t.kind)
+	}
+
+	return t, nil
+}
+
+// parseOr returns the clauses to be joined with $and, an expression
+// containing an OR returns a single $or clause.
+func (s *state) parseOr() ([]bson.M, error) {
+	var groups [][]bson.M
+	for {
+		clauses, err := s.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		groups = append(groups, clauses)
+		if !s.peek().isKeyword("OR") {
+			break
+		}
+
+		s.next()
+	}
+
+	if len(groups) == 1 {
+		return groups[0], nil
+	}
+
+	var or []bson.M
+	for _, g := range groups {
+		or = append(or, joinAnd(g))
+	}
+
+	return []bson.M{operators.Or(or...)}, nil
+}
+
+func (s *state) parseAnd() ([]bson.M, error) {
+	var clauses []bson.M
+	for {
+		c, err := s.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+
+		clauses = append(clauses, c...)
+		if !s.peek().isKeyword("AND") {
+			return clauses, nil
+		}
+
+		s.next()
+	}
+}
+
+func (s *state) parseTerm() ([]bson.M, error) {
+	if s.peek().kind != tokenLParen {
+		c, err := s.parseComparison()
+		if err != nil {
+			return nil, err
+		}
+
+		return []bson.M{c}, nil
+	}
+
+	t := s.next()
+	s.depth++
+	if s.MaxDepth > 0 && s.depth > s.MaxDepth {
+		return nil, newError(SyntaxError, t.pos, "too many nested parentheses, maximum is %d", s.MaxDepth)
+	}
+
+	clauses, err := s.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.expect(tokenRParen); err != nil {
+		return nil, err
+	}
+
+	s.depth--
+	return clauses, nil
+}
+
+func (s *state) parseComparison() (bson.M, error) {
+	t, err := s.expect(tokenWord)
+	if err != nil {
+		return nil, err
+	}
+
+	field, err := s.field(t)
+	if err != nil {
+		return nil, err
+	}
+
+	s.clauses++
+	if s.MaxClauses > 0 && s.clauses > s.MaxClauses {
+		return nil, newError(SyntaxError, t.pos, "too many comparisons, maximum is %d", s.MaxClauses)
+	}
+
+	opToken := s.next()
+	switch opToken.kind {
+	case tokenOperator:
+		op := Operator(opToken.value)
+		if err := s.checkOperator(field, op, opToken.pos); err != nil {
+			return nil, err
+		}
+
+		value, err := s.parseValue(field, op)
+		if err != nil {
+			return nil, err
+		}
+
+		return comparison(field, op, value), nil
+	case tokenColon:
+		return s.parseFunction(field)
+	}
+
+	return nil, newError(SyntaxError, opToken.pos, "expected operator after %q, found %s", field, opToken.kind)
+}
+
+func (s *state) parseFunction(field storable.Field) (bson.M, error) {
+	t, err := s.expect(tokenWord)
+	if err != nil {
+		return nil, err
+	}
+
+	op := Operator(t.value)
+	switch op {
+	case In, Nin, All, Exists:
+	default:
+		return nil, newError(SyntaxError, t.pos, "unknown function %q", t.value)
+	}
+
+	if err := s.checkOperator(field, op, t.pos); err != nil {
+		return nil, err
+	}
+
+	if op == Exists {
+		return s.parseExists(field)
+	}
+
+	if _, err := s.expect(tokenLParen); err != nil {
+		return nil, err
+	}
+
+	var values []interface{}
+	for s.peek().kind != tokenRParen {
+		if len(values) != 0 {
+			if _, err := s.expect(tokenComma); err != nil {
+				return nil, err
+			}
+		}
+
+		if s.MaxValues > 0 && len(values) == s.MaxValues {
+			return nil, newError(SyntaxError, s.peek().pos, "too many values, maximum is %d", s.MaxValues)
+		}
+
+		v, err := s.parseValue(field, op)
+		if err != nil {
+			return nil, err
+		}
+
+		values = append(values, v)
+	}
+
+	s.next()
+	switch op {
+	case In:
+		return operators.In(field, values...), nil
+	case Nin:
+		return operators.Nin(field, values...), nil
+	}
+
+	return operators.All(field, values...), nil
+}
+
+// parseExists parses the optional argument of exists, `field:exists` is the
+// same as `field:exists(true)`.
+func (s *state) parseExists(field storable.Field) (bson.M, error) {
+	if s.peek().kind != tokenLParen {
+		return operators.Exists(field, true), nil
+	}
+
+	s.next()
+	t := s.next()
+	exists, err := strconv.ParseBool(t.value)
+	if err != nil || (t.kind != tokenWord && t.kind != tokenString) {
+		return nil, &Error{
+			Code: InvalidValue, Position: t.pos, Field: field.String(),
+			Operator: Exists, Value: t.value,
+			Message: fmt.Sprintf("exists expects true or false, found %q", t.value),
+		}
+	}
+
+	if _, err := s.expect(tokenRParen); err != nil {
+		return nil, err
+	}
+
+	return operators.Exists(field, exists), nil
+}
+
+func (s *state) parseValue(field storable.Field, op Operator) (interface{}, error) {
+	t := s.next()
+	if t.kind != tokenWord && t.kind != tokenString {
+		return nil, newError(SyntaxError, t.pos, "expected value, found %s", t.kind)
+	}
+
+	var v interface{}
+	var err error
+	if op == RegEx {
+		v, err = s.regEx(t.value)
+	} else {
+		v, err = convert(field.Type(), t.value)
+	}
+
+	if err != nil {
+		return nil, &Error{
+			Code: InvalidValue, Position: t.pos, Field: field.String(),
+			Operator: op, Value: t.value, Message: err.Error(),
+		}
+	}
+
+	return v, nil
+}
+
+func (s *state) field(t token) (storable.Field, error) {
+	f, ok := s.fields[t.value]
+	if !ok {
+		err := newError(UnknownField, t.pos, "unknown field %q", t.value)
+		err.Field = t.value
+		return f, err
+	}
+
+	if s.allowed != nil && !s.allowed[t.value] {
+		err := newError(FieldNotAllowed, t.pos, "field %q is not allowed", t.value)
+		err.Field = t.value
+		return f, err
+	}
+
+	return f, nil
+}
+
+func (s *state) checkOperator(field storable.Field, op Operator, pos int) error {
+	var msg string
+	switch {
+	case s.operators != nil && !s.operators[op]:
+		msg = fmt.Sprintf("operator %q is not allowed", op)
+	case op == RegEx && s.RegEx == RegExDisabled:
+		msg = fmt.Sprintf("operator %q is disabled", op)
+	case op == RegEx && field.Type() != "string":
+		msg = fmt.Sprintf("operator %q can't be used with field %q of type %s", op, field, field.Type())
+	default:
+		return nil
+	}
+
+	return &Error{
+		Code: OperatorNotAllowed, Position: pos, Field: field.String(),
+		Operator: op, Message: msg,
+	}
+}
+
+func comparison(field storable.Field, op Operator, value interface{}) bson.M {
+	switch op {
+	case Ne:
+		return operators.Ne(field, value)
+	case Gt:
+		return operators.Gt(field, value)
+	case Gte:
+		return operators.Gte(field, value)
+	case Lt:
+		return operators.Lt(field, value)
+	case Lte:
+		return operators.Lte(field, value)
+	case RegEx:
+		return operators.RegEx(field, value.(string), "")
+	}
+
+	return operators.Eq(field, value)
+}
+
+func joinAnd(clauses []bson.M) bson.M {
+	if len(clauses) == 1 {
+		return clauses[0]
+	}
+
+	return operators.And(clauses...)
+}
+
+// regEx returns the pattern of a value of the RegEx operator, escaped unless
+// the mode is RegExPattern.
+func (s *state) regEx(value string) (string, error) {
+	if s.MaxRegExLength != 0 && len(value) > s.MaxRegExLength {
+		return "", fmt.Errorf("regular expression longer than %d bytes", s.MaxRegExLength)
+	}
+
+	if s.RegEx != RegExPattern {
+		return regexp.QuoteMeta(value), nil
+	}
+
+	if _, err := regexp.Compile(value); err != nil {
+		return "", fmt.Errorf("invalid regular expression: %s", err)
+	}
+
+	return value, nil
+}
+
+// dateLayouts are the accepted formats of the values of time.Time fields.
+var dateLayouts = []string{time.RFC3339Nano, "2006-01-02"}
+
+// convert returns the value converted to the given type, the types are the
+// ones returned by storable.Field.Type.
+func convert(typ, value string) (interface{}, error) {
+	switch typ {
+	case "string":
+		return value, nil
+	case "bool":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid boolean %q", value)
+		}
+
+		return b, nil
+	case "int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64":
+		i, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid integer %q", value)
+		}
+
+		if typ == "int64" || typ == "uint64" {
+			return i, nil
+		}
+
+		return int(i), nil
+	case "float32", "float64":
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q", value)
+		}
+
+		return f, nil
+	case "time.Time":
+		for _, layout := range dateLayouts {
+			if t, err := time.Parse(layout, value); err == nil {
+				return t, nil
+			}
+		}
+
+		return nil, fmt.Errorf("invalid date %q, expected RFC 3339", value)
+	case "bson.ObjectId":
+		if !bson.IsObjectIdHex(value) {
+			return nil, fmt.Errorf("invalid object id %q", value)
+		}
+
+		return bson.ObjectIdHex(value), nil
+	}
+
+	return nil, fmt.Errorf("fields of type %s can't be filtered", typ)
+}
@@ -0,0 +1,201 @@
+package filter
+
+import (
+	"encoding/json"
+	"time"
+
+	. "gopkg.in/check.v1"
+	"gopkg.in/mgo.v2/bson"
+	"gopkg.in/src-d/storable.v1"
+)
+
+type schemaProduct struct {
+	Name      storable.Field
+	Status    storable.Field
+	Active    storable.Field
+	CreatedAt storable.Field
+	Owner     storable.Field
+	Price     *schemaProductPrice
+	Tags      storable.Field
+	Meta      storable.Map
+}
+
+type schemaProductPrice struct {
+	Amount storable.Field
+	Count  storable.Field
+}
+
+var productSchema = &schemaProduct{
+	Name:      storable.NewField("name", "string"),
+	Status:    storable.NewField("status", "int"),
+	Active:    storable.NewField("active", "bool"),
+	CreatedAt: storable.NewField("createdat", "time.Time"),
+	Owner:     storable.NewField("owner", "bson.ObjectId"),
+	Price: &schemaProductPrice{
+		Amount: storable.NewField("price.amount", "float64"),
+		Count:  storable.NewField("price.count", "int64"),
+	},
+	Tags: storable.NewField("tags", "string"),
+	Meta: storable.NewMap("meta.[map]", "string"),
+}
+
+func (s *FilterSuite) TestParserFields(c *C) {
+	p := NewParser(productSchema)
+	c.Assert(p.Fields(), DeepEquals, []string{
+		"_id", "active", "createdat", "name", "owner",
+		"price.amount", "price.count", "status", "tags",
+	})
+
+	p.Allow(productSchema.Name, productSchema.Price.Amount)
+	c.Assert(p.Fields(), DeepEquals, []string{"name", "price.amount"})
+}
+
+func (s *FilterSuite) TestParse(c *C) {
+	p := NewParser(productSchema)
+	p.RegEx = RegExPattern
+
+	q, err := p.Parse(
+		`price.amount>10 AND tags:in(a,b) AND name~"^foo"`,
+	)
+
+	c.Assert(err, IsNil)
+	c.Assert(q.GetCriteria(), DeepEquals, bson.M{"$and": []bson.M{
+		{"price.amount": bson.M{"$gt": 10.0}},
+		{"tags": bson.M{"$in": []interface{}{"a", "b"}}},
+		{"name": bson.M{"$regex": bson.RegEx{Pattern: "^foo"}}},
+	}})
+}
+
+func (s *FilterSuite) TestParseEmpty(c *C) {
+	q, err := NewParser(productSchema).Parse("  ")
+	c.Assert(err, IsNil)
+	c.Assert(q.GetCriteria(), IsNil)
+}
+
+func (s *FilterSuite) TestParseValues(c *C) {
+	owner := bson.NewObjectId()
+	date := time.Date(2016, time.January, 2, 3, 4, 5, 0, time.UTC)
+
+	for expr, expected := range map[string]bson.M{
+		`status=1`:                          {"status": bson.M{"$eq": 1}},
+		`status!="2"`:                       {"status": bson.M{"$ne": 2}},
+		`price.count<=-3`:                   {"price.count": bson.M{"$lte": int64(-3)}},
+		`price.amount<1.5`:                  {"price.amount": bson.M{"$lt": 1.5}},
+		`active=true`:                       {"active": bson.M{"$eq": true}},
+		`createdat>="2016-01-02T03:04:05Z"`: {"createdat": bson.M{"$gte": date}},
+		`createdat>2016-01-02`:              {"createdat": bson.M{"$gt": date.Truncate(24 * time.Hour)}},
+		`owner=` + owner.Hex():              {"owner": bson.M{"$eq": owner}},
+		`_id:nin(` + owner.Hex() + `)`:      {"_id": bson.M{"$nin": []interface{}{owner}}},
+		`tags:all(a, "b c")`:                {"tags": bson.M{"$all": []interface{}{"a", "b c"}}},
+		`tags:exists`:                       {"tags": bson.M{"$exists": true}},
+		`tags:exists(false)`:                {"tags": bson.M{"$exists": false}},
+		`name="AND"`:                        {"name": bson.M{"$eq": "AND"}},
+	} {
+		q, err := NewParser(productSchema).Parse(expr)
+		c.Assert(err, IsNil, Commentf(expr))
+		c.Assert(q.GetCriteria(), DeepEquals, bson.M{"$and": []bson.M{expected}}, Commentf(expr))
+	}
+}
+
+func (s *FilterSuite) TestParseOr(c *C) {
+	q, err := NewParser(productSchema).Parse(
+		`status=1 AND (name=foo OR name=bar and tags:exists) AND active=true`,
+	)
+
+	c.Assert(err, IsNil)
+	c.Assert(q.GetCriteria(), DeepEquals, bson.M{"$and": []bson.M{
+		{"status": bson.M{"$eq": 1}},
+		{"$or": []bson.M{
+			{"name": bson.M{"$eq": "foo"}},
+			{"$and": []bson.M{
+				{"name": bson.M{"$eq": "bar"}},
+				{"tags": bson.M{"$exists": true}},
+			}},
+		}},
+		{"active": bson.M{"$eq": true}},
+	}})
+}
+
+func (s *FilterSuite) TestParseInto(c *C) {
+	q := storable.NewBaseQuery()
+	q.AddCriteria(bson.M{"foo": "bar"})
+
+	err := NewParser(productSchema).ParseInto(q, "status=1")
+	c.Assert(err, IsNil)
+	c.Assert(q.GetCriteria(), DeepEquals, bson.M{"$and": []bson.M{
+		{"foo": "bar"},
+		{"status": bson.M{"$eq": 1}},
+	}})
+}
+
+func (s *FilterSuite) TestParseErrors(c *C) {
+	p := NewParser(productSchema).
+		Allow(productSchema.Name, productSchema.Status, productSchema.Tags).
+		AllowOperators(Eq, Gt, RegEx, In, Exists)
+	p.MaxClauses = 3
+	p.MaxDepth = 2
+	p.MaxValues = 2
+	p.RegEx = RegExPattern
+
+	for expr, expected := range map[string]Error{
+		`foo=1`:                                 {Code: UnknownField, Field: "foo", Position: 0},
+		`name=a AND meta.foo=1`:                 {Code: UnknownField, Field: "meta.foo", Position: 11},
+		`price.amount>1`:                        {Code: FieldNotAllowed, Field: "price.amount", Position: 0},
+		`status<1`:                              {Code: OperatorNotAllowed, Field: "status", Operator: Lt, Position: 6},
+		`tags:nin(a)`:                           {Code: OperatorNotAllowed, Field: "tags", Operator: Nin, Position: 5},
+		`status~"^1"`:                           {Code: OperatorNotAllowed, Field: "status", Operator: RegEx, Position: 6},
+		`status=foo`:                            {Code: InvalidValue, Field: "status", Operator: Eq, Value: "foo", Position: 7},
+		`tags:in(a,b`:                           {Code: SyntaxError, Position: 11},
+		`tags:exists(maybe)`:                    {Code: InvalidValue, Field: "tags", Operator: Exists, Value: "maybe", Position: 12},
+		`tags:foo(a)`:                           {Code: SyntaxError, Position: 5},
+		`name~"("`:                              {Code: InvalidValue, Field: "name", Operator: RegEx, Value: "(", Position: 5},
+		`name=a name=b`:                         {Code: SyntaxError, Position: 7},
+		`(name=a`:                               {Code: SyntaxError, Position: 7},
+		`name`:                                  {Code: SyntaxError, Position: 4},
+		`name=`:                                 {Code: SyntaxError, Position: 5},
+		`name=a AND`:                            {Code: SyntaxError, Position: 10},
+		`name=a AND name=b OR name=c OR name=d`: {Code: SyntaxError, Position: 31},
+		`(((name=a)))`:                          {Code: SyntaxError, Position: 2},
+		`tags:in(a,b,c)`:                        {Code: SyntaxError, Position: 12},
+	} {
+		_, err := p.Parse(expr)
+		c.Assert(err, NotNil, Commentf(expr))
+
+		e := err.(*Error)
+		e.Message = ""
+		c.Assert(*e, DeepEquals, expected, Commentf(expr))
+	}
+}
+
+func (s *FilterSuite) TestParseRegEx(c *C) {
+	p := NewParser(productSchema)
+
+	_, err := p.Parse(`name~"^foo"`)
+	c.Assert(err, NotNil)
+	c.Assert(err.(*Error).Code, Equals, OperatorNotAllowed)
+
+	p.RegEx = RegExLiteral
+	q, err := p.Parse(`name~"a.b(c"`)
+	c.Assert(err, IsNil)
+	c.Assert(q.GetCriteria(), DeepEquals, bson.M{"$and": []bson.M{
+		{"name": bson.M{"$regex": bson.RegEx{Pattern: `a\.b\(c`}}},
+	}})
+
+	p.MaxRegExLength = 4
+	_, err = p.Parse(`name~"abcde"`)
+	c.Assert(err, NotNil)
+	c.Assert(err.(*Error).Code, Equals, InvalidValue)
+}
+
+func (s *FilterSuite) TestErrorJSON(c *C) {
+	_, err := NewParser(productSchema).Parse("foo=1")
+	b, _ := json.Marshal(err)
+	c.Assert(string(b), Equals,
+		`{"code":"unknown_field","message":"unknown field \"foo\"","field":"foo","position":0}`,
+	)
+}
+
+func (s *FilterSuite) TestNewParserModelInfo(c *C) {
+	p := NewParser(&storable.ModelInfo{Name: "Product", Schema: productSchema})
+	c.Assert(p.Fields(), HasLen, 9)
+}
@@ -0,0 +1,89 @@
+package generator
+
+import (
+	"go/ast"
+	"go/token"
+	"strings"
+)
+
+// DirectivePrefix is the prefix of the comments used to annotate declarations
+// for the generator, eg: `//storable:finders`
+const DirectivePrefix = "//storable:"
+
+// HistoryDirective enables the history mode on a model, the previous versions
+// of the documents are kept, see storable.Store.EnableHistory:
+// `//storable:history`
+const HistoryDirective = "history"
+
+type Directive struct {
+	Name string
+	Args []string
+}
+
+type Directives []*Directive
+
+// Get returns the directive with the given name, nil if is not present.
+func (d Directives) Get(name string) *Directive {
+	for _, directive := range d {
+		if directive.Name == name {
+			return directive
+		}
+	}
+
+	return nil
+}
+
+// Has returns if the directive with the given name is present.
+func (d Directives) Has(name string) bool {
+	return d.Get(name) != nil
+}
+
+// getDirectives returns the directives found on the doc comments of the type
+// declarations, indexed by type name.
+func getDirectives(files []*ast.File) map[string]Directives {
+	directives := make(map[string]Directives, 0)
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range gen.Specs {
+				ts := spec.(*ast.TypeSpec)
+				doc := ts.Doc
+				if doc == nil && len(gen.Specs) == 1 {
+					doc = gen.Doc
+				}
+
+				if d := parseDirectives(doc); len(d) != 0 {
+					directives[ts.Name.Name] = d
+				}
+			}
+		}
+	}
+
+	return directives
+}
+
+func parseDirectives(doc *ast.CommentGroup) Directives {
+	if doc == nil {
+		return nil
+	}
+
+	var directives Directives
+	for _, comment := range doc.List {
+		if !strings.HasPrefix(comment.Text, DirectivePrefix) {
+			continue
+		}
+
+		parts := strings.Fields(comment.Text[len(DirectivePrefix):])
+		if len(parts) == 0 {
+			continue
+		}
+
+		directives = append(directives, &Directive{Name: parts[0], Args: parts[1:]})
+	}
+
+	return directives
+}
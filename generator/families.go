@@ -0,0 +1,139 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+const (
+	FamilyStoreNamePattern = "%sFamilyStore"
+	FamilyKindsNamePattern = "%sKinds"
+)
+
+// KindTag is the tag of the embedded storable.Document setting the kind of a
+// model, stored on the reserved _kind key of its documents. The models sharing
+// a collection without it get its name as kind, matching also the documents
+// without kind:
+//
+//	storable.Document `bson:",inline" collection:"animals" kind:"dog"`
+const KindTag = "kind"
+
+// kindKey is the key of the kind on the documents, see storable.KindField.
+const kindKey = "_kind"
+
+// Family is a set of models sharing a collection, the documents are
+// distinguished by its kind. The generator adds a store decoding every
+// document into the model of its kind.
+type Family struct {
+	Name       string
+	StoreName  string
+	KindsName  string
+	Collection string
+	Models     []*Model
+}
+
+func NewFamily(collection string) *Family {
+	n := familyName(collection)
+	return &Family{
+		Name:       n,
+		StoreName:  fmt.Sprintf(FamilyStoreNamePattern, n),
+		KindsName:  fmt.Sprintf(FamilyKindsNamePattern, n),
+		Collection: collection,
+	}
+}
+
+// processFamilies groups the models sharing a collection, the models of a
+// family without kind tag get its name as default kind.
+func (p *Processor) processFamilies(pkg *Package) error {
+	byCollection := make(map[string]*Family)
+	for _, m := range pkg.Models {
+		if m.Collection == "" {
+			continue
+		}
+
+		f, ok := byCollection[m.Collection]
+		if !ok {
+			f = NewFamily(m.Collection)
+			byCollection[m.Collection] = f
+		}
+
+		f.Models = append(f.Models, m)
+	}
+
+	for _, m := range pkg.Models {
+		f := byCollection[m.Collection]
+		if f == nil || len(f.Models) < 2 || f.Models[0] != m {
+			continue
+		}
+
+		kinds := make(map[string]string)
+		for _, fm := range f.Models {
+			if fm.Kind == "" {
+				fm.Kind, fm.DefaultKind = fm.Name, true
+			}
+
+			if other, ok := kinds[fm.Kind]; ok {
+				return fmt.Errorf(
+					"%s: kind %q already used by %s on collection %q",
+					fm.Name, fm.Kind, other, f.Collection,
+				)
+			}
+
+			kinds[fm.Kind] = fm.Name
+		}
+
+		pkg.Families = append(pkg.Families, f)
+	}
+
+	for _, m := range pkg.Models {
+		if m.Kind == "" {
+			continue
+		}
+
+		if f := findDbName(m.Fields, kindKey); f != nil {
+			return fmt.Errorf(
+				"%s: field %s clashes with the reserved key %q of the kind",
+				m.Name, f.Name, kindKey,
+			)
+		}
+	}
+
+	return nil
+}
+
+// findDbName returns the field stored with the given name, looking into the
+// inline structs.
+func findDbName(fields []*Field, name string) *Field {
+	for _, f := range fields {
+		if f.Inline() {
+			if found := findDbName(f.Fields, name); found != nil {
+				return found
+			}
+
+			continue
+		}
+
+		if f.DbName() == name {
+			return f
+		}
+	}
+
+	return nil
+}
+
+// familyName returns the collection name in camel case, eg: "user_events"
+// becomes "UserEvents".
+func familyName(collection string) string {
+	words := strings.FieldsFunc(collection, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	for i, w := range words {
+		r := []rune(w)
+		r[0] = unicode.ToUpper(r[0])
+		words[i] = string(r)
+	}
+
+	return strings.Join(words, "")
+}
@@ -0,0 +1,113 @@
+package generator
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+func (s *ProcessorSuite) TestFamilies(c *C) {
+	pkg := s.processFixture(`
+	package fixture
+
+	import "gopkg.in/src-d/storable.v1"
+
+	type Cat struct {
+		storable.Document ` + "`collection:\"user_animals\" kind:\"cat\"`" + `
+		Name string
+	}
+
+	type Dog struct {
+		storable.Document ` + "`collection:\"user_animals\" kind:\"dog\"`" + `
+		Name string
+	}
+
+	type Fish struct {
+		storable.Document ` + "`collection:\"user_animals\"`" + `
+		Name string
+	}
+
+	type Person struct {
+		storable.Document ` + "`collection:\"people\"`" + `
+		Name string
+	}
+	`)
+
+	c.Assert(pkg.Families, HasLen, 1)
+	f := pkg.Families[0]
+	c.Assert(f.Name, Equals, "UserAnimals")
+	c.Assert(f.StoreName, Equals, "UserAnimalsFamilyStore")
+	c.Assert(f.KindsName, Equals, "UserAnimalsKinds")
+	c.Assert(f.Collection, Equals, "user_animals")
+	c.Assert(f.Models, HasLen, 3)
+	c.Assert(f.Models[0].Kind, Equals, "cat")
+	c.Assert(f.Models[0].DefaultKind, Equals, false)
+	c.Assert(f.Models[1].Kind, Equals, "dog")
+	c.Assert(f.Models[2].Kind, Equals, "Fish")
+	c.Assert(f.Models[2].DefaultKind, Equals, true)
+
+	c.Assert(pkg.Model("Person").Kind, Equals, "")
+}
+
+func (s *ProcessorSuite) TestFamiliesWithKindField(c *C) {
+	pkg := s.processFixture(`
+	package fixture
+
+	import "gopkg.in/src-d/storable.v1"
+
+	type Cat struct {
+		storable.Document ` + "`collection:\"animals\"`" + `
+		Kind string
+	}
+
+	type Dog struct {
+		storable.Document ` + "`collection:\"animals\"`" + `
+		Kind string
+	}
+	`)
+
+	c.Assert(pkg.Families, HasLen, 1)
+	c.Assert(pkg.Model("Cat").Kind, Equals, "Cat")
+	c.Assert(pkg.Model("Dog").Kind, Equals, "Dog")
+}
+
+func (s *ProcessorSuite) TestFamiliesKindClash(c *C) {
+	_, err := s.tryProcessFixture(`
+	package fixture
+
+	import "gopkg.in/src-d/storable.v1"
+
+	type Meta struct {
+		Type string ` + "`bson:\"_kind\"`" + `
+	}
+
+	type Cat struct {
+		storable.Document ` + "`collection:\"animals\" kind:\"cat\"`" + `
+		Meta ` + "`bson:\",inline\"`" + `
+	}
+	`)
+
+	c.Assert(err, ErrorMatches, `Cat: field Type clashes with the reserved key "_kind" of the kind`)
+}
+
+func (s *ProcessorSuite) TestFamiliesDuplicatedKind(c *C) {
+	_, err := s.tryProcessFixture(`
+	package fixture
+
+	import "gopkg.in/src-d/storable.v1"
+
+	type Cat struct {
+		storable.Document ` + "`collection:\"animals\" kind:\"pet\"`" + `
+	}
+
+	type Dog struct {
+		storable.Document ` + "`collection:\"animals\" kind:\"pet\"`" + `
+	}
+	`)
+
+	c.Assert(err, ErrorMatches, `Dog: kind "pet" already used by Cat on collection "animals"`)
+}
+
+func (s *ProcessorSuite) TestFamilyName(c *C) {
+	c.Assert(familyName("animals"), Equals, "Animals")
+	c.Assert(familyName("user_events"), Equals, "UserEvents")
+	c.Assert(familyName("user-events.v2"), Equals, "UserEventsV2")
+}
@@ -0,0 +1,412 @@
+package generator
+
+import (
+	"fmt"
+	"go/types"
+	"regexp"
+	"strings"
+)
+
+// FindersDirective marks an interface as a set of finder methods to be
+// implemented by the store of a model, the model name can be given as
+// argument, if not the name of the interface without the `Finders` suffix is
+// used: `//storable:finders Product`
+const FindersDirective = "finders"
+
+var finderName = regexp.MustCompile(`^(Find|Count)By([A-Z].*)$`)
+
+type finderKind int
+
+const (
+	findOne finderKind = iota
+	findAll
+	findResultSet
+	count
+)
+
+type finderOperator struct {
+	// Suffix is the keyword used on the method name after the field name.
+	Suffix string
+	// Func is the function from the operators package.
+	Func string
+	// Multi the argument is a slice of values of the type of the field.
+	Multi bool
+}
+
+// finderOperators sorted from the longest suffix to the shortest, the first
+// one matching is used.
+var finderOperators = []finderOperator{
+	{"GreaterThanEqual", "Gte", false},
+	{"GreaterThan", "Gt", false},
+	{"LessThanEqual", "Lte", false},
+	{"LessThan", "Lt", false},
+	{"Contains", "Eq", false},
+	{"Matches", "RegEx", false},
+	{"Exists", "Exists", false},
+	{"NotIn", "Nin", true},
+	{"Not", "Ne", false},
+	{"In", "In", true},
+	{"", "Eq", false},
+}
+
+// Finder is a method declared on a finders interface, the criteria of the
+// query is derived from the name of the method.
+type Finder struct {
+	Name      string
+	Interface string
+	Params    string
+	Results   string
+	Criteria  []*FinderCriteria
+	kind      finderKind
+	model     *Model
+}
+
+// Return returns the code of the return statement of the method.
+func (f *Finder) Return() string {
+	switch f.kind {
+	case findAll:
+		return "rs, err := s.Find(q)\nif err != nil {\nreturn nil, err\n}\n\nreturn rs.All()"
+	case findResultSet:
+		return "return s.Find(q)"
+	case count:
+		return "return s.Store.Count(q)"
+	}
+
+	return "return s.FindOne(q)"
+}
+
+type FinderCriteria struct {
+	// Schema is the expression to access to the field on the Schema variable.
+	Schema   string
+	Operator finderOperator
+	Arg      string
+	index    int
+}
+
+// Code returns the code adding the criteria to a query called `q`.
+func (c *FinderCriteria) Code() string {
+	switch {
+	case c.Operator.Multi:
+		vs := fmt.Sprintf("vs%d", c.index)
+		return fmt.Sprintf(
+			"var %[1]s []interface{}\nfor _, v := range %[2]s {\n%[1]s = append(%[1]s, v)\n}\nq.AddCriteria(operators.%[3]s(%[4]s, %[1]s...))",
+			vs, c.Arg, c.Operator.Func, c.Schema,
+		)
+	case c.Operator.Func == "RegEx":
+		return fmt.Sprintf("q.AddCriteria(operators.RegEx(%s, %s, \"\"))", c.Schema, c.Arg)
+	}
+
+	return fmt.Sprintf("q.AddCriteria(operators.%s(%s, %s))", c.Operator.Func, c.Schema, c.Arg)
+}
+
+func (p *Processor) processFinders(pkg *Package, name string, iface *types.Interface, d *Directive) error {
+	modelName := strings.TrimSuffix(name, "Finders")
+	if len(d.Args) != 0 {
+		modelName = d.Args[0]
+	}
+
+	m := pkg.Model(modelName)
+	if m == nil {
+		return fmt.Errorf("%s: unknown model %q", name, modelName)
+	}
+
+	for i := 0; i < iface.NumMethods(); i++ {
+		f, err := newFinder(m, iface.Method(i))
+		if err != nil {
+			return fmt.Errorf("%s.%s: %s", name, iface.Method(i).Name(), err)
+		}
+
+		f.Interface = name
+		m.Finders = append(m.Finders, f)
+	}
+
+	m.FinderInterfaces = append(m.FinderInterfaces, name)
+	return nil
+}
+
+func newFinder(m *Model, fun *types.Func) (*Finder, error) {
+	match := finderName.FindStringSubmatch(fun.Name())
+	if match == nil {
+		return nil, fmt.Errorf("invalid finder name, should be FindBy* or CountBy*")
+	}
+
+	f := &Finder{Name: fun.Name(), model: m}
+	sig := fun.Type().(*types.Signature)
+	if err := f.processResults(match[1], sig); err != nil {
+		return nil, err
+	}
+
+	params := f.processParams(sig)
+	if err := f.processCriteria(match[2], params); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+func (f *Finder) processResults(prefix string, sig *types.Signature) error {
+	res := sig.Results()
+	if res.Len() != 2 || !isBuiltinError(res.At(1).Type()) {
+		return fmt.Errorf("finders should return two values, the last one an error")
+	}
+
+	typ := res.At(0).Type()
+	result := typeString(typ, f.model.Package)
+
+	switch {
+	case prefix == "Count":
+		if b, ok := typ.(*types.Basic); !ok || b.Kind() != types.Int {
+			return fmt.Errorf("CountBy* finders should return (int, error)")
+		}
+
+		f.kind = count
+	case isTypeOrPtrTo(typ, f.model.CheckedNode):
+		f.kind = findOne
+	case isSliceOfPtrTo(typ, f.model.CheckedNode):
+		f.kind = findAll
+	case isPtrToInvalid(typ) || typ == types.Typ[types.Invalid]:
+		// the ResultSet is not defined until the code is generated
+		f.kind = findResultSet
+		result = "*" + f.model.ResultSetName
+	default:
+		return fmt.Errorf(
+			"FindBy* finders should return *%[1]s, []*%[1]s or *%[2]s and an error",
+			f.model.Name, f.model.ResultSetName,
+		)
+	}
+
+	f.Results = fmt.Sprintf("(%s, error)", result)
+	return nil
+}
+
+func (f *Finder) processParams(sig *types.Signature) []*types.Var {
+	var args []string
+	var params []*types.Var
+
+	for i := 0; i < sig.Params().Len(); i++ {
+		param := sig.Params().At(i)
+
+		name := param.Name()
+		if name == "" || name == "_" || name == "s" || name == "q" {
+			name = fmt.Sprintf("arg%d", i)
+		}
+
+		typeName := typeString(param.Type(), f.model.Package)
+		if sig.Variadic() && i == sig.Params().Len()-1 {
+			typeName = "..." + strings.TrimPrefix(typeName, "[]")
+		}
+
+		args = append(args, name+" "+typeName)
+		params = append(params, types.NewParam(param.Pos(), param.Pkg(), name, param.Type()))
+	}
+
+	f.Params = strings.Join(args, ", ")
+	return params
+}
+
+func (f *Finder) processCriteria(predicate string, params []*types.Var) error {
+	for predicate != "" {
+		path, field, rest := matchModelField(f.model, predicate)
+		if field == nil {
+			return fmt.Errorf("unknown field at %q", predicate)
+		}
+
+		op, rest := matchFinderOperator(rest)
+		if rest != "" {
+			if !strings.HasPrefix(rest, "And") || len(rest) == len("And") {
+				return fmt.Errorf("unexpected %q, expected And", rest)
+			}
+
+			rest = rest[len("And"):]
+		}
+
+		i := len(f.Criteria)
+		if i >= len(params) {
+			return fmt.Errorf("missing argument for %s", field.Name)
+		}
+
+		if err := checkFinderArgument(field, op, params[i]); err != nil {
+			return err
+		}
+
+		f.Criteria = append(f.Criteria, &FinderCriteria{
+			Schema:   path,
+			Operator: op,
+			Arg:      params[i].Name(),
+			index:    i,
+		})
+
+		predicate = rest
+	}
+
+	if len(f.Criteria) != len(params) {
+		return fmt.Errorf("expected %d argument(s), got %d", len(f.Criteria), len(params))
+	}
+
+	return nil
+}
+
+// idField is the field representing the _id of every document.
+var idField = NewField("Id", "bson.ObjectId", "")
+
+// matchModelField returns the longest field of the model matching the
+// beginning of the predicate, and the expression to reach it.
+func matchModelField(m *Model, predicate string) (path string, field *Field, rest string) {
+	path, field, rest = matchFinderField(m.Fields, predicate)
+	if field != nil {
+		path = "Schema." + m.Name + "." + path
+	}
+
+	if !strings.HasPrefix(predicate, idField.Name) {
+		return
+	}
+
+	if r := predicate[len(idField.Name):]; field == nil || len(r) < len(rest) {
+		return "storable.IdField", idField, r
+	}
+
+	return
+}
+
+// matchFinderField returns the longest field matching the beginning of the
+// predicate, and the path to reach it from the model on the Schema variable.
+func matchFinderField(fields []*Field, predicate string) (path string, field *Field, rest string) {
+	for _, f := range fields {
+		var candidates []*Field
+		if f.Inline() && f.Type == "struct" {
+			candidates = f.Fields
+		} else if strings.HasPrefix(predicate, f.Name) {
+			candidates = []*Field{f}
+		}
+
+		for _, c := range candidates {
+			p, cf, r := matchFinderSubField(c, predicate)
+			if cf != nil && (field == nil || len(r) < len(rest)) {
+				path, field, rest = p, cf, r
+			}
+		}
+	}
+
+	return
+}
+
+func matchFinderSubField(f *Field, predicate string) (path string, field *Field, rest string) {
+	if !strings.HasPrefix(predicate, f.Name) {
+		return
+	}
+
+	schema := schemaPath(f)
+	rest = predicate[len(f.Name):]
+	if f.Type != "struct" {
+		if !f.Findable() || f.ContainsMap() {
+			return "", nil, ""
+		}
+
+		return schema, f, rest
+	}
+
+	if f.ContainsMap() {
+		return "", nil, ""
+	}
+
+	return matchFinderField(f.Fields, rest)
+}
+
+// schemaPath returns the path to access to a field from its model on the
+// Schema variable.
+func schemaPath(f *Field) string {
+	var names []string
+	for recursive := f; recursive != nil; recursive = recursive.Parent {
+		names = append(names, recursive.Name)
+	}
+
+	return strings.Join(reverseSliceStrings(names), ".")
+}
+
+func matchFinderOperator(rest string) (finderOperator, string) {
+	for _, op := range finderOperators {
+		if !strings.HasPrefix(rest, op.Suffix) {
+			continue
+		}
+
+		r := rest[len(op.Suffix):]
+		if r == "" || strings.HasPrefix(r, "And") {
+			return op, r
+		}
+	}
+
+	return finderOperators[len(finderOperators)-1], rest
+}
+
+func checkFinderArgument(f *Field, op finderOperator, param *types.Var) error {
+	typ := param.Type()
+	switch op.Func {
+	case "Exists":
+		return checkFinderType(f, param, typ, types.Typ[types.Bool])
+	case "RegEx":
+		return checkFinderType(f, param, typ, types.Typ[types.String])
+	}
+
+	if f.CheckedNode == nil {
+		return nil
+	}
+
+	expected := f.CheckedNode.Type()
+	if op.Multi {
+		s, ok := typ.Underlying().(*types.Slice)
+		if !ok {
+			return fmt.Errorf("argument %s of %s should be a slice", param.Name(), f.Name)
+		}
+
+		typ = s.Elem()
+	}
+
+	if op.Suffix == "Contains" || (!isAssignable(typ, expected) && isSlice(expected)) {
+		s, ok := expected.Underlying().(*types.Slice)
+		if !ok {
+			return fmt.Errorf("field %s should be a slice", f.Name)
+		}
+
+		expected = s.Elem()
+	}
+
+	return checkFinderType(f, param, typ, expected)
+}
+
+func checkFinderType(f *Field, param *types.Var, typ, expected types.Type) error {
+	if !isAssignable(typ, expected) {
+		return fmt.Errorf(
+			"argument %s of type %s cannot be used with field %s of type %s",
+			param.Name(), typ, f.Name, expected,
+		)
+	}
+
+	return nil
+}
+
+func isAssignable(typ, expected types.Type) bool {
+	if typ == types.Typ[types.Invalid] || expected == types.Typ[types.Invalid] {
+		return true
+	}
+
+	if ptr, ok := expected.(*types.Pointer); ok && types.AssignableTo(typ, ptr.Elem()) {
+		return true
+	}
+
+	return types.AssignableTo(typ, expected)
+}
+
+func isSlice(typ types.Type) bool {
+	_, ok := typ.Underlying().(*types.Slice)
+	return ok
+}
+
+func isSliceOfPtrTo(typ types.Type, named *types.Named) bool {
+	s, ok := typ.(*types.Slice)
+	if !ok {
+		return false
+	}
+
+	ptr, ok := s.Elem().(*types.Pointer)
+	return ok && isTypeOrPtrTo(ptr, named)
+}
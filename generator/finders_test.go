@@ -0,0 +1,142 @@
+package generator
+
+import (
+	"strings"
+
+	. "gopkg.in/check.v1"
+)
+
+const findersFixture = `
+	package fixture
+
+	import (
+		"time"
+
+		"gopkg.in/mgo.v2/bson"
+		"gopkg.in/src-d/storable.v1"
+	)
+
+	var _ bson.ObjectId
+
+	type Product struct {
+		storable.Document
+		Name   string
+		Status int
+		Tags   []string
+		Price  struct {
+			Amount float64
+		}
+		Base      ` + "`bson:\",inline\"`" + `
+		CreatedAt time.Time
+	}
+
+	type Base struct {
+		Url string
+	}
+
+	%s
+	`
+
+func (s *ProcessorSuite) TestFinders(c *C) {
+	pkg := s.processFixture(fmtFinders(`
+	//storable:finders
+	type ProductFinders interface {
+		FindByNameAndStatus(name string, s int) (*Product, error)
+		FindByPriceAmountGreaterThan(amount float64) ([]*Product, error)
+		FindByUrlAndStatusIn(url string, status ...int) ([]*Product, error)
+		FindByIdIn(ids []bson.ObjectId) ([]*Product, error)
+		CountByTagsContains(tag string) (int, error)
+		CountByCreatedAtLessThan(t time.Time) (int, error)
+	}
+	`))
+
+	m := pkg.Models[0]
+	c.Assert(m.FinderInterfaces, DeepEquals, []string{"ProductFinders"})
+	c.Assert(m.Finders, HasLen, 6)
+
+	finders := make(map[string]*Finder, 0)
+	for _, f := range m.Finders {
+		finders[f.Name] = f
+	}
+
+	f := finders["FindByNameAndStatus"]
+	c.Assert(f.Name, Equals, "FindByNameAndStatus")
+	c.Assert(f.Params, Equals, "name string, arg1 int")
+	c.Assert(f.Results, Equals, "(*Product, error)")
+	c.Assert(f.Return(), Equals, "return s.FindOne(q)")
+	c.Assert(f.Criteria, HasLen, 2)
+	c.Assert(f.Criteria[0].Code(), Equals, "q.AddCriteria(operators.Eq(Schema.Product.Name, name))")
+	c.Assert(f.Criteria[1].Code(), Equals, "q.AddCriteria(operators.Eq(Schema.Product.Status, arg1))")
+
+	f = finders["FindByPriceAmountGreaterThan"]
+	c.Assert(f.Results, Equals, "([]*Product, error)")
+	c.Assert(f.Criteria[0].Code(), Equals, "q.AddCriteria(operators.Gt(Schema.Product.Price.Amount, amount))")
+
+	f = finders["FindByUrlAndStatusIn"]
+	c.Assert(f.Params, Equals, "url string, status ...int")
+	c.Assert(f.Criteria[0].Schema, Equals, "Schema.Product.Base.Url")
+	c.Assert(f.Criteria[1].Operator.Func, Equals, "In")
+
+	f = finders["FindByIdIn"]
+	c.Assert(f.Criteria[0].Schema, Equals, "storable.IdField")
+
+	f = finders["CountByTagsContains"]
+	c.Assert(f.Return(), Equals, "return s.Store.Count(q)")
+	c.Assert(f.Criteria[0].Code(), Equals, "q.AddCriteria(operators.Eq(Schema.Product.Tags, tag))")
+
+	f = finders["CountByCreatedAtLessThan"]
+	c.Assert(f.Criteria[0].Code(), Equals, "q.AddCriteria(operators.Lt(Schema.Product.CreatedAt, t))")
+}
+
+func (s *ProcessorSuite) TestFindersExplicitModel(c *C) {
+	pkg := s.processFixture(fmtFinders(`
+	//storable:finders Product
+	type Queries interface {
+		FindByName(name string) (*Product, error)
+	}
+	`))
+
+	c.Assert(pkg.Models[0].FinderInterfaces, DeepEquals, []string{"Queries"})
+}
+
+func (s *ProcessorSuite) TestFindersErrors(c *C) {
+	errors := map[string]string{
+		`FindByQux(qux string) (*Product, error)`:              `.*unknown field at "Qux"`,
+		`FindByName(name int) (*Product, error)`:               `.*argument name of type int cannot be used with field Name of type string`,
+		`FindByName() (*Product, error)`:                       `.*missing argument for Name`,
+		`FindByName(name, qux string) (*Product, error)`:       `.*expected 1 argument\(s\), got 2`,
+		`FindByNameOrStatus(name string) (*Product, error)`:    `.*unexpected "OrStatus", expected And`,
+		`FindByStatusIn(status int) (*Product, error)`:         `.*argument status of Status should be a slice`,
+		`FindByNameContains(name string) (*Product, error)`:    `.*field Name should be a slice`,
+		`FindByName(name string) (*Base, error)`:               `.*FindBy\* finders should return .*`,
+		`CountByName(name string) (*Product, error)`:           `.*CountBy\* finders should return \(int, error\)`,
+		`GetByName(name string) (*Product, error)`:             `.*invalid finder name.*`,
+		`FindByName(name string) *Product`:                     `.*finders should return two values.*`,
+		`FindByPriceAmountExists(exists string) (int, error)`:  `.*FindBy\* finders should return .*`,
+		`CountByPriceAmountExists(exists string) (int, error)`: `.*argument exists of type string cannot be used.*`,
+	}
+
+	for method, err := range errors {
+		_, e := s.tryProcessFixture(fmtFinders(`
+		//storable:finders
+		type ProductFinders interface {
+			` + method + `
+		}
+		`))
+
+		c.Assert(e, ErrorMatches, err, Commentf(method))
+	}
+}
+
+func (s *ProcessorSuite) TestFindersUnknownModel(c *C) {
+	_, err := s.tryProcessFixture(fmtFinders(`
+	//storable:finders
+	type QuxFinders interface {}
+	`))
+
+	c.Assert(err, ErrorMatches, `QuxFinders: unknown model "Qux"`)
+}
+
+func fmtFinders(decl string) string {
+	return strings.Replace(findersFixture, "%s", decl, 1)
+}
@@ -15,13 +15,21 @@ import (
 	"strings"
 )
 
-const BaseDocument = "gopkg.in/src-d/storable.v1.Document"
+const (
+	StorablePackage = "gopkg.in/src-d/storable.v1"
+	BaseDocument    = StorablePackage + ".Document"
+)
 
 type Processor struct {
 	Path       string
 	Ignore     map[string]bool
 	TypesPkg   *types.Package
 	SourceCode map[string][]byte
+	Directives map[string]Directives
+	// Scopes declared on the source files, indexed by receiver type.
+	Scopes map[string][]*Scope
+	// Methods declared on the source files, indexed by receiver type.
+	Methods map[string][]string
 }
 
 func NewProcessor(path string, ignore []string) *Processor {
@@ -99,7 +107,7 @@ func (p *Processor) parseSourceFiles(filenames []string) (*types.Package, error)
 	var files []*ast.File
 	fs := token.NewFileSet()
 	for _, filename := range filenames {
-		file, err := parser.ParseFile(fs, filename, nil, 0)
+		file, err := parser.ParseFile(fs, filename, nil, parser.ParseComments)
 		if err != nil {
 			return nil, fmt.Errorf("parsing package: %s: %s", filename, err)
 		}
@@ -107,6 +115,9 @@ func (p *Processor) parseSourceFiles(filenames []string) (*types.Package, error)
 		files = append(files, file)
 	}
 
+	p.Directives = getDirectives(files)
+	p.Scopes, p.Methods = getScopes(files)
+
 	config := types.Config{
 		FakeImportC: true,
 		Error:       func(error) {},
@@ -119,12 +130,14 @@ func (p *Processor) parseSourceFiles(filenames []string) (*types.Package, error)
 
 func (p *Processor) processTypesPkg() (*Package, error) {
 	pkg := &Package{Name: p.TypesPkg.Name()}
-	p.processPackage(pkg)
+	if err := p.processPackage(pkg); err != nil {
+		return nil, err
+	}
 
 	return pkg, nil
 }
 
-func (p *Processor) processPackage(pkg *Package) {
+func (p *Processor) processPackage(pkg *Package) error {
 	var newFuncs []*types.Func
 
 	fmt.Println("Package: ", pkg.Name)
@@ -146,7 +159,7 @@ func (p *Processor) processPackage(pkg *Package) {
 		if m := p.processStruct(name, str, s.Lookup(name).Type()); m != nil {
 			fmt.Printf("Found: %s\n", m)
 			if err := m.Validate(); err != nil {
-				panic(err)
+				return err
 			}
 
 			pkg.Models = append(pkg.Models, m)
@@ -161,6 +174,41 @@ func (p *Processor) processPackage(pkg *Package) {
 	for _, fun := range newFuncs {
 		p.tryMatchNewFunc(pkg.Models, fun)
 	}
+
+	if err := p.processScopes(pkg); err != nil {
+		return err
+	}
+
+	if err := p.processFamilies(pkg); err != nil {
+		return err
+	}
+
+	if err := p.processRefs(pkg); err != nil {
+		return err
+	}
+
+	return p.processInterfaces(pkg)
+}
+
+func (p *Processor) processInterfaces(pkg *Package) error {
+	s := p.TypesPkg.Scope()
+	for _, name := range s.Names() {
+		iface, ok := s.Lookup(name).Type().Underlying().(*types.Interface)
+		if !ok {
+			continue
+		}
+
+		d := p.Directives[name].Get(FindersDirective)
+		if d == nil {
+			continue
+		}
+
+		if err := p.processFinders(pkg, name, iface, d); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func (p *Processor) tryMatchNewFunc(models []*Model, fun *types.Func) {
@@ -218,6 +266,7 @@ func (p *Processor) processStruct(name string, s *types.Struct, t types.Type) *M
 	m.New = p.isNewPresent(name)
 	m.Init = p.isInitPresent(t)
 	m.Events = p.getEvents(name)
+	m.History = p.Directives[name].Has(HistoryDirective)
 
 	var base int
 	if base, m.Fields = p.getFields(s); base == -1 {
@@ -294,6 +343,10 @@ func (p *Processor) processFields(s *types.Struct, done []*types.Struct) (base i
 		str := p.tryGetStruct(f.Type())
 		if f.Type().String() != BaseDocument && str != nil {
 			field.Type = getStructType(f.Type())
+			if field.Type != "struct" {
+				fields = append(fields, field)
+				continue
+			}
 
 			d := false
 			for _, v := range done {
@@ -326,17 +379,43 @@ func (p *Processor) isInitPresent(t types.Type) bool {
 	return false
 }
 
+// geoTypes are the GeoJSON types from the storable package.
+var geoTypes = map[string]bool{
+	"Point": true, "LineString": true, "Polygon": true, "MultiPolygon": true,
+}
+
 func getStructType(t types.Type) string {
 	ts := t.String()
-	if ts != "time.Time" && ts != "bson.ObjectId" {
-		return "struct"
+	if ts == "time.Time" || ts == "bson.ObjectId" {
+		return ts
 	}
 
-	return ts
+	if name := elemTypeName(t); name != nil && name.Pkg() != nil &&
+		name.Pkg().Path() == StorablePackage && geoTypes[name.Name()] {
+		return "storable." + name.Name()
+	}
+
+	return "struct"
+}
+
+// elemTypeName returns the name of the type, dereferencing pointers and
+// slices of unnamed types.
+func elemTypeName(t types.Type) *types.TypeName {
+	switch t := t.(type) {
+	case *types.Named:
+		return t.Obj()
+	case *types.Pointer:
+		return elemTypeName(t.Elem())
+	case *types.Slice:
+		return elemTypeName(t.Elem())
+	}
+
+	return nil
 }
 
 func (p *Processor) processBaseField(m *Model, f *Field) {
 	m.Collection = f.Tag.Get("collection")
+	m.Kind = f.Tag.Get(KindTag)
 }
 
 func joinDirectory(directory string, files []string) []string {
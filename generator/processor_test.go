@@ -138,9 +138,68 @@ func (s *ProcessorSuite) TestDeepRecursiveStruct(c *C) {
 	c.Assert(len(pkg.Models[0].Fields[2].Fields[0].Fields[2].Fields), Equals, 0)
 }
 
+func (s *ProcessorSuite) TestGeoTypes(c *C) {
+	fixtureSrc := `
+	package fixture
+
+	import 	"gopkg.in/src-d/storable.v1"
+
+	type Shop struct {
+		storable.Document
+		Location storable.Point
+		Area     *storable.Polygon
+		Routes   []storable.LineString
+	}
+	`
+
+	pkg := s.processFixture(fixtureSrc)
+
+	fields := pkg.Models[0].Fields
+	c.Assert(fields[1].Type, Equals, "storable.Point")
+	c.Assert(fields[1].Findable(), Equals, true)
+	c.Assert(fields[1].Fields, HasLen, 0)
+	c.Assert(fields[2].Type, Equals, "storable.Polygon")
+	c.Assert(fields[2].Findable(), Equals, true)
+	c.Assert(fields[3].Type, Equals, "storable.LineString")
+	c.Assert(fields[3].Findable(), Equals, true)
+}
+
+func (s *ProcessorSuite) TestHistory(c *C) {
+	fixtureSrc := `
+  package fixture
+
+  import  "gopkg.in/src-d/storable.v1"
+
+  //storable:history
+  type HistoryExample struct {
+    storable.Document
+    Foo string
+  }
+
+  type NoHistoryExample struct {
+    storable.Document
+    Foo string
+  }
+  `
+
+	pkg := s.processFixture(fixtureSrc)
+	c.Assert(pkg.Models, HasLen, 2)
+	c.Assert(pkg.Models[0].History, Equals, true)
+	c.Assert(pkg.Models[1].History, Equals, false)
+}
+
 func (s *ProcessorSuite) processFixture(source string) *Package {
+	pkg, err := s.tryProcessFixture(source)
+	if err != nil {
+		panic(err)
+	}
+
+	return pkg
+}
+
+func (s *ProcessorSuite) tryProcessFixture(source string) (*Package, error) {
 	fset := &token.FileSet{}
-	astFile, err := parser.ParseFile(fset, "fixture.go", source, 0)
+	astFile, err := parser.ParseFile(fset, "fixture.go", source, parser.ParseComments)
 	if err != nil {
 		panic(err)
 	}
@@ -155,10 +214,7 @@ func (s *ProcessorSuite) processFixture(source string) *Package {
 
 	prc := NewProcessor("fixture", nil)
 	prc.TypesPkg = p
-	pkg, err := prc.processTypesPkg()
-	if err != nil {
-		panic(err)
-	}
-
-	return pkg
+	prc.Directives = getDirectives([]*ast.File{astFile})
+	prc.Scopes, prc.Methods = getScopes([]*ast.File{astFile})
+	return prc.processTypesPkg()
 }
@@ -0,0 +1,101 @@
+package generator
+
+import "fmt"
+
+// RefTag is the tag of the fields referencing documents of other model of the
+// package by id, the value is the collection or the name of the model:
+//
+//	CustomerId bson.ObjectId `ref:"customers"`
+//
+// The fields should be bson.ObjectId or []bson.ObjectId, the generator adds
+// to the store a Populate method loading the referenced documents.
+const RefTag = "ref"
+
+const (
+	objectIdType      = "gopkg.in/mgo.v2/bson.ObjectId"
+	objectIdSliceType = "[]" + objectIdType
+)
+
+// Ref is a field of a model referencing the documents of other model.
+type Ref struct {
+	Field  *Field
+	Target *Model
+	// Slice is true if the field is a slice of references.
+	Slice bool
+}
+
+// MethodName returns the name of the generated populate method.
+func (r *Ref) MethodName() string {
+	return "Populate" + r.Field.Name
+}
+
+// processRefs resolves the targets of the fields with ref tag.
+func (p *Processor) processRefs(pkg *Package) error {
+	for _, m := range pkg.Models {
+		for _, f := range m.Fields {
+			if err := checkNestedRefs(f.Fields); err != nil {
+				return fmt.Errorf("%s.%s: %s", m.Name, f.Name, err)
+			}
+
+			name := f.GetTagValue(RefTag)
+			if name == "" {
+				continue
+			}
+
+			r, err := newRef(pkg, f, name)
+			if err != nil {
+				return fmt.Errorf("%s.%s: %s", m.Name, f.Name, err)
+			}
+
+			m.Refs = append(m.Refs, r)
+		}
+	}
+
+	return nil
+}
+
+func newRef(pkg *Package, f *Field, name string) (*Ref, error) {
+	var typ string
+	if f.CheckedNode != nil {
+		typ = f.CheckedNode.Type().String()
+	}
+
+	if typ != objectIdType && typ != objectIdSliceType {
+		return nil, fmt.Errorf("ref fields should be bson.ObjectId or []bson.ObjectId")
+	}
+
+	target := pkg.Model(name)
+	if target == nil {
+		var found []*Model
+		for _, m := range pkg.Models {
+			if m.Collection == name {
+				found = append(found, m)
+			}
+		}
+
+		switch len(found) {
+		case 0:
+			return nil, fmt.Errorf("unknown ref %q, should be a collection or a model of the package", name)
+		case 1:
+			target = found[0]
+		default:
+			return nil, fmt.Errorf("ambiguous ref %q, the collection is shared by many models, use the model name", name)
+		}
+	}
+
+	return &Ref{Field: f, Target: target, Slice: typ == objectIdSliceType}, nil
+}
+
+func checkNestedRefs(fields []*Field) error {
+	for _, f := range fields {
+		if f.GetTagValue(RefTag) != "" {
+			return fmt.Errorf("ref fields should be declared on the model, found on %s", f.Name)
+		}
+
+		if err := checkNestedRefs(f.Fields); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package generator
+
+import (
+	"fmt"
+
+	. "gopkg.in/check.v1"
+)
+
+const refsFixture = `
+	package fixture
+
+	import (
+		"gopkg.in/mgo.v2/bson"
+		"gopkg.in/src-d/storable.v1"
+	)
+
+	type Customer struct {
+		storable.Document ` + "`collection:\"customers\"`" + `
+		Name     string
+		Referrer bson.ObjectId
+	}
+
+	type Order struct {
+		storable.Document ` + "`collection:\"orders\"`" + `
+		%s
+	}
+	`
+
+func (s *ProcessorSuite) TestRefs(c *C) {
+	pkg := s.processFixture(fmt.Sprintf(refsFixture, "CustomerId bson.ObjectId `ref:\"customers\"`\n"+
+		"WatcherIds []bson.ObjectId `ref:\"Customer\"`\n"+
+		"Name string"))
+
+	m := pkg.Model("Order")
+	c.Assert(m.Refs, HasLen, 2)
+	c.Assert(m.Refs[0].Field.Name, Equals, "CustomerId")
+	c.Assert(m.Refs[0].Target, Equals, pkg.Model("Customer"))
+	c.Assert(m.Refs[0].Slice, Equals, false)
+	c.Assert(m.Refs[0].MethodName(), Equals, "PopulateCustomerId")
+	c.Assert(m.Refs[1].Field.Name, Equals, "WatcherIds")
+	c.Assert(m.Refs[1].Target, Equals, pkg.Model("Customer"))
+	c.Assert(m.Refs[1].Slice, Equals, true)
+}
+
+func (s *ProcessorSuite) TestRefsErrors(c *C) {
+	fixtures := map[string]string{
+		"CustomerId string `ref:\"customers\"`":                  `Order.CustomerId: ref fields should be bson.ObjectId or \[\]bson.ObjectId`,
+		"CustomerId bson.ObjectId `ref:\"foo\"`":                 `Order.CustomerId: unknown ref "foo", .*`,
+		"Address struct{ Id bson.ObjectId `ref:\"customers\"` }": `Order.Address: ref fields should be declared on the model, found on Id`,
+	}
+
+	for field, expected := range fixtures {
+		_, err := s.tryProcessFixture(fmt.Sprintf(refsFixture, field))
+		c.Assert(err, ErrorMatches, expected)
+	}
+}
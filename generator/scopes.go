@@ -0,0 +1,212 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"strings"
+	"unicode"
+)
+
+// ScopeDirective marks a method of the query of a model as a named scope, the
+// methods with the `scope` prefix are scopes too. The argument `default`
+// makes the scope a default scope, applied to every query unless Unscoped is
+// called:
+//
+//	//storable:scope default
+//	func (q *ProductQuery) scopePublished() {
+//		q.AddCriteria(operators.Eq(Schema.Product.Status, Published))
+//	}
+const ScopeDirective = "scope"
+
+// BaseQuery is the name of the query embedded by the generated queries.
+const BaseQuery = "BaseQuery"
+
+const scopePrefix = "scope"
+
+// Scope is a method of a query adding reusable criteria, the generator adds
+// to the query an exported chainable method calling it.
+type Scope struct {
+	// Name is the name of the generated method.
+	Name string
+	// Method is the name of the scope method.
+	Method  string
+	Params  string
+	Args    string
+	Default bool
+
+	receiver  string
+	directive bool
+}
+
+// getScopes returns the scopes declared on the files, indexed by the name of
+// the receiver type, and the names of the methods of every type.
+func getScopes(files []*ast.File) (scopes map[string][]*Scope, methods map[string][]string) {
+	scopes = make(map[string][]*Scope, 0)
+	methods = make(map[string][]string, 0)
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			fun, ok := decl.(*ast.FuncDecl)
+			if !ok || fun.Recv == nil || len(fun.Recv.List) != 1 {
+				continue
+			}
+
+			recv := receiverName(fun.Recv.List[0].Type)
+			methods[recv] = append(methods[recv], fun.Name.Name)
+			if s := newScope(recv, fun); s != nil {
+				scopes[recv] = append(scopes[recv], s)
+			}
+		}
+	}
+
+	return scopes, methods
+}
+
+func receiverName(expr ast.Expr) string {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+
+	return ""
+}
+
+func newScope(recv string, fun *ast.FuncDecl) *Scope {
+	d := parseDirectives(fun.Doc).Get(ScopeDirective)
+	name := fun.Name.Name
+	if !hasScopePrefix(name) && d == nil {
+		return nil
+	}
+
+	s := &Scope{Method: name, receiver: recv, directive: d != nil}
+	if hasScopePrefix(name) {
+		name = name[len(scopePrefix):]
+	}
+
+	s.Name = capitalize(name)
+	if d != nil {
+		for _, arg := range d.Args {
+			if arg == "default" {
+				s.Default = true
+			}
+		}
+	}
+
+	var params, args []string
+	for _, field := range fun.Type.Params.List {
+		typ := types.ExprString(field.Type)
+		names := field.Names
+		if len(names) == 0 {
+			names = []*ast.Ident{ast.NewIdent("_")}
+		}
+
+		for _, n := range names {
+			arg := n.Name
+			if arg == "_" || arg == "q" {
+				arg = fmt.Sprintf("arg%d", len(args))
+			}
+
+			params = append(params, arg+" "+typ)
+			if strings.HasPrefix(typ, "...") {
+				arg += "..."
+			}
+
+			args = append(args, arg)
+		}
+	}
+
+	s.Params = strings.Join(params, ", ")
+	s.Args = strings.Join(args, ", ")
+	return s
+}
+
+func hasScopePrefix(name string) bool {
+	return strings.HasPrefix(name, scopePrefix) && len(name) > len(scopePrefix) &&
+		unicode.IsUpper(rune(name[len(scopePrefix)]))
+}
+
+func capitalize(name string) string {
+	if name == "" {
+		return name
+	}
+
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// generatedQueryMethods are the methods declared by the generated queries,
+// besides the ones promoted from storable.BaseQuery.
+var generatedQueryMethods = map[string]bool{
+	"FindById": true, "Unscoped": true, "Clone": true,
+}
+
+// isReservedQueryMethod returns if the name is a method of the generated
+// queries, so it cannot be used as scope name.
+func (p *Processor) isReservedQueryMethod(name string) bool {
+	if generatedQueryMethods[name] {
+		return true
+	}
+
+	for _, imp := range p.TypesPkg.Imports() {
+		if imp.Path() != StorablePackage {
+			continue
+		}
+
+		obj := imp.Scope().Lookup(BaseQuery)
+		if obj == nil {
+			return false
+		}
+
+		return types.NewMethodSet(types.NewPointer(obj.Type())).Lookup(imp, name) != nil
+	}
+
+	return false
+}
+
+func (p *Processor) processScopes(pkg *Package) error {
+	for recv, scopes := range p.Scopes {
+		var m *Model
+		for _, candidate := range pkg.Models {
+			if candidate.QueryName == recv {
+				m = candidate
+			}
+		}
+
+		for _, s := range scopes {
+			if err := p.checkScope(m, s); err != nil {
+				return fmt.Errorf("%s.%s: %s", recv, s.Method, err)
+			}
+
+			if m != nil {
+				m.Scopes = append(m.Scopes, s)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (p *Processor) checkScope(m *Model, s *Scope) error {
+	switch {
+	case m == nil && s.directive:
+		return fmt.Errorf("scopes should be declared on the query of a model")
+	case m == nil:
+		return nil
+	case ast.IsExported(s.Method):
+		return fmt.Errorf("scope methods should be unexported")
+	case s.Default && s.Params != "":
+		return fmt.Errorf("default scopes cannot have parameters")
+	case p.isReservedQueryMethod(s.Name):
+		return fmt.Errorf("%s is a reserved method name", s.Name)
+	}
+
+	for _, method := range p.Methods[s.receiver] {
+		if method == s.Name {
+			return fmt.Errorf("method %s already declared", s.Name)
+		}
+	}
+
+	return nil
+}
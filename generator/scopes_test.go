@@ -0,0 +1,75 @@
+package generator
+
+import (
+	"fmt"
+
+	. "gopkg.in/check.v1"
+)
+
+const scopesFixture = `
+	package fixture
+
+	import "gopkg.in/src-d/storable.v1"
+
+	type Product struct {
+		storable.Document
+		Name   string
+		Status int
+	}
+
+	type ProductQuery struct {
+		storable.BaseQuery
+	}
+
+	%s
+	`
+
+func (s *ProcessorSuite) TestScopes(c *C) {
+	pkg := s.processFixture(fmt.Sprintf(scopesFixture, `
+	func (q *ProductQuery) scopeCheap() {}
+
+	//storable:scope default
+	func (q *ProductQuery) scopePublished() {}
+
+	//storable:scope
+	func (q *ProductQuery) withStatus(status int, names ...string) {}
+
+	func (q *ProductQuery) scopeless() {}
+
+	func (q *ProductQuery) FindCheap() {}
+	`))
+
+	m := pkg.Models[0]
+	c.Assert(m.Scopes, HasLen, 3)
+	c.Assert(*m.Scopes[0], DeepEquals, Scope{
+		Name: "Cheap", Method: "scopeCheap", receiver: "ProductQuery",
+	})
+	c.Assert(*m.Scopes[1], DeepEquals, Scope{
+		Name: "Published", Method: "scopePublished", Default: true,
+		receiver: "ProductQuery", directive: true,
+	})
+	c.Assert(*m.Scopes[2], DeepEquals, Scope{
+		Name: "WithStatus", Method: "withStatus",
+		Params: "status int, names ...string", Args: "status, names...",
+		receiver: "ProductQuery", directive: true,
+	})
+
+	c.Assert(m.DefaultScopes(), DeepEquals, m.Scopes[1:2])
+}
+
+func (s *ProcessorSuite) TestScopesErrors(c *C) {
+	errors := map[string]string{
+		"//storable:scope\nfunc (q *ProductQuery) Cheap() {}":                       `ProductQuery.Cheap: scope methods should be unexported`,
+		"//storable:scope default\nfunc (q *ProductQuery) scopeX(n int) {}":         `.*default scopes cannot have parameters`,
+		"func (q *ProductQuery) scopeLimit() {}":                                    `.*Limit is a reserved method name`,
+		"func (q *ProductQuery) scopeHint() {}":                                     `.*Hint is a reserved method name`,
+		"func (q *ProductQuery) scopeClone() {}":                                    `.*Clone is a reserved method name`,
+		"func (q *ProductQuery) scopeCheap() {}\nfunc (q *ProductQuery) Cheap() {}": `.*method Cheap already declared`,
+		"//storable:scope\nfunc (q *Product) cheap() {}":                            `Product.cheap: scopes should be declared on the query of a model`,
+	}
+
+	for decl, err := range errors {
+		_, e := s.tryProcessFixture(fmt.Sprintf(scopesFixture, decl))
+		c.Assert(e, ErrorMatches, err, Commentf(decl))
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"go/build"
+	"go/types"
 	"io"
 	"os"
 	"path/filepath"
@@ -28,6 +29,9 @@ type TemplateField struct {
 	Name   string
 	Path   string
 	Fields interface{}
+	// Slice is true if the struct is the element of a slice, the schema of the
+	// struct embeds a storable.Field of the slice to be used with ElemMatch.
+	Slice bool
 }
 
 func (tf *TemplateField) ValidFields() []*Field {
@@ -81,6 +85,7 @@ func (td *TemplateData) LinkStruct(path string, vi interface{}) string {
 		Name:   schemaName,
 		Path:   path + name,
 		Fields: v.MethodByName("ValidFields").Call(nil)[0].Interface(),
+		Slice:  isSliceField(vi),
 	})
 
 	return name + " *" + schemaName
@@ -133,6 +138,11 @@ func (td *TemplateData) StructValue(vi interface{}, done map[interface{}]bool) s
 	done[ifc] = true
 
 	ret := name + ": &" + td.Processed[vi] + "{"
+	if isSliceField(vi) {
+		f := vi.(*Field)
+		ret += fmt.Sprintf("\nField: storable.NewField(%q, %q),", f.GetPath(), f.FindableType())
+	}
+
 	for _, v := range v.MethodByName("ValidFields").Call(nil)[0].Interface().([]*Field) {
 		ret += "\n" + td.GenVar(v, done)
 	}
@@ -141,6 +151,53 @@ func (td *TemplateData) StructValue(vi interface{}, done map[interface{}]bool) s
 	return ret
 }
 
+func isSliceField(vi interface{}) bool {
+	f, ok := vi.(*Field)
+	return ok && f.IsSlice()
+}
+
+// GenFieldInfos returns the code of the storable.FieldInfo of the fields of
+// the model, the base document is replaced by the _id field.
+func (td *TemplateData) GenFieldInfos(m *Model) string {
+	return genFieldInfos(m.Fields, m.Package)
+}
+
+func genFieldInfos(fields []*Field, pkg *types.Package) string {
+	ret := "[]*storable.FieldInfo{"
+	for _, f := range fields {
+		if f.IsBaseDocument() {
+			path := "_id"
+			if p := f.GetPath(); p != "" {
+				path = p + "." + path
+			}
+
+			ret += fmt.Sprintf("\n{Name: \"Id\", Path: %q, Type: \"bson.ObjectId\", Findable: true},", path)
+			continue
+		}
+
+		ret += fmt.Sprintf("\n{Name: %q, Path: %q, Type: %q", f.Name, f.GetPath(), f.GoType(pkg))
+		if f.Findable() {
+			ret += ", Findable: true"
+		}
+
+		if f.ContainsMap() {
+			ret += ", Map: true"
+		}
+
+		if f.Inline() {
+			ret += ", Inline: true"
+		}
+
+		if len(f.Fields) != 0 {
+			ret += ", Fields: " + genFieldInfos(f.Fields, pkg)
+		}
+
+		ret += "},"
+	}
+
+	return ret + "\n}"
+}
+
 func prettyfy(input []byte, wr io.Writer) error {
 	output, err := imports.Process("storable.go", input, nil)
 	if err != nil {
@@ -188,5 +245,10 @@ var schema *template.Template = addTemplate(base, "schema", "templates/schema.tg
 var model *template.Template = addTemplate(base, "model", "templates/model.tgo")
 var query *template.Template = addTemplate(model, "query", "templates/query.tgo")
 var resultset *template.Template = addTemplate(model, "resultset", "templates/resultset.tgo")
+var changestream *template.Template = addTemplate(model, "changestream", "templates/changestream.tgo")
+var finders *template.Template = addTemplate(model, "finders", "templates/finders.tgo")
+var refs *template.Template = addTemplate(model, "refs", "templates/refs.tgo")
+var family *template.Template = addTemplate(base, "family", "templates/family.tgo")
+var registry *template.Template = addTemplate(base, "registry", "templates/registry.tgo")
 
 var Base *Template = &Template{template: base}
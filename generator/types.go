@@ -29,11 +29,16 @@ var findableTypes = map[string]bool{
 	"time.Time":                     true,
 	"interface{}":                   true,
 	"gopkg.in/mgo.v2/bson.ObjectId": true,
+	"storable.Point":                true,
+	"storable.LineString":           true,
+	"storable.Polygon":              true,
+	"storable.MultiPolygon":         true,
 }
 
 type Package struct {
 	Name      string
 	Models    []*Model
+	Families  []*Family
 	Structs   []string
 	Functions []string
 }
@@ -48,6 +53,17 @@ func (p *Package) StructIsDefined(name string) bool {
 	return false
 }
 
+// Model returns the model with the given name, nil if not found.
+func (p *Package) Model(name string) *Model {
+	for _, m := range p.Models {
+		if m.Name == name {
+			return m
+		}
+	}
+
+	return nil
+}
+
 func (p *Package) FunctionIsDefined(name string) bool {
 	for _, n := range p.Functions {
 		if name == n {
@@ -59,37 +75,49 @@ func (p *Package) FunctionIsDefined(name string) bool {
 }
 
 const (
-	StoreNamePattern     = "%sStore"
-	QueryNamePattern     = "%sQuery"
-	ResultSetNamePattern = "%sResultSet"
+	StoreNamePattern        = "%sStore"
+	QueryNamePattern        = "%sQuery"
+	ResultSetNamePattern    = "%sResultSet"
+	ChangeStreamNamePattern = "%sChangeStream"
 )
 
 type Model struct {
-	Name          string
-	StoreName     string
-	QueryName     string
-	ResultSetName string
+	Name             string
+	StoreName        string
+	QueryName        string
+	ResultSetName    string
+	ChangeStreamName string
 
 	Collection  string
+	Kind        string
+	DefaultKind bool
 	Type        string
 	Fields      []*Field
 	New         bool
 	Init        bool
+	History     bool
 	Events      Events
+	Finders     []*Finder
+	Scopes      []*Scope
+	Refs        []*Ref
 	CheckedNode *types.Named
 	NewFunc     *types.Func
 	Package     *types.Package
+
+	// FinderInterfaces the interfaces implemented by the finders.
+	FinderInterfaces []string
 }
 
 func NewModel(n string) *Model {
 	return &Model{
-		Name:          n,
-		StoreName:     fmt.Sprintf(StoreNamePattern, n),
-		QueryName:     fmt.Sprintf(QueryNamePattern, n),
-		ResultSetName: fmt.Sprintf(ResultSetNamePattern, n),
-		Type:          "struct",
-		Fields:        make([]*Field, 0),
-		Events:        make([]Event, 0),
+		Name:             n,
+		StoreName:        fmt.Sprintf(StoreNamePattern, n),
+		QueryName:        fmt.Sprintf(QueryNamePattern, n),
+		ResultSetName:    fmt.Sprintf(ResultSetNamePattern, n),
+		ChangeStreamName: fmt.Sprintf(ChangeStreamNamePattern, n),
+		Type:             "struct",
+		Fields:           make([]*Field, 0),
+		Events:           make([]Event, 0),
 	}
 }
 
@@ -102,6 +130,18 @@ func (m *Model) String() string {
 	return fmt.Sprintf("%q [%d Field(s)] [Events: %s]", m.Name, len(m.Fields), events)
 }
 
+// DefaultScopes returns the scopes applied by default to every query.
+func (m *Model) DefaultScopes() []*Scope {
+	var scopes []*Scope
+	for _, s := range m.Scopes {
+		if s.Default {
+			scopes = append(scopes, s)
+		}
+	}
+
+	return scopes
+}
+
 func (m *Model) ValidFields() []*Field {
 	var fields []*Field
 	for _, f := range m.Fields {
@@ -390,6 +430,41 @@ func (f *Field) Findable() bool {
 	return findableTypes[f.FindableType()]
 }
 
+// IsSlice returns if the field is a slice or a pointer to a slice.
+func (f *Field) IsSlice() bool {
+	if f.CheckedNode == nil {
+		return strings.HasPrefix(f.Type, "[]")
+	}
+
+	typ := f.CheckedNode.Type().Underlying()
+	if ptr, ok := typ.(*types.Pointer); ok {
+		typ = ptr.Elem().Underlying()
+	}
+
+	_, ok := typ.(*types.Slice)
+	return ok
+}
+
+// IsBaseDocument returns if the field is the embedded storable.Document.
+func (f *Field) IsBaseDocument() bool {
+	return f.CheckedNode != nil && f.CheckedNode.Type().String() == BaseDocument
+}
+
+// GoType returns the Go type of the field as is written on the given package.
+func (f *Field) GoType(pkg *types.Package) string {
+	if f.CheckedNode == nil {
+		return f.Type
+	}
+
+	return types.TypeString(f.CheckedNode.Type(), func(p *types.Package) string {
+		if p == pkg {
+			return ""
+		}
+
+		return p.Name()
+	})
+}
+
 func (f *Field) String() string {
 	return f.Name
 }
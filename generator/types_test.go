@@ -27,3 +27,8 @@ func (s *TypesSuite) TestFieldInline(c *C) {
 		c.Assert(NewField("", "", reflect.StructTag(t.tag)).Inline(), Equals, t.inline)
 	}
 }
+
+func (s *TypesSuite) TestFieldIsSlice(c *C) {
+	c.Assert(NewField("", "[]int", "").IsSlice(), Equals, true)
+	c.Assert(NewField("", "int", "").IsSlice(), Equals, false)
+}
@@ -0,0 +1,217 @@
+package storable
+
+import (
+	"errors"
+	"fmt"
+
+	"gopkg.in/mgo.v2/bson"
+)
+
+// ErrInvalidGeoJSON is returned when a GeoJSON object from the database does
+// not match the type of the field.
+var ErrInvalidGeoJSON = errors.New("invalid GeoJSON object")
+
+// GeoJSON types, http://geojson.org/geojson-spec.html
+const (
+	PointType        = "Point"
+	LineStringType   = "LineString"
+	PolygonType      = "Polygon"
+	MultiPolygonType = "MultiPolygon"
+)
+
+type geoJSON struct {
+	Type        string      `bson:"type"`
+	Coordinates interface{} `bson:"coordinates"`
+}
+
+type rawGeoJSON struct {
+	Type        string   `bson:"type"`
+	Coordinates bson.Raw `bson:"coordinates"`
+}
+
+func unmarshalGeoJSON(raw bson.Raw, typ string, coordinates interface{}) error {
+	var g rawGeoJSON
+	if err := raw.Unmarshal(&g); err != nil {
+		return err
+	}
+
+	if g.Type != typ {
+		return fmt.Errorf("%s: expected %s, found %q", ErrInvalidGeoJSON, typ, g.Type)
+	}
+
+	return g.Coordinates.Unmarshal(coordinates)
+}
+
+// Point is a GeoJSON point, stored as `{type: "Point", coordinates: [lng, lat]}`.
+type Point struct {
+	Longitude float64
+	Latitude  float64
+}
+
+// NewPoint returns a new Point, notice that the longitude goes first.
+func NewPoint(longitude, latitude float64) Point {
+	return Point{Longitude: longitude, Latitude: latitude}
+}
+
+func (p Point) coordinates() []float64 {
+	return []float64{p.Longitude, p.Latitude}
+}
+
+func newPoint(c []float64) (Point, error) {
+	if len(c) != 2 {
+		return Point{}, fmt.Errorf("%s: a position should have 2 elements", ErrInvalidGeoJSON)
+	}
+
+	return NewPoint(c[0], c[1]), nil
+}
+
+// GetBSON implements bson.Getter.
+func (p Point) GetBSON() (interface{}, error) {
+	return geoJSON{PointType, p.coordinates()}, nil
+}
+
+// SetBSON implements bson.Setter.
+func (p *Point) SetBSON(raw bson.Raw) error {
+	var c []float64
+	if err := unmarshalGeoJSON(raw, PointType, &c); err != nil {
+		return err
+	}
+
+	point, err := newPoint(c)
+	if err != nil {
+		return err
+	}
+
+	*p = point
+	return nil
+}
+
+// LineString is a GeoJSON line string of two or more points.
+type LineString []Point
+
+func (l LineString) coordinates() [][]float64 {
+	c := make([][]float64, len(l))
+	for i, p := range l {
+		c[i] = p.coordinates()
+	}
+
+	return c
+}
+
+func newLineString(c [][]float64) (LineString, error) {
+	l := make(LineString, len(c))
+	for i, pc := range c {
+		p, err := newPoint(pc)
+		if err != nil {
+			return nil, err
+		}
+
+		l[i] = p
+	}
+
+	return l, nil
+}
+
+// GetBSON implements bson.Getter.
+func (l LineString) GetBSON() (interface{}, error) {
+	return geoJSON{LineStringType, l.coordinates()}, nil
+}
+
+// SetBSON implements bson.Setter.
+func (l *LineString) SetBSON(raw bson.Raw) error {
+	var c [][]float64
+	if err := unmarshalGeoJSON(raw, LineStringType, &c); err != nil {
+		return err
+	}
+
+	line, err := newLineString(c)
+	if err != nil {
+		return err
+	}
+
+	*l = line
+	return nil
+}
+
+// Polygon is a GeoJSON polygon, the first ring is the exterior one and the
+// rest are holes. Every ring should be closed, the first and the last points
+// are the same.
+type Polygon [][]Point
+
+func (p Polygon) coordinates() [][][]float64 {
+	c := make([][][]float64, len(p))
+	for i, ring := range p {
+		c[i] = LineString(ring).coordinates()
+	}
+
+	return c
+}
+
+func newPolygon(c [][][]float64) (Polygon, error) {
+	p := make(Polygon, len(c))
+	for i, rc := range c {
+		ring, err := newLineString(rc)
+		if err != nil {
+			return nil, err
+		}
+
+		p[i] = ring
+	}
+
+	return p, nil
+}
+
+// GetBSON implements bson.Getter.
+func (p Polygon) GetBSON() (interface{}, error) {
+	return geoJSON{PolygonType, p.coordinates()}, nil
+}
+
+// SetBSON implements bson.Setter.
+func (p *Polygon) SetBSON(raw bson.Raw) error {
+	var c [][][]float64
+	if err := unmarshalGeoJSON(raw, PolygonType, &c); err != nil {
+		return err
+	}
+
+	polygon, err := newPolygon(c)
+	if err != nil {
+		return err
+	}
+
+	*p = polygon
+	return nil
+}
+
+// MultiPolygon is a GeoJSON multi polygon.
+type MultiPolygon []Polygon
+
+// GetBSON implements bson.Getter.
+func (m MultiPolygon) GetBSON() (interface{}, error) {
+	c := make([][][][]float64, len(m))
+	for i, p := range m {
+		c[i] = p.coordinates()
+	}
+
+	return geoJSON{MultiPolygonType, c}, nil
+}
+
+// SetBSON implements bson.Setter.
+func (m *MultiPolygon) SetBSON(raw bson.Raw) error {
+	var c [][][][]float64
+	if err := unmarshalGeoJSON(raw, MultiPolygonType, &c); err != nil {
+		return err
+	}
+
+	multi := make(MultiPolygon, len(c))
+	for i, pc := range c {
+		p, err := newPolygon(pc)
+		if err != nil {
+			return err
+		}
+
+		multi[i] = p
+	}
+
+	*m = multi
+	return nil
+}
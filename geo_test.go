@@ -0,0 +1,74 @@
+package storable
+
+import (
+	. "gopkg.in/check.v1"
+	"gopkg.in/mgo.v2/bson"
+)
+
+type geoFixture struct {
+	Point        Point
+	LineString   LineString
+	Polygon      Polygon
+	MultiPolygon MultiPolygon
+}
+
+var square = Polygon{{{0, 0}, {0, 1}, {1, 1}, {1, 0}, {0, 0}}}
+
+func (s *BaseSuite) TestGeoJSON_Marshal(c *C) {
+	b, err := bson.Marshal(geoFixture{
+		Point:        NewPoint(1, 2),
+		LineString:   LineString{{1, 2}, {3, 4}},
+		Polygon:      square,
+		MultiPolygon: MultiPolygon{square},
+	})
+	c.Assert(err, IsNil)
+
+	var m bson.M
+	c.Assert(bson.Unmarshal(b, &m), IsNil)
+	c.Assert(m["point"], DeepEquals, bson.M{
+		"type": "Point", "coordinates": []interface{}{1.0, 2.0},
+	})
+
+	c.Assert(m["linestring"], DeepEquals, bson.M{
+		"type": "LineString", "coordinates": []interface{}{
+			[]interface{}{1.0, 2.0}, []interface{}{3.0, 4.0},
+		},
+	})
+
+	c.Assert(m["polygon"].(bson.M)["type"], Equals, "Polygon")
+	c.Assert(m["multipolygon"].(bson.M)["type"], Equals, "MultiPolygon")
+}
+
+func (s *BaseSuite) TestGeoJSON_RoundTrip(c *C) {
+	expected := geoFixture{
+		Point:        NewPoint(1, 2),
+		LineString:   LineString{{1, 2}, {3, 4}},
+		Polygon:      square,
+		MultiPolygon: MultiPolygon{square, square},
+	}
+
+	b, err := bson.Marshal(expected)
+	c.Assert(err, IsNil)
+
+	var obtained geoFixture
+	c.Assert(bson.Unmarshal(b, &obtained), IsNil)
+	c.Assert(obtained, DeepEquals, expected)
+}
+
+func (s *BaseSuite) TestGeoJSON_InvalidType(c *C) {
+	b, err := bson.Marshal(bson.M{"point": bson.M{
+		"type": "LineString", "coordinates": []float64{1, 2},
+	}})
+	c.Assert(err, IsNil)
+
+	var obtained geoFixture
+	err = bson.Unmarshal(b, &obtained)
+	c.Assert(err, ErrorMatches, "invalid GeoJSON object: expected Point.*")
+}
+
+func (s *BaseSuite) TestIndexKind_Key(c *C) {
+	f := NewField("location", "storable.Point")
+	c.Assert(Geo2DSphereIndex.Key(f), Equals, "$2dsphere:location")
+	c.Assert(DescIndex.Key(f), Equals, "-location")
+	c.Assert(AscIndex.Key(f), Equals, "location")
+}
@@ -0,0 +1,374 @@
+package storable
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+const (
+	// HistorySuffix is the suffix of the name of the history collection.
+	HistorySuffix = "_history"
+	// HistoryVersionsSuffix is the suffix of the name of the collection with
+	// the last version of every document.
+	HistoryVersionsSuffix = "_history_versions"
+)
+
+// namespaceExists is the error code of the creation of an existing collection.
+const namespaceExists = 48
+
+// Revision is a prior version of a document, stored in the history
+// collection when the document is updated, saved or deleted.
+type Revision struct {
+	Id         bson.ObjectId `bson:"_id"`
+	DocumentId bson.ObjectId `bson:"documentid"`
+	// Version is the version of the document, starting at 1. The versions
+	// are increasing in the order the revisions are written, outside a
+	// transaction the ones of concurrent writes may not follow the order of
+	// the writes.
+	Version int `bson:"version"`
+	// Operation is the write replacing this version, update or delete.
+	Operation OperationType `bson:"operation"`
+	// Timestamp is the time when this version was replaced.
+	Timestamp time.Time `bson:"timestamp"`
+	// Actor is the actor of the write, see WithActor.
+	Actor string `bson:"actor,omitempty"`
+	// Changes are the changes turning this version into the next one, see
+	// Diff, empty on deletes.
+	Changes Changes `bson:"changes,omitempty"`
+	// Document is the document on this version.
+	Document bson.Raw `bson:"document"`
+}
+
+// Decode unmarshals the document of the revision into doc.
+func (r *Revision) Decode(doc interface{}) error {
+	return r.Document.Unmarshal(doc)
+}
+
+type actorKey struct{}
+
+// WithActor returns a copy of the context with the given actor, recorded on
+// the history by the stores using the context, see Store.WithContext.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext returns the actor of the context, empty if none.
+func ActorFromContext(ctx context.Context) string {
+	actor, _ := ctx.Value(actorKey{}).(string)
+	return actor
+}
+
+// history is the state of the history mode of a store, shared by its copies.
+type history struct {
+	m       sync.Mutex
+	ensured bool
+}
+
+// EnableHistory enables the history mode, the previous version of the
+// documents is stored in the <collection>_history collection by Update,
+// UpdateDiff, Save and Delete, with the index created on the first write. The
+// previous version is the one returned by the write, and the history is
+// written after the document, use a transaction to write them atomically,
+// see WithTransaction.
+func (s *Store) EnableHistory() {
+	s.history = &history{}
+}
+
+// WithContext returns a copy of the store using the given context, the actor
+// of the context is recorded on the history, see WithActor.
+func (s *Store) WithContext(ctx context.Context) *Store {
+	c := *s
+	c.ctx = ctx
+
+	return &c
+}
+
+// getContext returns the context of the store, or the one of its transaction.
+func (s *Store) getContext() context.Context {
+	if s.ctx != nil {
+		return s.ctx
+	}
+
+	if s.tx != nil {
+		return s.tx.Context()
+	}
+
+	return context.Background()
+}
+
+// raw returns a copy of the store without strict mode, history nor kind, used
+// for the internal queries.
+func (s *Store) raw() *Store {
+	c := *s
+	c.history, c.schema, c.kind = nil, nil, ""
+
+	return &c
+}
+
+// historyStore returns the store of the history collection.
+func (s *Store) historyStore() *Store {
+	c := s.raw()
+	c.collection = s.collection + HistorySuffix
+
+	return c
+}
+
+// EnsureHistoryIndex creates the unique index of the history collection, on
+// the document id and the version, and the collection of the versions. It is
+// called by the first write with the history enabled.
+func (s *Store) EnsureHistoryIndex() error {
+	// the collections cannot be created inside a transaction
+	h := s.historyStore()
+	h.tx = nil
+
+	err := h.EnsureIndex(mgo.Index{
+		Key:    []string{"documentid", "version"},
+		Unique: true,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	sess := s.db.Session.Copy()
+	defer sess.Close()
+
+	err = sess.DB(s.db.Name).C(s.collection + HistoryVersionsSuffix).Create(&mgo.CollectionInfo{})
+	if code, ok := errorCode(err); ok && code == namespaceExists {
+		return nil
+	}
+
+	return err
+}
+
+func (s *Store) ensureHistory() error {
+	s.history.m.Lock()
+	defer s.history.m.Unlock()
+	if s.history.ensured {
+		return nil
+	}
+
+	err := s.EnsureHistoryIndex()
+	s.history.ensured = err == nil
+	return err
+}
+
+// archivedWrite applies the given change to the document with the given id
+// with findAndModify, writing the version replaced by next, a document or the
+// Changes applied, or deleted if next is nil, to the history. The version is the one returned by the write,
+// so every version is archived even with concurrent writes.
+func (s *Store) archivedWrite(id bson.ObjectId, change mgo.Change, next interface{}) (*mgo.ChangeInfo, error) {
+	if err := s.ensureHistory(); err != nil {
+		return nil, err
+	}
+
+	// the write is logged by the callers
+	c := s.raw()
+	c.oplogCollection = ""
+
+	var current bson.Raw
+	info, err := c.FindAndModify(s.idQuery(id), change, &current)
+	if err == ErrNotFound {
+		// as the writes without history
+		return nil, mgo.ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if current.Kind != 0x03 {
+		return info, nil
+	}
+
+	return info, s.archive(id, current, next)
+}
+
+// archive writes the given version of the document with the given id to the
+// history, replaced by next or deleted if next is nil.
+func (s *Store) archive(id bson.ObjectId, current bson.Raw, next interface{}) error {
+	version, err := s.nextVersion(id)
+	if err != nil {
+		return err
+	}
+
+	r := &Revision{
+		Id:         bson.NewObjectId(),
+		DocumentId: id,
+		Version:    version,
+		Operation:  DeleteOperation,
+		Timestamp:  time.Now(),
+		Actor:      ActorFromContext(s.getContext()),
+		Document:   current,
+	}
+
+	if next != nil {
+		r.Operation = UpdateOperation
+		if r.Changes, err = revisionChanges(current, next); err != nil {
+			return err
+		}
+	}
+
+	sess, c := s.historyStore().getSessionAndCollection()
+	defer sess.Close()
+
+	if s.tx != nil {
+		return insertCmd(c, s.tx, r)
+	}
+
+	return c.Insert(r)
+}
+
+// versionsStore returns the store of the collection of the versions.
+func (s *Store) versionsStore() *Store {
+	c := s.raw()
+	c.collection = s.collection + HistoryVersionsSuffix
+	c.oplogCollection = ""
+
+	return c
+}
+
+// versions is the document of the last version of a document, with its
+// creation time.
+type versions struct {
+	Version int       `bson:"version"`
+	Created time.Time `bson:"created,omitempty"`
+}
+
+// created records the creation time of the document with the given id, used
+// by AsOf, nothing is done if the history is disabled.
+func (s *Store) created(id bson.ObjectId) error {
+	if s.history == nil {
+		return nil
+	}
+
+	if err := s.ensureHistory(); err != nil {
+		return err
+	}
+
+	var result versions
+	_, err := s.versionsStore().FindAndModify(idQuery(id), mgo.Change{
+		Update: bson.M{"$setOnInsert": bson.M{"created": time.Now()}},
+		Upsert: true,
+	}, &result)
+
+	return err
+}
+
+// createdAt returns the creation time of the document with the given id, or
+// the time of its id if it is not recorded, with seconds precision, as on
+// the documents written before enabling the history.
+func (s *Store) createdAt(id bson.ObjectId) (time.Time, error) {
+	rs, err := s.versionsStore().Find(idQuery(id))
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var result versions
+	if err := rs.One(&result); err != nil && err != ErrNotFound {
+		return time.Time{}, err
+	}
+
+	if result.Created.IsZero() {
+		return id.Time(), nil
+	}
+
+	return result.Created, nil
+}
+
+// nextVersion increments atomically the version of the document with the
+// given id, returning the new one.
+func (s *Store) nextVersion(id bson.ObjectId) (int, error) {
+	var result versions
+	_, err := s.versionsStore().FindAndModify(idQuery(id), mgo.Change{
+		Update:    bson.M{"$inc": bson.M{"version": 1}},
+		Upsert:    true,
+		ReturnNew: true,
+	}, &result)
+
+	return result.Version, err
+}
+
+// History returns the prior versions of the document with the given id,
+// sorted by version.
+func (s *Store) History(id bson.ObjectId) ([]*Revision, error) {
+	q := NewBaseQuery()
+	q.AddCriteria(bson.M{"documentid": id})
+	q.Sort(Sort{{NewField("version", "int"), Asc}})
+
+	rs, err := s.historyStore().Find(q)
+	if err != nil {
+		return nil, err
+	}
+
+	var revisions []*Revision
+	return revisions, rs.All(&revisions)
+}
+
+// AsOf unmarshals into result the version of the document with the given id
+// at the given time, from the history or the current document. ErrNotFound
+// is returned if the document did not exist at that time, the creation time
+// is recorded on insert, the time of the id is used for the documents written
+// before enabling the history.
+func (s *Store) AsOf(id bson.ObjectId, t time.Time, result interface{}) error {
+	created, err := s.createdAt(id)
+	if err != nil {
+		return err
+	}
+
+	if created.After(t) {
+		return ErrNotFound
+	}
+
+	q := NewBaseQuery()
+	q.AddCriteria(bson.M{"documentid": id, "timestamp": bson.M{"$gt": t}})
+	q.Sort(Sort{{NewField("version", "int"), Asc}})
+	q.Limit(1)
+
+	rs, err := s.historyStore().Find(q)
+	if err != nil {
+		return err
+	}
+
+	var r Revision
+	err = rs.One(&r)
+	if err == nil {
+		return r.Decode(result)
+	}
+
+	if err != ErrNotFound {
+		return err
+	}
+
+	rs, err = s.raw().Find(s.idQuery(id))
+	if err != nil {
+		return err
+	}
+
+	return rs.One(result)
+}
+
+// revisionChanges returns the changes turning the current document into
+// next, see Diff. If next are the Changes written, as on UpdateDiff, they are
+// returned as they are.
+func revisionChanges(current bson.Raw, next interface{}) (Changes, error) {
+	if changes, ok := next.(Changes); ok {
+		return changes, nil
+	}
+
+	var prev bson.D
+	if err := current.Unmarshal(&prev); err != nil {
+		return nil, err
+	}
+
+	doc, err := marshalDoc(next)
+	if err != nil {
+		return nil, err
+	}
+
+	return diffDocs(nil, "", prev, doc), nil
+}
@@ -0,0 +1,48 @@
+package storable
+
+import (
+	"context"
+
+	. "gopkg.in/check.v1"
+	"gopkg.in/mgo.v2/bson"
+)
+
+func (s *BaseSuite) TestActorFromContext(c *C) {
+	ctx := context.Background()
+	c.Assert(ActorFromContext(ctx), Equals, "")
+	c.Assert(ActorFromContext(WithActor(ctx, "foo")), Equals, "foo")
+}
+
+func (s *BaseSuite) TestStore_WithContext(c *C) {
+	st := NewStore(s.db, "test")
+	c.Assert(st.getContext(), Equals, context.Background())
+
+	ctx := WithActor(context.Background(), "foo")
+	c.Assert(st.WithContext(ctx).getContext(), Equals, ctx)
+
+	tx := &Tx{ctx: ctx}
+	c.Assert(st.InTx(tx).getContext(), Equals, ctx)
+}
+
+func (s *BaseSuite) TestRevisionChanges(c *C) {
+	p := NewPerson("qux")
+	p.SetId(bson.NewObjectId())
+	p.Gender = "foo"
+
+	data, err := bson.Marshal(bson.M{
+		"_id": p.Id, "firstname": "foo", "lastname": "", "gender": "foo", "old": true,
+	})
+	c.Assert(err, IsNil)
+
+	changes, err := revisionChanges(bson.Raw{Kind: 0x03, Data: data}, p)
+	c.Assert(err, IsNil)
+	c.Assert(changes.Update(), DeepEquals, bson.M{
+		"$set":   bson.M{"firstname": "qux"},
+		"$unset": bson.M{"old": ""},
+	})
+
+	written := Changes{{Op: ReplaceOp, Path: "gender", Old: "foo", New: "bar"}}
+	changes, err = revisionChanges(bson.Raw{Kind: 0x03, Data: data}, written)
+	c.Assert(err, IsNil)
+	c.Assert(changes, DeepEquals, written)
+}
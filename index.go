@@ -0,0 +1,64 @@
+package storable
+
+import (
+	"strings"
+
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// IndexKind is the kind of a key of an index.
+type IndexKind string
+
+const (
+	AscIndex         IndexKind = ""
+	DescIndex        IndexKind = "-"
+	TextIndex        IndexKind = "$text:"
+	HashedIndex      IndexKind = "$hashed:"
+	Geo2DSphereIndex IndexKind = "$2dsphere:"
+)
+
+// Key returns the key of an index over the given field, as is expected by
+// mgo.Index, eg:
+//
+//	store.EnsureIndex(mgo.Index{
+//		Key: []string{storable.Geo2DSphereIndex.Key(Schema.Shop.Location)},
+//	})
+func (k IndexKind) Key(f Field) string {
+	return string(k) + f.String()
+}
+
+// indexKeyDoc returns the document of an index key, as the ones returned by
+// IndexKind.Key, eg: {"name": 1, "location": "2dsphere"}.
+func indexKeyDoc(key []string) bson.D {
+	var d bson.D
+	for _, k := range key {
+		var v interface{} = 1
+		switch {
+		case strings.HasPrefix(k, string(DescIndex)):
+			k, v = k[1:], -1
+		case strings.HasPrefix(k, "$"):
+			if i := strings.Index(k, ":"); i != -1 {
+				k, v = k[i+1:], k[1:i]
+			}
+		}
+
+		d = append(d, bson.DocElem{Name: k, Value: v})
+	}
+
+	return d
+}
+
+// EnsureIndex creates the index on the collection if it does not exist. The
+// queries with a Collation only use the indexes with the same collation:
+//
+//	store.EnsureIndex(mgo.Index{
+//		Key:       []string{storable.AscIndex.Key(Schema.Person.Name)},
+//		Collation: &mgo.Collation{Locale: "en", Strength: 2},
+//	})
+func (s *Store) EnsureIndex(index mgo.Index) error {
+	sess, c := s.getSessionAndCollection()
+	defer sess.Close()
+
+	return c.EnsureIndex(index)
+}
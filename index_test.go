@@ -0,0 +1,19 @@
+package storable
+
+import (
+	. "gopkg.in/check.v1"
+	"gopkg.in/mgo.v2/bson"
+)
+
+func (s *BaseSuite) TestIndexKeyDoc(c *C) {
+	f := NewField("location", "")
+	c.Assert(indexKeyDoc([]string{
+		AscIndex.Key(IdField),
+		DescIndex.Key(f),
+		Geo2DSphereIndex.Key(f),
+	}), DeepEquals, bson.D{
+		{Name: "_id", Value: 1},
+		{Name: "location", Value: -1},
+		{Name: "location", Value: "2dsphere"},
+	})
+}
@@ -0,0 +1,240 @@
+package storable
+
+import (
+	"errors"
+	"reflect"
+
+	"gopkg.in/mgo.v2/bson"
+	"gopkg.in/src-d/storable.v1/operators"
+)
+
+var (
+	// ErrUnknownKind is returned when a document with a kind not present on
+	// the Kinds is decoded
+	ErrUnknownKind = errors.New("Cannot decode a document of an unknown kind.")
+)
+
+// KindField is the reserved field holding the discriminator of the models
+// sharing a collection, written by the stores with kind, see Store.SetKind.
+// The models do not declare it.
+var KindField = NewField("_kind", "string")
+
+// Kinds maps the kinds of the models sharing a collection to its types, the
+// generated code declares the Kinds of every collection shared by more than
+// one model. The type of the documents without kind, as the ones written
+// before the collection was shared, can be given with an empty kind.
+type Kinds map[string]reflect.Type
+
+// Decode unmarshals the given document into a new instance of the model of
+// its kind. ErrUnknownKind is returned if its kind is not present.
+func (k Kinds) Decode(raw bson.Raw) (DocumentBase, error) {
+	var d struct {
+		Kind string `bson:"_kind"`
+	}
+
+	if err := raw.Unmarshal(&d); err != nil {
+		return nil, err
+	}
+
+	typ, ok := k[d.Kind]
+	if !ok {
+		return nil, ErrUnknownKind
+	}
+
+	doc, ok := reflect.New(typ).Interface().(DocumentBase)
+	if !ok {
+		return nil, ErrUnknownKind
+	}
+
+	if err := raw.Unmarshal(doc); err != nil {
+		return nil, err
+	}
+
+	if i, ok := doc.(initializer); ok {
+		return doc, i.Init(doc)
+	}
+
+	return doc, nil
+}
+
+type initializer interface {
+	Init(doc DocumentBase) error
+}
+
+// KindResultSet is a ResultSet decoding every document into the model of its
+// kind, see Store.FindKinds.
+type KindResultSet struct {
+	ResultSet
+	kinds   Kinds
+	last    DocumentBase
+	lastErr error
+}
+
+// All returns all documents on the resultset and close the resultset.
+func (r *KindResultSet) All() ([]DocumentBase, error) {
+	var raws []bson.Raw
+	if err := r.ResultSet.All(&raws); err != nil {
+		return nil, err
+	}
+
+	result := make([]DocumentBase, len(raws))
+	for i, raw := range raws {
+		doc, err := r.kinds.Decode(raw)
+		if err != nil {
+			return nil, err
+		}
+
+		result[i] = doc
+	}
+
+	return result, nil
+}
+
+// One returns the first document on the resultset and close the resultset.
+func (r *KindResultSet) One() (DocumentBase, error) {
+	var raw bson.Raw
+	if err := r.ResultSet.One(&raw); err != nil {
+		return nil, err
+	}
+
+	return r.kinds.Decode(raw)
+}
+
+// Next prepares the next result document for reading with the Get method.
+func (r *KindResultSet) Next() (returned bool) {
+	var raw bson.Raw
+	r.last = nil
+	returned, r.lastErr = r.ResultSet.Next(&raw)
+	if returned && r.lastErr == nil {
+		r.last, r.lastErr = r.kinds.Decode(raw)
+	}
+
+	return
+}
+
+// Get returns the document retrieved with the Next method.
+func (r *KindResultSet) Get() (DocumentBase, error) {
+	return r.last, r.lastErr
+}
+
+// ForEach iterates the resultset calling to the given function.
+func (r *KindResultSet) ForEach(f func(DocumentBase) error) error {
+	for r.Next() {
+		doc, err := r.Get()
+		if err != nil {
+			return err
+		}
+
+		err = f(doc)
+		if err == ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return r.lastErr
+}
+
+// filter returns the criteria matching the documents of the kinds, the
+// empty kind matches the documents without kind.
+func (k Kinds) filter() bson.M {
+	kinds := make([]interface{}, 0, len(k))
+	for kind := range k {
+		if kind == "" {
+			kinds = append(kinds, nil)
+		} else {
+			kinds = append(kinds, kind)
+		}
+	}
+
+	return operators.In(KindField, kinds...)
+}
+
+// SetKind sets the kind of the store, written on the KindField of the
+// documents by Insert, Update and Save. The queries of the store only match
+// the documents of its kind, the filter is not part of the criteria of the
+// queries. The generated stores of the models with kind tag set it.
+func (s *Store) SetKind(kind string) {
+	s.kind, s.defaultKind = kind, false
+}
+
+// SetDefaultKind sets the kind of the store as SetKind, but the queries also
+// match the documents without kind, as the ones written before the collection
+// was shared. The generated stores of the models sharing a collection without
+// kind tag set it, with the name of the model as kind.
+func (s *Store) SetDefaultKind(kind string) {
+	s.kind, s.defaultKind = kind, true
+}
+
+// FindKinds executes the given query in the collection, only the documents
+// of the given kinds are returned, decoded into the model of its kind.
+func (s *Store) FindKinds(q Query, kinds Kinds) (*KindResultSet, error) {
+	if err := s.validate(q); err != nil {
+		return nil, err
+	}
+
+	c := s.raw()
+	rs, err := c.Find(&scopedQuery{Query: q, filter: kinds.filter()})
+	if err != nil {
+		return nil, err
+	}
+
+	return &KindResultSet{ResultSet: *rs, kinds: kinds}, nil
+}
+
+// scopedQuery adds a hidden filter to the criteria of a query, as the kind
+// of a store.
+type scopedQuery struct {
+	Query
+	filter bson.M
+}
+
+// GetCriteria returns the criteria of the query and the filter.
+func (q *scopedQuery) GetCriteria() bson.M {
+	criteria := q.Query.GetCriteria()
+	if criteria == nil {
+		return q.filter
+	}
+
+	return operators.And(criteria, q.filter)
+}
+
+// scope returns the given query filtered by the kind of the store, if any.
+// The queries are validated before, so the filter does not affect the
+// validation errors.
+func (s *Store) scope(q Query) Query {
+	if s.kind == "" {
+		return q
+	}
+
+	filter := operators.Eq(KindField, s.kind)
+	if s.defaultKind {
+		filter = operators.In(KindField, s.kind, nil)
+	}
+
+	return &scopedQuery{Query: q, filter: filter}
+}
+
+// withKind returns the given document with the kind of the store, if any.
+func (s *Store) withKind(doc DocumentBase) (interface{}, error) {
+	if s.kind == "" {
+		return doc, nil
+	}
+
+	d, err := marshalDoc(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, e := range d {
+		if e.Name == KindField.String() {
+			d[i].Value = s.kind
+			return d, nil
+		}
+	}
+
+	return append(d, bson.DocElem{Name: KindField.String(), Value: s.kind}), nil
+}
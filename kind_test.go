@@ -0,0 +1,98 @@
+package storable
+
+import (
+	"reflect"
+
+	. "gopkg.in/check.v1"
+	"gopkg.in/mgo.v2/bson"
+)
+
+type kindFixture struct {
+	Document    `bson:",inline"`
+	Name        string
+	Initialized bool `bson:"-"`
+}
+
+func (k *kindFixture) Init(doc DocumentBase) error {
+	k.Initialized = true
+	return nil
+}
+
+var testKinds = Kinds{
+	"person": reflect.TypeOf(Person{}),
+	"":       reflect.TypeOf(kindFixture{}),
+}
+
+func (s *BaseSuite) TestKinds_Decode(c *C) {
+	doc, err := testKinds.Decode(rawDoc(c, bson.M{"_kind": "person", "firstname": "foo"}))
+	c.Assert(err, IsNil)
+	c.Assert(doc, DeepEquals, &Person{FirstName: "foo"})
+
+	doc, err = testKinds.Decode(rawDoc(c, bson.M{"name": "foo"}))
+	c.Assert(err, IsNil)
+	c.Assert(doc.(*kindFixture).Name, Equals, "foo")
+	c.Assert(doc.(*kindFixture).Initialized, Equals, true)
+
+	_, err = testKinds.Decode(rawDoc(c, bson.M{"_kind": "qux"}))
+	c.Assert(err, Equals, ErrUnknownKind)
+}
+
+func (s *BaseSuite) TestKinds_Filter(c *C) {
+	c.Assert(Kinds{"person": nil}.filter(), DeepEquals, bson.M{
+		"_kind": bson.M{"$in": []interface{}{"person"}},
+	})
+
+	c.Assert(Kinds{"": nil}.filter(), DeepEquals, bson.M{
+		"_kind": bson.M{"$in": []interface{}{nil}},
+	})
+}
+
+func (s *BaseSuite) TestStore_SetKind(c *C) {
+	st := NewStore(s.db, "test")
+	p := NewPerson("foo")
+	p.SetId(bson.NewObjectId())
+
+	written, err := st.withKind(p)
+	c.Assert(err, IsNil)
+	c.Assert(written, Equals, p)
+
+	q := NewBaseQuery()
+	q.AddCriteria(bson.M{"firstname": "foo"})
+	c.Assert(st.scope(q), Equals, q)
+
+	st.SetKind("person")
+	c.Assert(st.InTx(&Tx{}).kind, Equals, "person")
+
+	written, err = st.withKind(p)
+	c.Assert(err, IsNil)
+	c.Assert(written, DeepEquals, bson.D{
+		{Name: "_id", Value: p.Id},
+		{Name: "firstname", Value: "foo"},
+		{Name: "lastname", Value: ""},
+		{Name: "gender", Value: ""},
+		{Name: "_kind", Value: "person"},
+	})
+
+	scoped := st.scope(q)
+	c.Assert(scoped.GetCriteria(), DeepEquals, bson.M{"$and": []bson.M{
+		q.GetCriteria(),
+		{"_kind": bson.M{"$eq": "person"}},
+	}})
+	c.Assert(q.GetClauses(), DeepEquals, []bson.M{{"firstname": "foo"}})
+
+	st.SetDefaultKind("person")
+	c.Assert(st.scope(q).GetCriteria(), DeepEquals, bson.M{"$and": []bson.M{
+		q.GetCriteria(),
+		{"_kind": bson.M{"$in": []interface{}{"person", nil}}},
+	}})
+	c.Assert(st.raw().scope(q), Equals, q)
+}
+
+func (s *BaseSuite) TestRegistry_Kinds(c *C) {
+	r := NewRegistry()
+	r.Register(&ModelInfo{Name: "Person", Collection: "people", Kind: "person", Type: reflect.TypeOf(Person{})})
+	r.Register(&ModelInfo{Name: "kindFixture", Collection: "people", Type: reflect.TypeOf(kindFixture{})})
+
+	c.Assert(r.Kinds("people"), DeepEquals, Kinds{"person": reflect.TypeOf(Person{})})
+	c.Assert(r.Kinds("foo"), HasLen, 0)
+}
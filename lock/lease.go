@@ -0,0 +1,31 @@
+package lock
+
+import (
+	"time"
+
+	"gopkg.in/src-d/storable.v1"
+)
+
+//go:generate storable gen
+
+// Lease is a document of the locks collection, there is one per lock name.
+// The document is kept after the release, so the token keeps increasing.
+type Lease struct {
+	storable.Document `bson:",inline" collection:"locks"`
+
+	Name string
+	// Owner is the identity of the holder of the lease.
+	Owner string
+	// Token is the fencing token, it is increased on every acquisition. The
+	// resources protected by the lock should reject the writes with a token
+	// lower than the last one seen.
+	Token int64
+	// ExpiresAt is the time when the lease can be acquired by other owner.
+	ExpiresAt  time.Time
+	AcquiredAt time.Time
+}
+
+// IsExpired returns true if the lease is expired at the given time.
+func (l *Lease) IsExpired(now time.Time) bool {
+	return !now.Before(l.ExpiresAt)
+}
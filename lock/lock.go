@@ -0,0 +1,163 @@
+package lock
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+	"gopkg.in/src-d/storable.v1"
+	"gopkg.in/src-d/storable.v1/operators"
+)
+
+var (
+	// ErrLocked is returned by Acquire when the lease is held by other owner
+	ErrLocked = errors.New("The lock is held by other owner.")
+	// ErrLeaseLost is returned when a lease is renewed or released after
+	// being acquired by other owner
+	ErrLeaseLost = errors.New("The lease was acquired by other owner.")
+)
+
+// Locker acquires leases on behalf of an owner, the leases are stored in a
+// collection with a unique index on the name. The expiration of the leases
+// is based on the clock of the owners, so they should be synchronized.
+type Locker struct {
+	store *LeaseStore
+	owner string
+
+	m       sync.Mutex
+	indexed bool
+}
+
+// New returns a Locker storing the leases in the given collection, an owner
+// identity is generated if owner is empty, see Owner.
+func New(db *mgo.Database, collection, owner string) *Locker {
+	if owner == "" {
+		owner = newOwner()
+	}
+
+	return &Locker{
+		store: &LeaseStore{*storable.NewStore(db, collection)},
+		owner: owner,
+	}
+}
+
+func newOwner() string {
+	host, _ := os.Hostname()
+	return fmt.Sprintf("%s-%d-%s", host, os.Getpid(), bson.NewObjectId().Hex())
+}
+
+// Owner returns the identity of the owner of the leases.
+func (l *Locker) Owner() string {
+	return l.owner
+}
+
+// EnsureIndexes creates the unique index on the name of the leases, required
+// to acquire them atomically. It is called by the first Acquire.
+func (l *Locker) EnsureIndexes() error {
+	l.m.Lock()
+	defer l.m.Unlock()
+	if l.indexed {
+		return nil
+	}
+
+	err := l.store.EnsureIndex(mgo.Index{
+		Key:    []string{storable.AscIndex.Key(Schema.Lease.Name)},
+		Unique: true,
+	})
+
+	l.indexed = err == nil
+	return err
+}
+
+// Acquire acquires the lease with the given name for the given ttl, if it is
+// not held or it is expired. The lease is renewed, with a new token, if it is
+// already held by the owner. ErrLocked is returned if it is held by other
+// owner.
+func (l *Locker) Acquire(name string, ttl time.Duration) (*Lease, error) {
+	if err := l.EnsureIndexes(); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	q := l.store.Query()
+	q.AddCriteria(operators.Eq(Schema.Lease.Name, name))
+	q.AddCriteria(operators.Or(
+		operators.Lte(Schema.Lease.ExpiresAt, now),
+		operators.Eq(Schema.Lease.Owner, l.owner),
+	))
+
+	lease, err := l.store.FindAndModify(q, mgo.Change{
+		Update: bson.M{
+			"$set": bson.M{
+				Schema.Lease.Owner.String():      l.owner,
+				Schema.Lease.ExpiresAt.String():  now.Add(ttl),
+				Schema.Lease.AcquiredAt.String(): now,
+			},
+			"$inc": bson.M{Schema.Lease.Token.String(): 1},
+		},
+		Upsert:    true,
+		ReturnNew: true,
+	})
+
+	// the lease exists and is not matched, so the upsert violates the index
+	if mgo.IsDup(err) {
+		return nil, ErrLocked
+	}
+
+	return lease, err
+}
+
+// Renew extends the given lease for the given ttl from now, ErrLeaseLost is
+// returned if the lease was acquired by other owner.
+func (l *Locker) Renew(lease *Lease, ttl time.Duration) error {
+	expiresAt := time.Now().Add(ttl)
+	err := l.update(lease, bson.M{Schema.Lease.ExpiresAt.String(): expiresAt})
+	if err != nil {
+		return err
+	}
+
+	lease.ExpiresAt = expiresAt
+	return nil
+}
+
+// Release releases the given lease, it can be acquired by any owner right
+// away. ErrLeaseLost is returned if the lease was acquired by other owner.
+func (l *Locker) Release(lease *Lease) error {
+	now := time.Now()
+	err := l.update(lease, bson.M{Schema.Lease.ExpiresAt.String(): now})
+	if err != nil {
+		return err
+	}
+
+	lease.ExpiresAt = now
+	return nil
+}
+
+// update updates the given lease if it was not acquired again since.
+func (l *Locker) update(lease *Lease, update bson.M) error {
+	q := l.store.Query()
+	q.AddCriteria(operators.Eq(Schema.Lease.Name, lease.Name))
+	q.AddCriteria(operators.Eq(Schema.Lease.Token, lease.Token))
+	q.AddCriteria(operators.Eq(Schema.Lease.Owner, lease.Owner))
+
+	err := l.store.RawUpdate(q, update, false)
+	if err == mgo.ErrNotFound {
+		return ErrLeaseLost
+	}
+
+	return err
+}
+
+// Get returns the current lease with the given name, it may be expired.
+// storable.ErrNotFound is returned if it was never acquired.
+func (l *Locker) Get(name string) (*Lease, error) {
+	q := l.store.Query()
+	q.AddCriteria(operators.Eq(Schema.Lease.Name, name))
+
+	return l.store.FindOne(q)
+}
@@ -0,0 +1,110 @@
+package lock
+
+import (
+	"testing"
+	"time"
+
+	. "gopkg.in/check.v1"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/src-d/storable.v1"
+)
+
+const (
+	testMongoHost = "127.0.0.1:27017"
+	testDatabase  = "storable-lock-test"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type LockSuite struct {
+	db *mgo.Database
+}
+
+var _ = Suite(&LockSuite{})
+
+func (s *LockSuite) SetUpTest(c *C) {
+	conn, _ := mgo.Dial(testMongoHost)
+	s.db = conn.DB(testDatabase)
+}
+
+func (s *LockSuite) TearDownTest(c *C) {
+	s.db.DropDatabase()
+}
+
+// newLocker returns a Locker without the index, created by Acquire.
+func (s *LockSuite) newLocker(owner string) *Locker {
+	return New(s.db, "locks", owner)
+}
+
+func (s *LockSuite) TestNewOwner(c *C) {
+	l := New(s.db, "locks", "")
+	c.Assert(l.Owner(), Not(Equals), "")
+	c.Assert(New(s.db, "locks", "").Owner(), Not(Equals), l.Owner())
+}
+
+func (s *LockSuite) TestAcquire(c *C) {
+	foo, qux := s.newLocker("foo"), s.newLocker("qux")
+
+	lease, err := foo.Acquire("cron", time.Minute)
+	c.Assert(err, IsNil)
+	c.Assert(lease.Name, Equals, "cron")
+	c.Assert(lease.Owner, Equals, "foo")
+	c.Assert(lease.Token, Equals, int64(1))
+	c.Assert(lease.IsExpired(time.Now()), Equals, false)
+
+	_, err = qux.Acquire("cron", time.Minute)
+	c.Assert(err, Equals, ErrLocked)
+
+	other, err := qux.Acquire("other", time.Minute)
+	c.Assert(err, IsNil)
+	c.Assert(other.Token, Equals, int64(1))
+
+	again, err := foo.Acquire("cron", time.Minute)
+	c.Assert(err, IsNil)
+	c.Assert(again.Token, Equals, int64(2))
+}
+
+func (s *LockSuite) TestAcquireExpired(c *C) {
+	foo, qux := s.newLocker("foo"), s.newLocker("qux")
+
+	lease, err := foo.Acquire("cron", 10*time.Millisecond)
+	c.Assert(err, IsNil)
+
+	time.Sleep(20 * time.Millisecond)
+	taken, err := qux.Acquire("cron", time.Minute)
+	c.Assert(err, IsNil)
+	c.Assert(taken.Owner, Equals, "qux")
+	c.Assert(taken.Token, Equals, int64(2))
+
+	c.Assert(foo.Renew(lease, time.Minute), Equals, ErrLeaseLost)
+	c.Assert(foo.Release(lease), Equals, ErrLeaseLost)
+
+	current, err := foo.Get("cron")
+	c.Assert(err, IsNil)
+	c.Assert(current.Owner, Equals, "qux")
+}
+
+func (s *LockSuite) TestRenewAndRelease(c *C) {
+	foo, qux := s.newLocker("foo"), s.newLocker("qux")
+
+	lease, err := foo.Acquire("cron", 10*time.Millisecond)
+	c.Assert(err, IsNil)
+
+	c.Assert(foo.Renew(lease, time.Minute), IsNil)
+	time.Sleep(20 * time.Millisecond)
+
+	_, err = qux.Acquire("cron", time.Minute)
+	c.Assert(err, Equals, ErrLocked)
+
+	c.Assert(foo.Release(lease), IsNil)
+	c.Assert(lease.IsExpired(time.Now()), Equals, true)
+
+	taken, err := qux.Acquire("cron", time.Minute)
+	c.Assert(err, IsNil)
+	c.Assert(taken.Token, Equals, int64(2))
+}
+
+func (s *LockSuite) TestGetNotFound(c *C) {
+	_, err := s.newLocker("foo").Get("cron")
+	c.Assert(err, Equals, storable.ErrNotFound)
+}
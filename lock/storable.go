@@ -0,0 +1,338 @@
+package lock
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+	"gopkg.in/src-d/storable.v1"
+	"gopkg.in/src-d/storable.v1/operators"
+)
+
+type LeaseStore struct {
+	storable.Store
+}
+
+func NewLeaseStore(db *mgo.Database) *LeaseStore {
+	return &LeaseStore{*storable.NewStore(db, "locks")}
+}
+
+// WithContext returns a copy of the store using the given context, see
+// storable.Store.WithContext.
+func (s *LeaseStore) WithContext(ctx context.Context) *LeaseStore {
+	return &LeaseStore{*s.Store.WithContext(ctx)}
+}
+
+// InTx returns a copy of the store running its operations, including the
+// hooks, inside the given transaction. See storable.WithTransaction.
+func (s *LeaseStore) InTx(tx *storable.Tx) *LeaseStore {
+	return &LeaseStore{*s.Store.InTx(tx)}
+}
+
+// New returns a new instance of Lease.
+func (s *LeaseStore) New() (doc *Lease) {
+	doc = &Lease{}
+	if doc != nil {
+		doc.SetIsNew(true)
+		doc.SetId(bson.NewObjectId())
+	}
+	return
+}
+
+// Query return a new instance of LeaseQuery.
+func (s *LeaseStore) Query() *LeaseQuery {
+	return &LeaseQuery{*storable.NewBaseQuery()}
+}
+
+// Find performs a find on the collection using the given query.
+func (s *LeaseStore) Find(query *LeaseQuery) (*LeaseResultSet, error) {
+	resultSet, err := s.Store.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LeaseResultSet{ResultSet: *resultSet}, nil
+}
+
+// MustFind like Find but panics on error
+func (s *LeaseStore) MustFind(query *LeaseQuery) *LeaseResultSet {
+	resultSet := s.Store.MustFind(query)
+	return &LeaseResultSet{ResultSet: *resultSet}
+}
+
+// Tail performs a find on the capped collection using the given query,
+// waiting for new documents up to the given timeout. See storable.Store.Tail.
+func (s *LeaseStore) Tail(query *LeaseQuery, timeout time.Duration) (*LeaseResultSet, error) {
+	resultSet, err := s.Store.Tail(query, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LeaseResultSet{ResultSet: *resultSet}, nil
+}
+
+// FindOne performs a find on the collection using the given query returning
+// the first document from the resultset.
+func (s *LeaseStore) FindOne(query *LeaseQuery) (*Lease, error) {
+	resultSet, err := s.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultSet.One()
+}
+
+// MustFindOne like FindOne but panics on error
+func (s *LeaseStore) MustFindOne(query *LeaseQuery) *Lease {
+	doc, err := s.FindOne(query)
+	if err != nil {
+		panic(err)
+	}
+
+	return doc
+}
+
+// FindAndModify atomically modifies the first document matching the given
+// query and returns it, before the change or after it if change.ReturnNew is
+// set. See storable.Store.FindAndModify.
+func (s *LeaseStore) FindAndModify(query *LeaseQuery, change mgo.Change) (*Lease, error) {
+	var result *Lease
+	_, err := s.Store.FindAndModify(query, change, &result)
+
+	return result, err
+}
+
+// Insert insert the given document on the collection, trigger BeforeInsert and
+// AfterInsert if any. Throws ErrNonNewDocument if doc is a non-new document.
+func (s *LeaseStore) Insert(doc *Lease) error {
+
+	err := s.Store.Insert(doc)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Update update the given document on the collection, trigger BeforeUpdate and
+// AfterUpdate if any. Throws ErrNewDocument if doc is a new document.
+func (s *LeaseStore) Update(doc *Lease) error {
+
+	err := s.Store.Update(doc)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateDiff update the given document on the collection writing only the
+// changes from old, trigger BeforeUpdate and AfterUpdate if any. See
+// storable.Store.UpdateDiff.
+func (s *LeaseStore) UpdateDiff(old, doc *Lease) (storable.Changes, error) {
+
+	changes, err := s.Store.UpdateDiff(old, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// Save insert or update the given document on the collection using Upsert,
+// trigger BeforeUpdate and AfterUpdate if the document is non-new and
+// BeforeInsert and AfterInset if is new.
+func (s *LeaseStore) Save(doc *Lease) (updated bool, err error) {
+	updated, err = s.Store.Save(doc)
+	if err != nil {
+		return false, err
+	}
+
+	return
+}
+
+type LeaseQuery struct {
+	storable.BaseQuery
+}
+
+// FindById add a new criteria to the query searching by _id
+func (q *LeaseQuery) FindById(ids ...bson.ObjectId) *LeaseQuery {
+	var vs []interface{}
+	for _, id := range ids {
+		vs = append(vs, id)
+	}
+	q.AddCriteria(operators.In(storable.IdField, vs...))
+
+	return q
+}
+
+// Clone returns a copy of the query, see storable.BaseQuery.Clone.
+func (q *LeaseQuery) Clone() *LeaseQuery {
+	return &LeaseQuery{*q.BaseQuery.Clone()}
+}
+
+type LeaseResultSet struct {
+	storable.ResultSet
+	last    *Lease
+	lastErr error
+}
+
+// All returns all documents on the resultset and close the resultset
+func (r *LeaseResultSet) All() ([]*Lease, error) {
+	var result []*Lease
+	err := r.ResultSet.All(&result)
+
+	return result, err
+}
+
+// One returns the first document on the resultset and close the resultset
+func (r *LeaseResultSet) One() (*Lease, error) {
+	var result *Lease
+	err := r.ResultSet.One(&result)
+
+	return result, err
+}
+
+// Next prepares the next result document for reading with the Get method.
+func (r *LeaseResultSet) Next() (returned bool) {
+	r.last = nil
+	returned, r.lastErr = r.ResultSet.Next(&r.last)
+
+	return
+}
+
+// Get returns the document retrieved with the Next method.
+func (r *LeaseResultSet) Get() (*Lease, error) {
+	return r.last, r.lastErr
+}
+
+// ForEach iterates the resultset calling to the given function.
+func (r *LeaseResultSet) ForEach(f func(*Lease) error) error {
+	for {
+		var result *Lease
+		found, err := r.ResultSet.Next(&result)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			break
+		}
+
+		err = f(result)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Watch returns a stream with the changes on the documents matching the given
+// query, the current version of the document is retrieved on every update.
+// See storable.Store.Watch.
+func (s *LeaseStore) Watch(query *LeaseQuery, opts storable.WatchOptions) (*LeaseChangeStream, error) {
+	opts.FullDocument = true
+	cs, err := s.Store.Watch(query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LeaseChangeStream{ChangeStream: cs}, nil
+}
+
+type LeaseChangeStream struct {
+	*storable.ChangeStream
+	event   storable.ChangeEvent
+	last    *Lease
+	lastErr error
+}
+
+// Next waits for the next event, to be read with the Get method. Returns
+// false when the stream is closed or fails.
+func (cs *LeaseChangeStream) Next() (returned bool) {
+	cs.event, cs.last, cs.lastErr = storable.ChangeEvent{}, nil, nil
+	if !cs.ChangeStream.Next(&cs.event) {
+		cs.lastErr = cs.ChangeStream.Err()
+		return false
+	}
+
+	if cs.event.HasDocument() {
+		cs.lastErr = cs.event.Decode(&cs.last)
+	}
+
+	return true
+}
+
+// Get returns the event and the document retrieved with the Next method, the
+// document is nil on the deletes or if it does not exist anymore.
+func (cs *LeaseChangeStream) Get() (*storable.ChangeEvent, *Lease, error) {
+	return &cs.event, cs.last, cs.lastErr
+}
+
+// ForEach waits for the events calling to the given function, until the
+// stream is closed or storable.ErrStop is returned.
+func (cs *LeaseChangeStream) ForEach(f func(*storable.ChangeEvent, *Lease) error) error {
+	for cs.Next() {
+		event, doc, err := cs.Get()
+		if err != nil {
+			return err
+		}
+
+		err = f(event, doc)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return cs.lastErr
+}
+
+type schema struct {
+	Lease *schemaLease
+}
+
+type schemaLease struct {
+	Name       storable.Field
+	Owner      storable.Field
+	Token      storable.Field
+	ExpiresAt  storable.Field
+	AcquiredAt storable.Field
+}
+
+var Schema = schema{
+	Lease: &schemaLease{
+		Name:       storable.NewField("name", "string"),
+		Owner:      storable.NewField("owner", "string"),
+		Token:      storable.NewField("token", "int64"),
+		ExpiresAt:  storable.NewField("expiresat", "time.Time"),
+		AcquiredAt: storable.NewField("acquiredat", "time.Time"),
+	},
+}
+
+func init() {
+	storable.Register(&storable.ModelInfo{
+		Name:       "Lease",
+		Collection: "locks",
+		Type:       reflect.TypeOf(Lease{}),
+		Schema:     Schema.Lease,
+		Fields: []*storable.FieldInfo{
+			{Name: "Id", Path: "_id", Type: "bson.ObjectId", Findable: true},
+			{Name: "Name", Path: "name", Type: "string", Findable: true},
+			{Name: "Owner", Path: "owner", Type: "string", Findable: true},
+			{Name: "Token", Path: "token", Type: "int64", Findable: true},
+			{Name: "ExpiresAt", Path: "expiresat", Type: "time.Time", Findable: true},
+			{Name: "AcquiredAt", Path: "acquiredat", Type: "time.Time", Findable: true},
+		},
+	})
+}
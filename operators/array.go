@@ -1,6 +1,8 @@
 package operators
 
 import (
+	"strings"
+
 	"gopkg.in/mgo.v2/bson"
 )
 
@@ -14,4 +16,116 @@ func Size(field Field, count int) bson.M {
 	return bson.M{field.String(): bson.M{"$size": count}}
 }
 
-//TODO: $elemMatch
+// ElemMatch Selects documents if element in the array field matches all the
+// specified clauses. The clauses are written with the fields of the elements,
+// as are defined on the Schema, the path of the array is removed from them:
+//
+//	ElemMatch(Schema.Order.Items,
+//	    Eq(Schema.Order.Items.Name, "foo"),
+//	    Gt(Schema.Order.Items.Quantity, 1),
+//	)
+//
+// For arrays of scalar values the clauses are written with the array field:
+//
+//	ElemMatch(Schema.Product.Scores, Gte(Schema.Product.Scores, 80), Lt(Schema.Product.Scores, 90))
+func ElemMatch(field Field, clauses ...bson.M) bson.M {
+	path := field.String()
+
+	match := bson.M{}
+	var and []bson.M
+	for _, c := range clauses {
+		for k, v := range relativeClause(path, c) {
+			current, ok := match[k]
+			if !ok {
+				match[k] = v
+				continue
+			}
+
+			if merged, ok := mergeOperators(current, v); ok {
+				match[k] = merged
+				continue
+			}
+
+			and = append(and, bson.M{k: v})
+		}
+	}
+
+	if len(and) != 0 {
+		match = bson.M{"$and": append([]bson.M{match}, and...)}
+	}
+
+	return bson.M{path: bson.M{"$elemMatch": match}}
+}
+
+// relativeClause returns the clause with the keys relative to the given path,
+// the criteria over the path itself are returned as operators.
+func relativeClause(path string, clause bson.M) bson.M {
+	r := bson.M{}
+	for k, v := range clause {
+		switch {
+		case k == path:
+			if doc, ok := v.(bson.M); ok && isOperators(doc) {
+				for op, arg := range doc {
+					r[op] = arg
+				}
+			} else {
+				r["$eq"] = v
+			}
+		case strings.HasPrefix(k, path+"."):
+			r[k[len(path)+1:]] = v
+		case k == "$and" || k == "$or" || k == "$nor":
+			if clauses, ok := v.([]bson.M); ok {
+				var rs []bson.M
+				for _, c := range clauses {
+					rs = append(rs, relativeClause(path, c))
+				}
+
+				v = rs
+			}
+
+			r[k] = v
+		default:
+			r[k] = v
+		}
+	}
+
+	return r
+}
+
+// mergeOperators merges two documents of operators without common operators.
+func mergeOperators(a, b interface{}) (bson.M, bool) {
+	ma, ok := a.(bson.M)
+	if !ok || !isOperators(ma) {
+		return nil, false
+	}
+
+	mb, ok := b.(bson.M)
+	if !ok || !isOperators(mb) {
+		return nil, false
+	}
+
+	merged := bson.M{}
+	for k, v := range ma {
+		merged[k] = v
+	}
+
+	for k, v := range mb {
+		if _, ok := merged[k]; ok {
+			return nil, false
+		}
+
+		merged[k] = v
+	}
+
+	return merged, true
+}
+
+func isOperators(doc bson.M) bool {
+	for k := range doc {
+		if !strings.HasPrefix(k, "$") {
+			return false
+		}
+	}
+
+	return len(doc) != 0
+}
@@ -14,3 +14,45 @@ func (s *OperatorsSuite) TestSize(c *check.C) {
 	size := Size(Foo, 2)
 	c.Assert(size, check.DeepEquals, bson.M{"foo": bson.M{"$size": 2}})
 }
+
+func (s *OperatorsSuite) TestElemMatch(c *check.C) {
+	name := FieldExample("foo.name")
+	qty := FieldExample("foo.qty")
+
+	match := ElemMatch(Foo, Eq(name, "bar"), Gt(qty, 1), Lt(qty, 5))
+	c.Assert(match, check.DeepEquals, bson.M{"foo": bson.M{"$elemMatch": bson.M{
+		"name": bson.M{"$eq": "bar"},
+		"qty":  bson.M{"$gt": 1, "$lt": 5},
+	}}})
+}
+
+func (s *OperatorsSuite) TestElemMatchScalar(c *check.C) {
+	match := ElemMatch(Foo, Gte(Foo, 80), Lt(Foo, 90))
+	c.Assert(match, check.DeepEquals, bson.M{"foo": bson.M{"$elemMatch": bson.M{
+		"$gte": 80, "$lt": 90,
+	}}})
+
+	match = ElemMatch(Foo, bson.M{"foo": "bar"})
+	c.Assert(match, check.DeepEquals, bson.M{"foo": bson.M{"$elemMatch": bson.M{
+		"$eq": "bar",
+	}}})
+}
+
+func (s *OperatorsSuite) TestElemMatchLogical(c *check.C) {
+	name := FieldExample("foo.name")
+	match := ElemMatch(Foo, Or(Eq(name, "bar"), Eq(name, "qux")))
+	c.Assert(match, check.DeepEquals, bson.M{"foo": bson.M{"$elemMatch": bson.M{
+		"$or": []bson.M{{"name": bson.M{"$eq": "bar"}}, {"name": bson.M{"$eq": "qux"}}},
+	}}})
+}
+
+func (s *OperatorsSuite) TestElemMatchConflict(c *check.C) {
+	name := FieldExample("foo.name")
+	match := ElemMatch(Foo, Eq(name, "bar"), bson.M{"foo.name": "qux"})
+	c.Assert(match, check.DeepEquals, bson.M{"foo": bson.M{"$elemMatch": bson.M{
+		"$and": []bson.M{
+			{"name": bson.M{"$eq": "bar"}},
+			{"name": "qux"},
+		},
+	}}})
+}
@@ -0,0 +1,41 @@
+package operators
+
+import (
+	"gopkg.in/mgo.v2/bson"
+)
+
+// The bitwise operators accept as bits a numeric bitmask, a []byte bitmask or
+// a []int with the positions of the bits, starting by 0 as the least
+// significant bit.
+
+// BitsAllSet Matches numeric or binary values in which a set of bit positions
+// all have a value of 1.
+func BitsAllSet(field Field, bits interface{}) bson.M {
+	return bson.M{field.String(): bson.M{"$bitsAllSet": bitmask(bits)}}
+}
+
+// BitsAnySet Matches numeric or binary values in which any bit from a set of
+// bit positions has a value of 1.
+func BitsAnySet(field Field, bits interface{}) bson.M {
+	return bson.M{field.String(): bson.M{"$bitsAnySet": bitmask(bits)}}
+}
+
+// BitsAllClear Matches numeric or binary values in which a set of bit
+// positions all have a value of 0.
+func BitsAllClear(field Field, bits interface{}) bson.M {
+	return bson.M{field.String(): bson.M{"$bitsAllClear": bitmask(bits)}}
+}
+
+// BitsAnyClear Matches numeric or binary values in which any bit from a set of
+// bit positions has a value of 0.
+func BitsAnyClear(field Field, bits interface{}) bson.M {
+	return bson.M{field.String(): bson.M{"$bitsAnyClear": bitmask(bits)}}
+}
+
+func bitmask(bits interface{}) interface{} {
+	if b, ok := bits.([]byte); ok {
+		return bson.Binary{Kind: 0x00, Data: b}
+	}
+
+	return bits
+}
@@ -0,0 +1,28 @@
+package operators
+
+import (
+	"gopkg.in/check.v1"
+	"gopkg.in/mgo.v2/bson"
+)
+
+func (s *OperatorsSuite) TestBitsAllSet(c *check.C) {
+	bits := BitsAllSet(Foo, 35)
+	c.Assert(bits, check.DeepEquals, bson.M{"foo": bson.M{"$bitsAllSet": 35}})
+}
+
+func (s *OperatorsSuite) TestBitsAnySet(c *check.C) {
+	bits := BitsAnySet(Foo, []int{1, 5})
+	c.Assert(bits, check.DeepEquals, bson.M{"foo": bson.M{"$bitsAnySet": []int{1, 5}}})
+}
+
+func (s *OperatorsSuite) TestBitsAllClear(c *check.C) {
+	bits := BitsAllClear(Foo, []byte{0x21})
+	c.Assert(bits, check.DeepEquals, bson.M{"foo": bson.M{
+		"$bitsAllClear": bson.Binary{Kind: 0x00, Data: []byte{0x21}},
+	}})
+}
+
+func (s *OperatorsSuite) TestBitsAnyClear(c *check.C) {
+	bits := BitsAnyClear(Foo, uint8(3))
+	c.Assert(bits, check.DeepEquals, bson.M{"foo": bson.M{"$bitsAnyClear": uint8(3)}})
+}
@@ -24,10 +24,36 @@ const (
 	Int32                 = 16
 	Timestamp             = 17
 	Int64                 = 18
+	Decimal128            = 19
 	MinKey                = -1
 	MaxKey                = 127
 )
 
+// BSONAlias is the string alias of a BSON type.
+type BSONAlias string
+
+const (
+	AliasDouble     BSONAlias = "double"
+	AliasString     BSONAlias = "string"
+	AliasObject     BSONAlias = "object"
+	AliasArray      BSONAlias = "array"
+	AliasBinary     BSONAlias = "binData"
+	AliasObjectId   BSONAlias = "objectId"
+	AliasBoolean    BSONAlias = "bool"
+	AliasDate       BSONAlias = "date"
+	AliasNull       BSONAlias = "null"
+	AliasRegExp     BSONAlias = "regex"
+	AliasJavaScript BSONAlias = "javascript"
+	AliasInt32      BSONAlias = "int"
+	AliasTimestamp  BSONAlias = "timestamp"
+	AliasInt64      BSONAlias = "long"
+	AliasDecimal128 BSONAlias = "decimal"
+	AliasMinKey     BSONAlias = "minKey"
+	AliasMaxKey     BSONAlias = "maxKey"
+	// AliasNumber matches any numeric type.
+	AliasNumber BSONAlias = "number"
+)
+
 // Exists Matches documents that have the specified field.
 func Exists(field Field, exists bool) bson.M {
 	return bson.M{field.String(): bson.M{"$exists": exists}}
@@ -37,3 +63,9 @@ func Exists(field Field, exists bool) bson.M {
 func Type(field Field, t BSONType) bson.M {
 	return bson.M{field.String(): bson.M{"$type": t}}
 }
+
+// TypeAlias Selects documents if a field is of the type with the specified
+// alias.
+func TypeAlias(field Field, alias BSONAlias) bson.M {
+	return bson.M{field.String(): bson.M{"$type": string(alias)}}
+}
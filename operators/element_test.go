@@ -14,3 +14,8 @@ func (s *OperatorsSuite) TestType(c *check.C) {
 	t := Type(Foo, Double)
 	c.Assert(t, check.DeepEquals, bson.M{"foo": bson.M{"$type": Double}})
 }
+
+func (s *OperatorsSuite) TestTypeAlias(c *check.C) {
+	t := TypeAlias(Foo, AliasDecimal128)
+	c.Assert(t, check.DeepEquals, bson.M{"foo": bson.M{"$type": "decimal"}})
+}
@@ -16,20 +16,63 @@ func RegEx(field Field, regexp, options string) bson.M {
 }
 
 // Text performs a text search on the content of the fields indexed with a text
-// index.
-func Text(field Field, search, lang string) bson.M {
-	return bson.M{
-		field.String(): bson.M{
-			"$text": bson.M{"$search": search, "$language": lang},
-		},
+// index, using the given language, an empty language means the default
+// language of the index.
+func Text(search, lang string) bson.M {
+	return TextWithOptions(search, TextOptions{Language: lang})
+}
+
+// TextOptions are the options of a text search.
+type TextOptions struct {
+	// Language determines the list of stop words and the rules for the
+	// stemmer, an empty language means the default language of the index.
+	Language string
+	// CaseSensitive enables the case sensitive search.
+	CaseSensitive bool
+	// DiacriticSensitive enables the diacritic sensitive search.
+	DiacriticSensitive bool
+}
+
+// TextWithOptions like Text but with all the options of $text.
+func TextWithOptions(search string, opts TextOptions) bson.M {
+	text := bson.M{"$search": search}
+	if opts.Language != "" {
+		text["$language"] = opts.Language
+	}
+
+	if opts.CaseSensitive {
+		text["$caseSensitive"] = true
+	}
+
+	if opts.DiacriticSensitive {
+		text["$diacriticSensitive"] = true
 	}
+
+	return bson.M{"$text": text}
+}
+
+// TextScore Projects the score assigned to each document by a text search on
+// the given field.
+func TextScore(field Field) bson.M {
+	return bson.M{field.String(): bson.M{"$meta": "textScore"}}
 }
 
 // Where Matches documents that satisfy a JavaScript expression.
-func Where(field Field, code string, scope interface{}) bson.M {
-	return bson.M{
-		field.String(): bson.M{
-			"$where": bson.JavaScript{Code: code, Scope: scope},
-		},
-	}
+func Where(code string, scope interface{}) bson.M {
+	return bson.M{"$where": bson.JavaScript{Code: code, Scope: scope}}
+}
+
+// Expr Allows the use of aggregation expressions, as the ones built with the
+// expr package, within the query language, eg. to compare fields of the same
+// document.
+func Expr(expression interface{}) bson.M {
+	return bson.M{"$expr": expression}
+}
+
+// JSONSchema Validates documents against the given JSON Schema, to find the
+// documents not matching it use Nor:
+//
+//	Nor(JSONSchema(bson.M{"required": []string{"name"}}))
+func JSONSchema(schema interface{}) bson.M {
+	return bson.M{"$jsonSchema": schema}
 }
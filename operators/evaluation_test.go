@@ -18,15 +18,50 @@ func (s *OperatorsSuite) TestRegEx(c *check.C) {
 }
 
 func (s *OperatorsSuite) TestText(c *check.C) {
-	text := Text(Foo, "foo", "none")
+	text := Text("foo", "none")
 	c.Assert(text, check.DeepEquals, bson.M{
-		"foo": bson.M{"$text": bson.M{"$search": "foo", "$language": "none"}},
+		"$text": bson.M{"$search": "foo", "$language": "none"},
 	})
+
+	text = Text("foo", "")
+	c.Assert(text, check.DeepEquals, bson.M{"$text": bson.M{"$search": "foo"}})
+}
+
+func (s *OperatorsSuite) TestTextWithOptions(c *check.C) {
+	text := TextWithOptions("foo", TextOptions{
+		Language:           "es",
+		CaseSensitive:      true,
+		DiacriticSensitive: true,
+	})
+
+	c.Assert(text, check.DeepEquals, bson.M{"$text": bson.M{
+		"$search":             "foo",
+		"$language":           "es",
+		"$caseSensitive":      true,
+		"$diacriticSensitive": true,
+	}})
+}
+
+func (s *OperatorsSuite) TestTextScore(c *check.C) {
+	score := TextScore(Foo)
+	c.Assert(score, check.DeepEquals, bson.M{"foo": bson.M{"$meta": "textScore"}})
 }
 
 func (s *OperatorsSuite) TestWhere(c *check.C) {
-	where := Where(Foo, "foo", nil)
+	where := Where("foo", nil)
 	c.Assert(where, check.DeepEquals, bson.M{
-		"foo": bson.M{"$where": bson.JavaScript{Code: "foo", Scope: interface{}(nil)}},
+		"$where": bson.JavaScript{Code: "foo", Scope: interface{}(nil)},
+	})
+}
+
+func (s *OperatorsSuite) TestExpr(c *check.C) {
+	expr := Expr(bson.M{"$gt": []interface{}{"$foo", "$qux"}})
+	c.Assert(expr, check.DeepEquals, bson.M{
+		"$expr": bson.M{"$gt": []interface{}{"$foo", "$qux"}},
 	})
 }
+
+func (s *OperatorsSuite) TestJSONSchema(c *check.C) {
+	schema := bson.M{"required": []string{"foo"}}
+	c.Assert(JSONSchema(schema), check.DeepEquals, bson.M{"$jsonSchema": schema})
+}
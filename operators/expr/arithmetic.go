@@ -0,0 +1,45 @@
+package expr
+
+import (
+	"gopkg.in/mgo.v2/bson"
+)
+
+// Add Adds numbers together or adds numbers and a date.
+func Add(args ...interface{}) bson.M {
+	return op("$add", args...)
+}
+
+// Subtract Returns the result of subtracting the second value from the first.
+func Subtract(a, b interface{}) bson.M {
+	return op("$subtract", a, b)
+}
+
+// Multiply Multiplies numbers together.
+func Multiply(args ...interface{}) bson.M {
+	return op("$multiply", args...)
+}
+
+// Divide Returns the result of dividing the first number by the second.
+func Divide(a, b interface{}) bson.M {
+	return op("$divide", a, b)
+}
+
+// Mod Returns the remainder of the first number divided by the second.
+func Mod(a, b interface{}) bson.M {
+	return op("$mod", a, b)
+}
+
+// Abs Returns the absolute value of a number.
+func Abs(a interface{}) bson.M {
+	return bson.M{"$abs": a}
+}
+
+// Ceil Returns the smallest integer greater than or equal to the number.
+func Ceil(a interface{}) bson.M {
+	return bson.M{"$ceil": a}
+}
+
+// Floor Returns the largest integer less than or equal to the number.
+func Floor(a interface{}) bson.M {
+	return bson.M{"$floor": a}
+}
@@ -0,0 +1,39 @@
+package expr
+
+import (
+	"gopkg.in/check.v1"
+	"gopkg.in/mgo.v2/bson"
+)
+
+func (s *ExprSuite) TestAdd(c *check.C) {
+	add := Add(Ref(Foo), Ref(Qux), 1)
+	c.Assert(add, check.DeepEquals, bson.M{
+		"$add": []interface{}{"$foo", "$qux.bar", 1},
+	})
+}
+
+func (s *ExprSuite) TestSubtract(c *check.C) {
+	sub := Subtract(Ref(Foo), 1)
+	c.Assert(sub, check.DeepEquals, bson.M{"$subtract": []interface{}{"$foo", 1}})
+}
+
+func (s *ExprSuite) TestMultiply(c *check.C) {
+	mul := Multiply(Ref(Foo), 2)
+	c.Assert(mul, check.DeepEquals, bson.M{"$multiply": []interface{}{"$foo", 2}})
+}
+
+func (s *ExprSuite) TestDivide(c *check.C) {
+	div := Divide(Ref(Foo), Ref(Qux))
+	c.Assert(div, check.DeepEquals, bson.M{"$divide": []interface{}{"$foo", "$qux.bar"}})
+}
+
+func (s *ExprSuite) TestMod(c *check.C) {
+	mod := Mod(Ref(Foo), 2)
+	c.Assert(mod, check.DeepEquals, bson.M{"$mod": []interface{}{"$foo", 2}})
+}
+
+func (s *ExprSuite) TestAbsCeilFloor(c *check.C) {
+	c.Assert(Abs(Ref(Foo)), check.DeepEquals, bson.M{"$abs": "$foo"})
+	c.Assert(Ceil(Ref(Foo)), check.DeepEquals, bson.M{"$ceil": "$foo"})
+	c.Assert(Floor(Ref(Foo)), check.DeepEquals, bson.M{"$floor": "$foo"})
+}
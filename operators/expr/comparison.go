@@ -0,0 +1,56 @@
+package expr
+
+import (
+	"gopkg.in/mgo.v2/bson"
+)
+
+// Eq Returns true if the values are equivalent.
+func Eq(a, b interface{}) bson.M {
+	return op("$eq", a, b)
+}
+
+// Ne Returns true if the values are not equivalent.
+func Ne(a, b interface{}) bson.M {
+	return op("$ne", a, b)
+}
+
+// Gt Returns true if the first value is greater than the second.
+func Gt(a, b interface{}) bson.M {
+	return op("$gt", a, b)
+}
+
+// Gte Returns true if the first value is greater than or equal to the second.
+func Gte(a, b interface{}) bson.M {
+	return op("$gte", a, b)
+}
+
+// Lt Returns true if the first value is less than the second.
+func Lt(a, b interface{}) bson.M {
+	return op("$lt", a, b)
+}
+
+// Lte Returns true if the first value is less than or equal to the second.
+func Lte(a, b interface{}) bson.M {
+	return op("$lte", a, b)
+}
+
+// Cmp Returns 0 if the two values are equivalent, 1 if the first value is
+// greater than the second, and -1 if the first value is less than the second.
+func Cmp(a, b interface{}) bson.M {
+	return op("$cmp", a, b)
+}
+
+// And Returns true only when all its expressions evaluate to true.
+func And(exprs ...interface{}) bson.M {
+	return op("$and", exprs...)
+}
+
+// Or Returns true when any of its expressions evaluates to true.
+func Or(exprs ...interface{}) bson.M {
+	return op("$or", exprs...)
+}
+
+// Not Returns the boolean value that is the opposite of its expression.
+func Not(expr interface{}) bson.M {
+	return op("$not", expr)
+}
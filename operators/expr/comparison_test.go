@@ -0,0 +1,31 @@
+package expr
+
+import (
+	"gopkg.in/check.v1"
+	"gopkg.in/mgo.v2/bson"
+)
+
+func (s *ExprSuite) TestComparison(c *check.C) {
+	for op, f := range map[string]func(a, b interface{}) bson.M{
+		"$eq":  Eq,
+		"$ne":  Ne,
+		"$gt":  Gt,
+		"$gte": Gte,
+		"$lt":  Lt,
+		"$lte": Lte,
+		"$cmp": Cmp,
+	} {
+		c.Assert(f(Ref(Foo), Ref(Qux)), check.DeepEquals, bson.M{
+			op: []interface{}{"$foo", "$qux.bar"},
+		})
+	}
+}
+
+func (s *ExprSuite) TestAndOrNot(c *check.C) {
+	gt := Gt(Ref(Foo), 1)
+	lt := Lt(Ref(Foo), 5)
+
+	c.Assert(And(gt, lt), check.DeepEquals, bson.M{"$and": []interface{}{gt, lt}})
+	c.Assert(Or(gt, lt), check.DeepEquals, bson.M{"$or": []interface{}{gt, lt}})
+	c.Assert(Not(gt), check.DeepEquals, bson.M{"$not": []interface{}{gt}})
+}
@@ -0,0 +1,34 @@
+package expr
+
+import (
+	"gopkg.in/mgo.v2/bson"
+)
+
+// Cond Evaluates a boolean expression to return one of the two specified
+// return expressions.
+func Cond(cond, then, otherwise interface{}) bson.M {
+	return bson.M{"$cond": bson.M{"if": cond, "then": then, "else": otherwise}}
+}
+
+// IfNull Returns the replacement if the expression evaluates to a null value,
+// including instances of undefined values or missing fields.
+func IfNull(expr, replacement interface{}) bson.M {
+	return op("$ifNull", expr, replacement)
+}
+
+// Case is a branch of a Switch, Then is returned if Case evaluates to true.
+type Case struct {
+	Case interface{}
+	Then interface{}
+}
+
+// Switch Evaluates the cases in order and returns the Then of the first case
+// that evaluates to true, the default is returned if none of them does.
+func Switch(cases []Case, def interface{}) bson.M {
+	branches := make([]bson.M, len(cases))
+	for i, c := range cases {
+		branches[i] = bson.M{"case": c.Case, "then": c.Then}
+	}
+
+	return bson.M{"$switch": bson.M{"branches": branches, "default": def}}
+}
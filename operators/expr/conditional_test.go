@@ -0,0 +1,35 @@
+package expr
+
+import (
+	"gopkg.in/check.v1"
+	"gopkg.in/mgo.v2/bson"
+)
+
+func (s *ExprSuite) TestCond(c *check.C) {
+	cond := Cond(Gt(Ref(Foo), 1), Ref(Qux), 0)
+	c.Assert(cond, check.DeepEquals, bson.M{"$cond": bson.M{
+		"if":   bson.M{"$gt": []interface{}{"$foo", 1}},
+		"then": "$qux.bar",
+		"else": 0,
+	}})
+}
+
+func (s *ExprSuite) TestIfNull(c *check.C) {
+	ifNull := IfNull(Ref(Foo), 0)
+	c.Assert(ifNull, check.DeepEquals, bson.M{"$ifNull": []interface{}{"$foo", 0}})
+}
+
+func (s *ExprSuite) TestSwitch(c *check.C) {
+	sw := Switch([]Case{
+		{Gt(Ref(Foo), 10), "high"},
+		{Gt(Ref(Foo), 5), "medium"},
+	}, "low")
+
+	c.Assert(sw, check.DeepEquals, bson.M{"$switch": bson.M{
+		"branches": []bson.M{
+			{"case": bson.M{"$gt": []interface{}{"$foo", 10}}, "then": "high"},
+			{"case": bson.M{"$gt": []interface{}{"$foo", 5}}, "then": "medium"},
+		},
+		"default": "low",
+	}})
+}
@@ -0,0 +1,60 @@
+package expr
+
+import (
+	"gopkg.in/mgo.v2/bson"
+)
+
+// Year Returns the year for a date as a number, e.g. 2014.
+func Year(date interface{}) bson.M {
+	return bson.M{"$year": date}
+}
+
+// Month Returns the month for a date as a number between 1 and 12.
+func Month(date interface{}) bson.M {
+	return bson.M{"$month": date}
+}
+
+// Week Returns the week number for a date as a number between 0 and 53.
+func Week(date interface{}) bson.M {
+	return bson.M{"$week": date}
+}
+
+// DayOfYear Returns the day of the year for a date as a number between 1 and
+// 366.
+func DayOfYear(date interface{}) bson.M {
+	return bson.M{"$dayOfYear": date}
+}
+
+// DayOfMonth Returns the day of the month for a date as a number between 1 and
+// 31.
+func DayOfMonth(date interface{}) bson.M {
+	return bson.M{"$dayOfMonth": date}
+}
+
+// DayOfWeek Returns the day of the week for a date as a number between 1
+// (Sunday) and 7 (Saturday).
+func DayOfWeek(date interface{}) bson.M {
+	return bson.M{"$dayOfWeek": date}
+}
+
+// Hour Returns the hour for a date as a number between 0 and 23.
+func Hour(date interface{}) bson.M {
+	return bson.M{"$hour": date}
+}
+
+// Minute Returns the minute for a date as a number between 0 and 59.
+func Minute(date interface{}) bson.M {
+	return bson.M{"$minute": date}
+}
+
+// Second Returns the seconds for a date as a number between 0 and 60, leap
+// seconds included.
+func Second(date interface{}) bson.M {
+	return bson.M{"$second": date}
+}
+
+// Millisecond Returns the milliseconds of a date as a number between 0 and
+// 999.
+func Millisecond(date interface{}) bson.M {
+	return bson.M{"$millisecond": date}
+}
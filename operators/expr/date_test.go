@@ -0,0 +1,23 @@
+package expr
+
+import (
+	"gopkg.in/check.v1"
+	"gopkg.in/mgo.v2/bson"
+)
+
+func (s *ExprSuite) TestDateParts(c *check.C) {
+	for op, f := range map[string]func(interface{}) bson.M{
+		"$year":        Year,
+		"$month":       Month,
+		"$week":        Week,
+		"$dayOfYear":   DayOfYear,
+		"$dayOfMonth":  DayOfMonth,
+		"$dayOfWeek":   DayOfWeek,
+		"$hour":        Hour,
+		"$minute":      Minute,
+		"$second":      Second,
+		"$millisecond": Millisecond,
+	} {
+		c.Assert(f(Ref(Foo)), check.DeepEquals, bson.M{op: "$foo"})
+	}
+}
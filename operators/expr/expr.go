@@ -0,0 +1,46 @@
+// Package expr builds aggregation expressions, to be used as criteria with
+// operators.Expr, allowing to compare fields of the same document:
+//
+//	import "gopkg.in/src-d/storable.v1/operators/expr"
+//
+//	func (q *ProductQuery) FindDiscountHigherThanPrice() {
+//		q.AddCriteria(operators.Expr(expr.Gt(
+//			expr.Ref(Schema.Product.Discount),
+//			expr.Ref(Schema.Product.Price.Amount),
+//		)))
+//	}
+//
+// The arguments of the expressions can be field references, literal values or
+// other expressions.
+package expr
+
+import (
+	"strings"
+
+	"gopkg.in/mgo.v2/bson"
+)
+
+type Field interface {
+	String() string
+}
+
+// Ref returns a reference to the value of the field in the current document.
+func Ref(field Field) string {
+	return "$" + field.String()
+}
+
+// Literal returns a value without parsing it, useful for strings starting by
+// $ that otherwise will be evaluated as field references.
+func Literal(value interface{}) bson.M {
+	return bson.M{"$literal": value}
+}
+
+// IsRef returns if the given value is a field reference, as returned by Ref.
+func IsRef(value interface{}) bool {
+	s, ok := value.(string)
+	return ok && strings.HasPrefix(s, "$") && !strings.HasPrefix(s, "$$")
+}
+
+func op(name string, args ...interface{}) bson.M {
+	return bson.M{name: args}
+}
@@ -0,0 +1,41 @@
+package expr
+
+import (
+	"testing"
+
+	"gopkg.in/check.v1"
+	"gopkg.in/mgo.v2/bson"
+)
+
+func Test(t *testing.T) { check.TestingT(t) }
+
+type ExprSuite struct{}
+
+var _ = check.Suite(&ExprSuite{})
+
+var (
+	Foo = FieldExample("foo")
+	Qux = FieldExample("qux.bar")
+)
+
+type FieldExample string
+
+func (f FieldExample) String() string {
+	return string(f)
+}
+
+func (s *ExprSuite) TestRef(c *check.C) {
+	c.Assert(Ref(Foo), check.Equals, "$foo")
+	c.Assert(Ref(Qux), check.Equals, "$qux.bar")
+}
+
+func (s *ExprSuite) TestLiteral(c *check.C) {
+	c.Assert(Literal("$foo"), check.DeepEquals, bson.M{"$literal": "$foo"})
+}
+
+func (s *ExprSuite) TestIsRef(c *check.C) {
+	c.Assert(IsRef(Ref(Foo)), check.Equals, true)
+	c.Assert(IsRef("foo"), check.Equals, false)
+	c.Assert(IsRef("$$ROOT"), check.Equals, false)
+	c.Assert(IsRef(42), check.Equals, false)
+}
@@ -0,0 +1,43 @@
+package operators
+
+import (
+	"gopkg.in/mgo.v2/bson"
+)
+
+// The geometries are GeoJSON objects, as the types storable.Point or
+// storable.Polygon, and the distances are in meters.
+
+// GeoWithin Selects geometries within a bounding GeoJSON geometry.
+func GeoWithin(field Field, geometry interface{}) bson.M {
+	return bson.M{field.String(): bson.M{"$geoWithin": bson.M{"$geometry": geometry}}}
+}
+
+// GeoIntersects Selects geometries that intersect with a GeoJSON geometry.
+func GeoIntersects(field Field, geometry interface{}) bson.M {
+	return bson.M{field.String(): bson.M{"$geoIntersects": bson.M{"$geometry": geometry}}}
+}
+
+// Near Returns geospatial objects in proximity to a point, sorted from the
+// nearest to the farthest. A distance of zero means no limit.
+func Near(field Field, point interface{}, maxDistance, minDistance float64) bson.M {
+	return bson.M{field.String(): bson.M{"$near": near(point, maxDistance, minDistance)}}
+}
+
+// NearSphere Returns geospatial objects in proximity to a point on a sphere,
+// sorted from the nearest to the farthest. A distance of zero means no limit.
+func NearSphere(field Field, point interface{}, maxDistance, minDistance float64) bson.M {
+	return bson.M{field.String(): bson.M{"$nearSphere": near(point, maxDistance, minDistance)}}
+}
+
+func near(point interface{}, maxDistance, minDistance float64) bson.M {
+	n := bson.M{"$geometry": point}
+	if maxDistance != 0 {
+		n["$maxDistance"] = maxDistance
+	}
+
+	if minDistance != 0 {
+		n["$minDistance"] = minDistance
+	}
+
+	return n
+}
@@ -0,0 +1,41 @@
+package operators
+
+import (
+	"gopkg.in/check.v1"
+	"gopkg.in/mgo.v2/bson"
+)
+
+var point = bson.M{"type": "Point", "coordinates": []float64{1, 2}}
+
+func (s *OperatorsSuite) TestGeoWithin(c *check.C) {
+	within := GeoWithin(Foo, point)
+	c.Assert(within, check.DeepEquals, bson.M{"foo": bson.M{
+		"$geoWithin": bson.M{"$geometry": point},
+	}})
+}
+
+func (s *OperatorsSuite) TestGeoIntersects(c *check.C) {
+	intersects := GeoIntersects(Foo, point)
+	c.Assert(intersects, check.DeepEquals, bson.M{"foo": bson.M{
+		"$geoIntersects": bson.M{"$geometry": point},
+	}})
+}
+
+func (s *OperatorsSuite) TestNear(c *check.C) {
+	near := Near(Foo, point, 100, 10)
+	c.Assert(near, check.DeepEquals, bson.M{"foo": bson.M{
+		"$near": bson.M{"$geometry": point, "$maxDistance": 100.0, "$minDistance": 10.0},
+	}})
+
+	near = Near(Foo, point, 0, 0)
+	c.Assert(near, check.DeepEquals, bson.M{"foo": bson.M{
+		"$near": bson.M{"$geometry": point},
+	}})
+}
+
+func (s *OperatorsSuite) TestNearSphere(c *check.C) {
+	near := NearSphere(Foo, point, 100, 0)
+	c.Assert(near, check.DeepEquals, bson.M{"foo": bson.M{
+		"$nearSphere": bson.M{"$geometry": point, "$maxDistance": 100.0},
+	}})
+}
@@ -0,0 +1,20 @@
+package operators
+
+import (
+	"gopkg.in/mgo.v2/bson"
+)
+
+// The projection operators are used with BaseQuery.Project, ElemMatch can be
+// used also as projection, returning only the first matching element.
+
+// Slice Limits the number of elements of the array to return, a negative
+// count returns the last elements.
+func Slice(field Field, count int) bson.M {
+	return bson.M{field.String(): bson.M{"$slice": count}}
+}
+
+// SliceRange Returns limit elements of the array after skipping the first
+// skip elements, a negative skip counts from the end of the array.
+func SliceRange(field Field, skip, limit int) bson.M {
+	return bson.M{field.String(): bson.M{"$slice": []int{skip, limit}}}
+}
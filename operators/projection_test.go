@@ -0,0 +1,16 @@
+package operators
+
+import (
+	"gopkg.in/check.v1"
+	"gopkg.in/mgo.v2/bson"
+)
+
+func (s *OperatorsSuite) TestSlice(c *check.C) {
+	slice := Slice(Foo, -5)
+	c.Assert(slice, check.DeepEquals, bson.M{"foo": bson.M{"$slice": -5}})
+}
+
+func (s *OperatorsSuite) TestSliceRange(c *check.C) {
+	slice := SliceRange(Foo, 10, 5)
+	c.Assert(slice, check.DeepEquals, bson.M{"foo": bson.M{"$slice": []int{10, 5}}})
+}
@@ -0,0 +1,180 @@
+package storable
+
+import (
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// EnableOplog makes the store append an entry to the given oplog-like
+// collection after every write of Insert, Update, UpdateDiff, Save, Delete,
+// RawUpdate, RawDelete and FindAndModify, with the format of local.oplog.rs.
+// Watch polls this collection instead of the oplog of the server, allowing
+// to watch the collection on standalone servers, where there is no oplog.
+//
+// The entries are written after the documents, inside a transaction once the
+// transaction is committed. The ts of the entries is assigned by the server,
+// the empty timestamps are replaced by the current one on insert. The entries
+// of the updates with operators also have the document after the update as
+// postImage, matched by the filtered Watch.
+func (s *Store) EnableOplog(database, collection string) {
+	s.oplogDatabase, s.oplogCollection = database, collection
+}
+
+// logWrite appends an entry to the oplog of the store, if enabled, with the
+// given operation (i, u or d) on the document with the given id.
+func (s *Store) logWrite(op string, id interface{}, o interface{}) error {
+	if s.oplogCollection == "" {
+		return nil
+	}
+
+	return s.appendEntry(s.entry(op, id, o))
+}
+
+// logUpdate appends the entry of an update with operators to the oplog, if
+// enabled, with the document after the update as postImage, read inside the
+// transaction of the store if any. The postImage is matched by the filtered
+// Watch, the update alone cannot be matched.
+func (s *Store) logUpdate(id interface{}, update interface{}) error {
+	if s.oplogCollection == "" {
+		return nil
+	}
+
+	entry := s.entry("u", id, update)
+
+	var post bson.Raw
+	err := s.raw().findRawId(id, &post)
+	if err == nil {
+		entry = append(entry, bson.DocElem{Name: "postImage", Value: post})
+	} else if err != ErrNotFound {
+		return err
+	}
+
+	return s.appendEntry(entry)
+}
+
+// entry returns an oplog entry of the given operation.
+func (s *Store) entry(op string, id interface{}, o interface{}) bson.D {
+	// the ts should be the first field to be replaced by the server
+	entry := bson.D{
+		{Name: "ts", Value: bson.MongoTimestamp(0)},
+		{Name: "op", Value: op},
+		{Name: "ns", Value: s.db.Name + "." + s.collection},
+		{Name: "o", Value: o},
+	}
+
+	if op == "u" {
+		entry = append(entry, bson.DocElem{Name: "o2", Value: bson.M{"_id": id}})
+	}
+
+	return entry
+}
+
+// appendEntry writes the given entry to the oplog, once the transaction is
+// committed if any.
+func (s *Store) appendEntry(entry bson.D) error {
+	write := func() error {
+		sess := s.db.Session.Copy()
+		defer sess.Close()
+
+		return sess.DB(s.oplogDatabase).C(s.oplogCollection).Insert(entry)
+	}
+
+	if s.tx != nil {
+		s.tx.afterCommit(write)
+		return nil
+	}
+
+	return write()
+}
+
+// logDelete appends a delete entry to the oplog of the store, if enabled.
+func (s *Store) logDelete(id interface{}) error {
+	return s.logWrite("d", id, bson.M{"_id": id})
+}
+
+// matchingIds returns the ids of the documents matching the query, only the
+// first one if multi is false, used to log the raw writes. Nothing is
+// returned if the oplog is disabled.
+func (s *Store) matchingIds(query Query, multi bool) ([]interface{}, error) {
+	if s.oplogCollection == "" {
+		return nil, nil
+	}
+
+	q := NewBaseQuery()
+	q.AddCriteria(query.GetCriteria())
+	q.Project(bson.M{"_id": 1})
+	if query.GetCollation() != nil {
+		q.Collation(query.GetCollation())
+	}
+
+	if !multi {
+		q.Limit(1)
+	}
+
+	rs, err := s.raw().Find(q)
+	if err != nil {
+		return nil, err
+	}
+
+	var docs []struct {
+		Id interface{} `bson:"_id"`
+	}
+
+	if err := rs.All(&docs); err != nil {
+		return nil, err
+	}
+
+	ids := make([]interface{}, len(docs))
+	for i, d := range docs {
+		ids[i] = d.Id
+	}
+
+	return ids, nil
+}
+
+// logFindAndModify appends the entry of a FindAndModify to the oplog, doc is
+// the document returned by the command.
+func (s *Store) logFindAndModify(change mgo.Change, info *mgo.ChangeInfo, doc bson.Raw) error {
+	var returned struct {
+		Id interface{} `bson:"_id"`
+	}
+
+	if doc.Kind == 0x03 {
+		if err := doc.Unmarshal(&returned); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case change.Remove:
+		if info.Removed == 0 {
+			return nil
+		}
+
+		return s.logDelete(returned.Id)
+	case info.UpsertedId != nil:
+		if !change.ReturnNew {
+			if err := s.raw().findRawId(info.UpsertedId, &doc); err != nil {
+				return err
+			}
+		}
+
+		return s.logWrite("i", info.UpsertedId, doc)
+	case info.Updated != 0 && returned.Id != nil:
+		return s.logUpdate(returned.Id, change.Update)
+	}
+
+	return nil
+}
+
+func (s *Store) findRawId(id interface{}, result *bson.Raw) error {
+	q := NewBaseQuery()
+	q.AddCriteria(bson.M{"_id": id})
+
+	rs, err := s.Find(q)
+	if err != nil {
+		return err
+	}
+
+	return rs.One(result)
+}
@@ -0,0 +1,45 @@
+package outbox
+
+import (
+	"time"
+
+	"gopkg.in/mgo.v2/bson"
+	"gopkg.in/src-d/storable.v1"
+)
+
+//go:generate storable gen
+
+// Event is a domain event, stored on the outbox collection until a Relay
+// delivers it. The events are delivered at least once, so the consumers
+// should use its Id to discard the duplicates.
+type Event struct {
+	storable.Document `bson:",inline" collection:"outbox"`
+
+	Topic string
+	// Payload is the document given to Publish, see Decode.
+	Payload bson.Raw
+	// Sequence is the order of the event in the outbox, assigned by Publish.
+	Sequence  int64
+	CreatedAt time.Time
+	// Dispatched is set once the event is delivered by a Relay.
+	Dispatched   bool
+	DispatchedAt time.Time `bson:",omitempty"`
+}
+
+func newEvent(topic string, payload interface{}) (*Event, error) {
+	data, err := bson.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Event{
+		Topic:     topic,
+		Payload:   bson.Raw{Kind: 0x03, Data: data},
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// Decode unmarshals the payload of the event into v.
+func (e *Event) Decode(v interface{}) error {
+	return e.Payload.Unmarshal(v)
+}
@@ -0,0 +1,143 @@
+package outbox
+
+import (
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+	"gopkg.in/src-d/storable.v1"
+	"gopkg.in/src-d/storable.v1/operators"
+)
+
+// SequenceSuffix is the suffix of the collection holding the sequence of the
+// events of an outbox.
+const SequenceSuffix = "_sequence"
+
+// namespaceExists is the code of the error creating an existing collection.
+const namespaceExists = 48
+
+// Outbox is a collection of events pending to be delivered. The events are
+// written atomically with the documents publishing them when both writes are
+// done inside the same transaction, usually from the hooks of a store bound
+// to it:
+//
+//	err := storable.WithTransaction(ctx, db, func(tx *storable.Tx) error {
+//		if err := orders.InTx(tx).Insert(order); err != nil {
+//			return err
+//		}
+//
+//		_, err := events.InTx(tx).Publish("order.created", order)
+//		return err
+//	})
+//
+// Outside a transaction the events are written on its own, so they are lost
+// if the process crashes between the write of the document and the publish.
+//
+// Every event takes a sequence from a counter updated inside the publishing
+// transaction, so the concurrent transactions publishing events conflict and
+// the events are committed in the order of its sequence. The events published
+// outside a transaction may be committed out of order, and then be delivered
+// after the following ones.
+type Outbox struct {
+	db         *mgo.Database
+	collection string
+	store      *EventStore
+	sequences  *storable.Store
+}
+
+// New returns an Outbox storing the events on the given collection, and its
+// sequence on the collection with the SequenceSuffix.
+func New(db *mgo.Database, collection string) *Outbox {
+	store := storable.NewStore(db, collection)
+	return &Outbox{
+		db:         db,
+		collection: collection,
+		store:      &EventStore{*store},
+		sequences:  store.Related(collection + SequenceSuffix),
+	}
+}
+
+// InTx returns a copy of the outbox writing the events inside the given
+// transaction, see storable.WithTransaction. A nil transaction is allowed,
+// as the one returned by the Tx method of a store not bound to any.
+func (o *Outbox) InTx(tx *storable.Tx) *Outbox {
+	if tx == nil {
+		return o
+	}
+
+	return &Outbox{
+		db:         o.db,
+		collection: o.collection,
+		store:      o.store.InTx(tx),
+		sequences:  o.sequences.InTx(tx),
+	}
+}
+
+// EnsureIndexes creates the index used by the relays to find the pending
+// events, and the collection of the sequence, since it cannot be created
+// inside a transaction.
+func (o *Outbox) EnsureIndexes() error {
+	err := o.store.EnsureIndex(mgo.Index{Key: []string{
+		storable.AscIndex.Key(Schema.Event.Dispatched),
+		storable.AscIndex.Key(Schema.Event.Sequence),
+	}})
+
+	if err != nil {
+		return err
+	}
+
+	sess := o.db.Session.Copy()
+	defer sess.Close()
+
+	c := o.db.With(sess).C(o.collection + SequenceSuffix)
+	err = c.Create(&mgo.CollectionInfo{})
+	if qerr, ok := err.(*mgo.QueryError); ok && qerr.Code == namespaceExists {
+		return nil
+	}
+
+	return err
+}
+
+// Publish writes an event with the given topic and payload to the outbox.
+func (o *Outbox) Publish(topic string, payload interface{}) (*Event, error) {
+	e, err := o.store.New(topic, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.Sequence, err = o.nextSequence(); err != nil {
+		return nil, err
+	}
+
+	return e, o.store.Insert(e)
+}
+
+// nextSequence increments the sequence of the outbox, returning the new one.
+func (o *Outbox) nextSequence() (int64, error) {
+	q := storable.NewBaseQuery()
+	q.AddCriteria(bson.M{"_id": "sequence"})
+
+	var result struct {
+		Sequence int64 `bson:"sequence"`
+	}
+
+	_, err := o.sequences.FindAndModify(q, mgo.Change{
+		Update:    bson.M{"$inc": bson.M{"sequence": 1}},
+		Upsert:    true,
+		ReturnNew: true,
+	}, &result)
+
+	return result.Sequence, err
+}
+
+// Pending returns the number of events not dispatched yet.
+func (o *Outbox) Pending() (int, error) {
+	return o.store.Count(o.pendingQuery())
+}
+
+// pendingQuery returns the events not dispatched yet, in order of sequence.
+func (o *Outbox) pendingQuery() *EventQuery {
+	q := o.store.Query()
+	q.AddCriteria(operators.Eq(Schema.Event.Dispatched, false))
+	q.Sort(storable.Sort{{Schema.Event.Sequence, storable.Asc}})
+
+	return q
+}
@@ -0,0 +1,66 @@
+package outbox
+
+import (
+	"testing"
+
+	. "gopkg.in/check.v1"
+	"gopkg.in/mgo.v2/bson"
+	"gopkg.in/src-d/storable.v1"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type OutboxSuite struct{}
+
+var _ = Suite(&OutboxSuite{})
+
+type payload struct {
+	Name string
+}
+
+func (s *OutboxSuite) TestNewEvent(c *C) {
+	store := NewEventStore(nil)
+	foo, err := store.New("foo", &payload{"foo"})
+	c.Assert(err, IsNil)
+	c.Assert(foo.Topic, Equals, "foo")
+	c.Assert(foo.Id.Valid(), Equals, true)
+	c.Assert(foo.IsNew(), Equals, true)
+	c.Assert(foo.Dispatched, Equals, false)
+
+	qux, err := store.New("qux", &payload{"qux"})
+	c.Assert(err, IsNil)
+	c.Assert(foo.Id < qux.Id, Equals, true)
+
+	var p payload
+	c.Assert(qux.Decode(&p), IsNil)
+	c.Assert(p.Name, Equals, "qux")
+}
+
+func (s *OutboxSuite) TestNewEventInvalidPayload(c *C) {
+	_, err := NewEventStore(nil).New("foo", 42)
+	c.Assert(err, NotNil)
+}
+
+func (s *OutboxSuite) TestInTxNil(c *C) {
+	o := New(nil, "events")
+	c.Assert(o.InTx(nil), Equals, o)
+}
+
+func (s *OutboxSuite) TestPendingQuery(c *C) {
+	q := New(nil, "events").pendingQuery()
+	c.Assert(q.GetClauses(), DeepEquals, []bson.M{{"dispatched": bson.M{"$eq": false}}})
+	c.Assert(q.GetSort(), DeepEquals, storable.Sort{{Schema.Event.Sequence, storable.Asc}})
+}
+
+func (s *OutboxSuite) TestSinkFunc(c *C) {
+	var delivered *Event
+	sink := SinkFunc(func(e *Event) error {
+		delivered = e
+		return nil
+	})
+
+	e, err := NewEventStore(nil).New("foo", &payload{"foo"})
+	c.Assert(err, IsNil)
+	c.Assert(sink.Deliver(e), IsNil)
+	c.Assert(delivered, Equals, e)
+}
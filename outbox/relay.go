@@ -0,0 +1,105 @@
+package outbox
+
+import (
+	"errors"
+	"time"
+
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+	"gopkg.in/src-d/storable.v1/operators"
+)
+
+// ErrStopped is returned by Relay.Run when it is stopped
+var ErrStopped = errors.New("Relay stopped.")
+
+// Sink delivers the events, eg: to a message broker.
+type Sink interface {
+	// Deliver delivers the given event, once it returns without error the
+	// event is marked as dispatched.
+	Deliver(e *Event) error
+}
+
+// SinkFunc is a function implementing Sink.
+type SinkFunc func(e *Event) error
+
+// Deliver calls f(e).
+func (f SinkFunc) Deliver(e *Event) error {
+	return f(e)
+}
+
+// Relay reads the pending events from an outbox, in the order of its
+// sequence, delivers them to a sink and marks them as dispatched.
+type Relay struct {
+	outbox *Outbox
+	sink   Sink
+}
+
+// NewRelay returns a Relay delivering the events of the given outbox to the
+// given sink.
+func NewRelay(o *Outbox, sink Sink) *Relay {
+	return &Relay{outbox: o, sink: sink}
+}
+
+// Dispatch delivers the pending events in the order of its sequence. It
+// stops at the first failed delivery, so the following events are not
+// delivered before it. Returns the number of events delivered.
+func (r *Relay) Dispatch() (int, error) {
+	rs, err := r.outbox.store.Find(r.outbox.pendingQuery())
+	if err != nil {
+		return 0, err
+	}
+
+	defer rs.Close()
+
+	var count int
+	err = rs.ForEach(func(e *Event) error {
+		if err := r.sink.Deliver(e); err != nil {
+			return err
+		}
+
+		if err := r.markDispatched(e); err != nil {
+			return err
+		}
+
+		count++
+		return nil
+	})
+
+	return count, err
+}
+
+// markDispatched marks the given event as dispatched, nothing is done if it
+// was marked by other relay.
+func (r *Relay) markDispatched(e *Event) error {
+	q := r.outbox.store.Query()
+	q.FindById(e.Id)
+	q.AddCriteria(operators.Eq(Schema.Event.Dispatched, false))
+
+	err := r.outbox.store.RawUpdate(q, bson.M{
+		Schema.Event.Dispatched.String():   true,
+		Schema.Event.DispatchedAt.String(): time.Now(),
+	}, false)
+
+	if err == mgo.ErrNotFound {
+		return nil
+	}
+
+	return err
+}
+
+// Run calls Dispatch waiting the given interval between the calls, until
+// stop is closed, when ErrStopped is returned. The errors of Dispatch are
+// returned as well.
+func (r *Relay) Run(interval time.Duration, stop <-chan struct{}) error {
+	for {
+		if _, err := r.Dispatch(); err != nil {
+			return err
+		}
+
+		select {
+		case <-stop:
+			return ErrStopped
+		case <-time.After(interval):
+		}
+	}
+}
@@ -0,0 +1,347 @@
+package outbox
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+	"gopkg.in/src-d/storable.v1"
+	"gopkg.in/src-d/storable.v1/operators"
+)
+
+type EventStore struct {
+	storable.Store
+}
+
+func NewEventStore(db *mgo.Database) *EventStore {
+	return &EventStore{*storable.NewStore(db, "outbox")}
+}
+
+// WithContext returns a copy of the store using the given context, see
+// storable.Store.WithContext.
+func (s *EventStore) WithContext(ctx context.Context) *EventStore {
+	return &EventStore{*s.Store.WithContext(ctx)}
+}
+
+// InTx returns a copy of the store running its operations, including the
+// hooks, inside the given transaction. See storable.WithTransaction.
+func (s *EventStore) InTx(tx *storable.Tx) *EventStore {
+	return &EventStore{*s.Store.InTx(tx)}
+}
+
+// New returns a new instance of Event.
+func (s *EventStore) New(topic string, payload interface{}) (doc *Event, err error) {
+	doc, err = newEvent(topic, payload)
+	if doc != nil {
+		doc.SetIsNew(true)
+		doc.SetId(bson.NewObjectId())
+	}
+	return
+}
+
+// Query return a new instance of EventQuery.
+func (s *EventStore) Query() *EventQuery {
+	return &EventQuery{*storable.NewBaseQuery()}
+}
+
+// Find performs a find on the collection using the given query.
+func (s *EventStore) Find(query *EventQuery) (*EventResultSet, error) {
+	resultSet, err := s.Store.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventResultSet{ResultSet: *resultSet}, nil
+}
+
+// MustFind like Find but panics on error
+func (s *EventStore) MustFind(query *EventQuery) *EventResultSet {
+	resultSet := s.Store.MustFind(query)
+	return &EventResultSet{ResultSet: *resultSet}
+}
+
+// Tail performs a find on the capped collection using the given query,
+// waiting for new documents up to the given timeout. See storable.Store.Tail.
+func (s *EventStore) Tail(query *EventQuery, timeout time.Duration) (*EventResultSet, error) {
+	resultSet, err := s.Store.Tail(query, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventResultSet{ResultSet: *resultSet}, nil
+}
+
+// FindOne performs a find on the collection using the given query returning
+// the first document from the resultset.
+func (s *EventStore) FindOne(query *EventQuery) (*Event, error) {
+	resultSet, err := s.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultSet.One()
+}
+
+// MustFindOne like FindOne but panics on error
+func (s *EventStore) MustFindOne(query *EventQuery) *Event {
+	doc, err := s.FindOne(query)
+	if err != nil {
+		panic(err)
+	}
+
+	return doc
+}
+
+// FindAndModify atomically modifies the first document matching the given
+// query and returns it, before the change or after it if change.ReturnNew is
+// set. See storable.Store.FindAndModify.
+func (s *EventStore) FindAndModify(query *EventQuery, change mgo.Change) (*Event, error) {
+	var result *Event
+	_, err := s.Store.FindAndModify(query, change, &result)
+
+	return result, err
+}
+
+// Insert insert the given document on the collection, trigger BeforeInsert and
+// AfterInsert if any. Throws ErrNonNewDocument if doc is a non-new document.
+func (s *EventStore) Insert(doc *Event) error {
+
+	err := s.Store.Insert(doc)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Update update the given document on the collection, trigger BeforeUpdate and
+// AfterUpdate if any. Throws ErrNewDocument if doc is a new document.
+func (s *EventStore) Update(doc *Event) error {
+
+	err := s.Store.Update(doc)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateDiff update the given document on the collection writing only the
+// changes from old, trigger BeforeUpdate and AfterUpdate if any. See
+// storable.Store.UpdateDiff.
+func (s *EventStore) UpdateDiff(old, doc *Event) (storable.Changes, error) {
+
+	changes, err := s.Store.UpdateDiff(old, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// Save insert or update the given document on the collection using Upsert,
+// trigger BeforeUpdate and AfterUpdate if the document is non-new and
+// BeforeInsert and AfterInset if is new.
+func (s *EventStore) Save(doc *Event) (updated bool, err error) {
+	updated, err = s.Store.Save(doc)
+	if err != nil {
+		return false, err
+	}
+
+	return
+}
+
+type EventQuery struct {
+	storable.BaseQuery
+}
+
+// FindById add a new criteria to the query searching by _id
+func (q *EventQuery) FindById(ids ...bson.ObjectId) *EventQuery {
+	var vs []interface{}
+	for _, id := range ids {
+		vs = append(vs, id)
+	}
+	q.AddCriteria(operators.In(storable.IdField, vs...))
+
+	return q
+}
+
+// Clone returns a copy of the query, see storable.BaseQuery.Clone.
+func (q *EventQuery) Clone() *EventQuery {
+	return &EventQuery{*q.BaseQuery.Clone()}
+}
+
+type EventResultSet struct {
+	storable.ResultSet
+	last    *Event
+	lastErr error
+}
+
+// All returns all documents on the resultset and close the resultset
+func (r *EventResultSet) All() ([]*Event, error) {
+	var result []*Event
+	err := r.ResultSet.All(&result)
+
+	return result, err
+}
+
+// One returns the first document on the resultset and close the resultset
+func (r *EventResultSet) One() (*Event, error) {
+	var result *Event
+	err := r.ResultSet.One(&result)
+
+	return result, err
+}
+
+// Next prepares the next result document for reading with the Get method.
+func (r *EventResultSet) Next() (returned bool) {
+	r.last = nil
+	returned, r.lastErr = r.ResultSet.Next(&r.last)
+
+	return
+}
+
+// Get returns the document retrieved with the Next method.
+func (r *EventResultSet) Get() (*Event, error) {
+	return r.last, r.lastErr
+}
+
+// ForEach iterates the resultset calling to the given function.
+func (r *EventResultSet) ForEach(f func(*Event) error) error {
+	for {
+		var result *Event
+		found, err := r.ResultSet.Next(&result)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			break
+		}
+
+		err = f(result)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Watch returns a stream with the changes on the documents matching the given
+// query, the current version of the document is retrieved on every update.
+// See storable.Store.Watch.
+func (s *EventStore) Watch(query *EventQuery, opts storable.WatchOptions) (*EventChangeStream, error) {
+	opts.FullDocument = true
+	cs, err := s.Store.Watch(query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventChangeStream{ChangeStream: cs}, nil
+}
+
+type EventChangeStream struct {
+	*storable.ChangeStream
+	event   storable.ChangeEvent
+	last    *Event
+	lastErr error
+}
+
+// Next waits for the next event, to be read with the Get method. Returns
+// false when the stream is closed or fails.
+func (cs *EventChangeStream) Next() (returned bool) {
+	cs.event, cs.last, cs.lastErr = storable.ChangeEvent{}, nil, nil
+	if !cs.ChangeStream.Next(&cs.event) {
+		cs.lastErr = cs.ChangeStream.Err()
+		return false
+	}
+
+	if cs.event.HasDocument() {
+		cs.lastErr = cs.event.Decode(&cs.last)
+	}
+
+	return true
+}
+
+// Get returns the event and the document retrieved with the Next method, the
+// document is nil on the deletes or if it does not exist anymore.
+func (cs *EventChangeStream) Get() (*storable.ChangeEvent, *Event, error) {
+	return &cs.event, cs.last, cs.lastErr
+}
+
+// ForEach waits for the events calling to the given function, until the
+// stream is closed or storable.ErrStop is returned.
+func (cs *EventChangeStream) ForEach(f func(*storable.ChangeEvent, *Event) error) error {
+	for cs.Next() {
+		event, doc, err := cs.Get()
+		if err != nil {
+			return err
+		}
+
+		err = f(event, doc)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return cs.lastErr
+}
+
+type schema struct {
+	Event *schemaEvent
+}
+
+type schemaEvent struct {
+	Topic        storable.Field
+	Payload      *schemaEventPayload
+	Sequence     storable.Field
+	CreatedAt    storable.Field
+	Dispatched   storable.Field
+	DispatchedAt storable.Field
+}
+
+type schemaEventPayload struct {
+}
+
+var Schema = schema{
+	Event: &schemaEvent{
+		Topic:        storable.NewField("topic", "string"),
+		Payload:      &schemaEventPayload{},
+		Sequence:     storable.NewField("sequence", "int64"),
+		CreatedAt:    storable.NewField("createdat", "time.Time"),
+		Dispatched:   storable.NewField("dispatched", "bool"),
+		DispatchedAt: storable.NewField("dispatchedat", "time.Time"),
+	},
+}
+
+func init() {
+	storable.Register(&storable.ModelInfo{
+		Name:       "Event",
+		Collection: "outbox",
+		Type:       reflect.TypeOf(Event{}),
+		Schema:     Schema.Event,
+		Fields: []*storable.FieldInfo{
+			{Name: "Id", Path: "_id", Type: "bson.ObjectId", Findable: true},
+			{Name: "Topic", Path: "topic", Type: "string", Findable: true},
+			{Name: "Payload", Path: "payload", Type: "bson.Raw", Findable: true, Fields: []*storable.FieldInfo{
+				{Name: "Kind", Path: "payload.kind", Type: "byte"},
+				{Name: "Data", Path: "payload.data", Type: "[]byte"},
+			}},
+			{Name: "Sequence", Path: "sequence", Type: "int64", Findable: true},
+			{Name: "CreatedAt", Path: "createdat", Type: "time.Time", Findable: true},
+			{Name: "Dispatched", Path: "dispatched", Type: "bool", Findable: true},
+			{Name: "DispatchedAt", Path: "dispatchedat", Type: "time.Time", Findable: true},
+		},
+	})
+}
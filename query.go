@@ -1,13 +1,20 @@
 package storable
 
 import (
+	"crypto/sha1"
 	"encoding/json"
+	"fmt"
+	"time"
 
 	"gopkg.in/src-d/storable.v1/operators"
 
+	"gopkg.in/mgo.v2"
 	"gopkg.in/mgo.v2/bson"
 )
 
+// Query is implemented by BaseQuery and the generated queries. Other
+// implementations should embed BaseQuery, the methods of the interface may
+// grow between minor versions, see the Breaking changes on the README.
 type Query interface {
 	GetCriteria() bson.M
 	Sort(s Sort)
@@ -18,6 +25,10 @@ type Query interface {
 	GetLimit() int
 	GetSkip() int
 	GetSelect() Select
+	GetProjection() bson.M
+	GetCollation() *mgo.Collation
+	GetOptions() QueryOptions
+	Validate(schema interface{}) error
 }
 
 type BaseQuery struct {
@@ -25,6 +36,31 @@ type BaseQuery struct {
 	limit, skip int
 	sort        Sort
 	selector    Select
+	projections []bson.M
+	collation   *mgo.Collation
+	options     QueryOptions
+	defaults    *BaseQuery
+	unscoped    bool
+}
+
+// QueryOptions are the options of the operation executing a query, they do
+// not change the documents matched by the query.
+type QueryOptions struct {
+	// Hint is the key of the index to be used, see IndexKind.Key.
+	Hint []string
+	// MaxTime is the maximum execution time of the query, zero means no limit.
+	MaxTime time.Duration
+	// BatchSize is the number of documents returned on each batch, zero means
+	// the default batch size.
+	BatchSize int
+	// Comment is attached to the operation, it is visible on the profiler and
+	// the logs.
+	Comment string
+	// NoCursorTimeout prevents the server from closing idle cursors.
+	NoCursorTimeout bool
+	// ReadMode overrides the read preference of the session, nil means the
+	// read preference of the session.
+	ReadMode *mgo.Mode
 }
 
 func NewBaseQuery() *BaseQuery {
@@ -49,11 +85,40 @@ func (q *BaseQuery) AddCriteria(expr bson.M) {
 
 // GetCriteria returns a valid bson.M used internally by Store.
 func (q *BaseQuery) GetCriteria() bson.M {
-	if len(q.clauses) == 0 {
+	clauses := q.scopedClauses()
+	if len(clauses) == 0 {
 		return nil
 	}
 
-	return operators.And(q.clauses...)
+	return operators.And(clauses...)
+}
+
+// SetDefaultScope sets the query holding the default scopes, its criteria is
+// added to the criteria of this query and its sort is used if this query has
+// none. The generated queries set it with the scopes marked as default.
+func (q *BaseQuery) SetDefaultScope(defaults *BaseQuery) {
+	q.defaults = defaults
+}
+
+// Unscoped disables the default scopes of the query.
+func (q *BaseQuery) Unscoped() {
+	q.unscoped = true
+}
+
+func (q *BaseQuery) scoped() bool {
+	return q.defaults != nil && !q.unscoped
+}
+
+// scopedClauses returns the clauses of the query, preceded by the clauses of
+// the default scopes if any.
+func (q *BaseQuery) scopedClauses() []bson.M {
+	if !q.scoped() {
+		return q.clauses
+	}
+
+	clauses := make([]bson.M, 0, len(q.defaults.clauses)+len(q.clauses))
+	clauses = append(clauses, q.defaults.clauses...)
+	return append(clauses, q.clauses...)
 }
 
 // Sort sets the sorting cristeria of the query.
@@ -77,8 +142,67 @@ func (q *BaseQuery) Select(projection Select) {
 	q.selector = projection
 }
 
+// Project adds projection expressions using operators, as $slice or
+// $elemMatch, they are merged with the fields from Select.
+//
+//  q.Project(operators.Slice(Schema.Post.Comments, -5))
+func (q *BaseQuery) Project(exprs ...bson.M) {
+	q.projections = append(q.projections, exprs...)
+}
+
+// Collation sets the language-specific rules used to compare strings on the
+// criteria and the sort of the query, eg. case-insensitive matches:
+//
+//  q.Collation(&mgo.Collation{Locale: "en", Strength: 2})
+//
+// The collation is honored by Store.Find, Count, Distinct, RawUpdate and
+// RawDelete, an index with the same collation is required to use the index.
+func (q *BaseQuery) Collation(c *mgo.Collation) {
+	q.collation = c
+}
+
+// Hint forces the query to use the index with the given key:
+//
+//  q.Hint(storable.DescIndex.Key(Schema.Product.CreatedAt))
+func (q *BaseQuery) Hint(indexKey ...string) {
+	q.options.Hint = indexKey
+}
+
+// MaxTime sets the maximum execution time of the query, the query fails if
+// it is exceeded.
+func (q *BaseQuery) MaxTime(d time.Duration) {
+	q.options.MaxTime = d
+}
+
+// BatchSize sets the number of documents returned on each batch.
+func (q *BaseQuery) BatchSize(n int) {
+	q.options.BatchSize = n
+}
+
+// Comment attaches a comment to the operation, as opposed to
+// operators.Comment, which is attached to the criteria.
+func (q *BaseQuery) Comment(comment string) {
+	q.options.Comment = comment
+}
+
+// NoCursorTimeout prevents the server from closing the cursor of the query
+// after being idle, the ResultSet should be closed when is not needed.
+func (q *BaseQuery) NoCursorTimeout() {
+	q.options.NoCursorTimeout = true
+}
+
+// ReadPreference overrides the read preference of the session for this
+// query, eg: mgo.SecondaryPreferred.
+func (q *BaseQuery) ReadPreference(mode mgo.Mode) {
+	q.options.ReadMode = &mode
+}
+
 // GetSort return the current sorting preferences of the query.
 func (q *BaseQuery) GetSort() Sort {
+	if q.sort.IsEmpty() && q.scoped() {
+		return q.defaults.sort
+	}
+
 	return q.sort
 }
 
@@ -97,6 +221,55 @@ func (q *BaseQuery) GetSelect() Select {
 	return q.selector
 }
 
+// GetCollation return the current collation of the query, nil if none.
+func (q *BaseQuery) GetCollation() *mgo.Collation {
+	return q.collation
+}
+
+// GetOptions return the current options of the query.
+func (q *BaseQuery) GetOptions() QueryOptions {
+	return q.options
+}
+
+// GetProjection return the projection to be used on the query, merging the
+// Select and the projection expressions, nil if none. The fields sorted by
+// TextScore are projected with the score, as is required by MongoDB.
+func (q *BaseQuery) GetProjection() bson.M {
+	p := q.selector.ToMap()
+	for _, expr := range q.projections {
+		for k, v := range expr {
+			p[k] = v
+		}
+	}
+
+	for _, fs := range q.GetSort() {
+		if _, ok := p[fs.F.String()]; fs.D == TextScore && !ok {
+			p[fs.F.String()] = textScoreMeta()
+		}
+	}
+
+	if len(p) == 0 {
+		return nil
+	}
+
+	return p
+}
+
+func textScoreMeta() bson.M {
+	return bson.M{"$meta": "textScore"}
+}
+
+func isTextScoreMeta(v interface{}) bool {
+	switch d := v.(type) {
+	case bson.M:
+		return len(d) == 1 && d["$meta"] == "textScore"
+	case bson.D:
+		return len(d) == 1 && d[0].Name == "$meta" && d[0].Value == "textScore"
+	}
+
+	return false
+}
+
 // Strings return a json representation of the criteria. Sorry but this is not
 // fully compatible with the MongoDb CLI.
 func (q *BaseQuery) String() string {
@@ -104,3 +277,118 @@ func (q *BaseQuery) String() string {
 
 	return string(j)
 }
+
+func (q *BaseQuery) base() *BaseQuery {
+	return q
+}
+
+// GetClauses returns a copy of the clauses added with AddCriteria, the
+// clauses of the default scopes are not included.
+func (q *BaseQuery) GetClauses() []bson.M {
+	return append([]bson.M{}, q.clauses...)
+}
+
+// ResetSort removes the sort of the query.
+func (q *BaseQuery) ResetSort() {
+	q.sort = nil
+}
+
+// ResetPagination removes the skip and the limit of the query.
+func (q *BaseQuery) ResetPagination() {
+	q.skip, q.limit = 0, 0
+}
+
+// Clone returns a copy of the query, the changes made to the copy don't
+// affect to the original query. The documents of the clauses are shared.
+func (q *BaseQuery) Clone() *BaseQuery {
+	c := *q
+	c.clauses = append([]bson.M{}, q.clauses...)
+	c.sort = append(Sort(nil), q.sort...)
+	c.selector = append(Select(nil), q.selector...)
+	c.projections = append([]bson.M(nil), q.projections...)
+	c.options.Hint = append([]string(nil), q.options.Hint...)
+	if q.collation != nil {
+		collation := *q.collation
+		c.collation = &collation
+	}
+
+	return &c
+}
+
+// Merge adds to the query the clauses, selected fields and projections of the
+// other query, and overrides the sort, skip, limit, collation and options if
+// they are set on the other query. The default scopes of the other query are
+// ignored.
+func (q *BaseQuery) Merge(other Query) {
+	if o, ok := other.(interface {
+		base() *BaseQuery
+	}); ok {
+		b := o.base()
+		q.clauses = append(q.GetClauses(), b.clauses...)
+		q.projections = append(append([]bson.M(nil), q.projections...), b.projections...)
+		if !b.sort.IsEmpty() {
+			q.sort = append(Sort(nil), b.sort...)
+		}
+	} else {
+		if c := other.GetCriteria(); c != nil {
+			q.AddCriteria(c)
+		}
+
+		if s := other.GetSort(); !s.IsEmpty() {
+			q.sort = append(Sort(nil), s...)
+		}
+	}
+
+	q.selector = append(append(Select(nil), q.selector...), other.GetSelect()...)
+	if other.GetSkip() != 0 {
+		q.skip = other.GetSkip()
+	}
+
+	if other.GetLimit() != 0 {
+		q.limit = other.GetLimit()
+	}
+
+	if c := other.GetCollation(); c != nil {
+		q.collation = c
+	}
+
+	q.options.merge(other.GetOptions())
+}
+
+func (o *QueryOptions) merge(other QueryOptions) {
+	if len(other.Hint) != 0 {
+		o.Hint = other.Hint
+	}
+
+	if other.MaxTime != 0 {
+		o.MaxTime = other.MaxTime
+	}
+
+	if other.BatchSize != 0 {
+		o.BatchSize = other.BatchSize
+	}
+
+	if other.Comment != "" {
+		o.Comment = other.Comment
+	}
+
+	if other.NoCursorTimeout {
+		o.NoCursorTimeout = true
+	}
+
+	if other.ReadMode != nil {
+		o.ReadMode = other.ReadMode
+	}
+}
+
+// Hash returns a stable hash of the criteria, sort, projection, skip, limit
+// and collation of the query, the same query produces always the same hash,
+// no matter the order of the keys of its documents. Useful as a cache key.
+func (q *BaseQuery) Hash() (string, error) {
+	b, err := q.MarshalExtJSON(Canonical)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%x", sha1.Sum(b)), nil
+}
@@ -1,7 +1,10 @@
 package storable
 
 import (
+	"time"
+
 	. "gopkg.in/check.v1"
+	"gopkg.in/mgo.v2"
 	"gopkg.in/mgo.v2/bson"
 )
 
@@ -25,3 +28,150 @@ func (s *BaseSuite) TestBaseQuery_String(c *C) {
 
 	c.Assert(q.String(), Equals, `{"$and":[{"foo":"foo"},{"qux":"qux"}]}`)
 }
+
+func (s *BaseSuite) TestBaseQuery_GetProjection(c *C) {
+	q := NewBaseQuery()
+	c.Assert(q.GetProjection(), IsNil)
+
+	items := NewField("items", "struct")
+	q.Select(Select{{NewField("foo", ""), Include}, {items.Positional(), Include}})
+	q.Project(bson.M{"qux": bson.M{"$slice": 5}})
+
+	c.Assert(q.GetProjection(), DeepEquals, bson.M{
+		"foo":     1,
+		"items.$": 1,
+		"qux":     bson.M{"$slice": 5},
+	})
+}
+
+func (s *BaseSuite) TestBaseQuery_GetProjectionTextScore(c *C) {
+	q := NewBaseQuery()
+	q.Sort(Sort{{NewField("score", ""), TextScore}})
+	c.Assert(q.GetProjection(), DeepEquals, bson.M{
+		"score": bson.M{"$meta": "textScore"},
+	})
+
+	q.Select(Select{{NewField("foo", ""), Include}})
+	c.Assert(q.GetProjection(), DeepEquals, bson.M{
+		"foo":   1,
+		"score": bson.M{"$meta": "textScore"},
+	})
+}
+
+func (s *BaseSuite) TestBaseQuery_Collation(c *C) {
+	q := NewBaseQuery()
+	c.Assert(q.GetCollation(), IsNil)
+
+	collation := &mgo.Collation{Locale: "en", Strength: 2}
+	q.Collation(collation)
+	c.Assert(q.GetCollation(), Equals, collation)
+}
+
+func (s *BaseSuite) TestBaseQuery_Options(c *C) {
+	q := NewBaseQuery()
+	c.Assert(q.GetOptions(), DeepEquals, QueryOptions{})
+
+	q.Hint("-foo")
+	q.MaxTime(time.Second)
+	q.BatchSize(10)
+	q.Comment("foo")
+	q.NoCursorTimeout()
+	q.ReadPreference(mgo.SecondaryPreferred)
+
+	mode := mgo.SecondaryPreferred
+	c.Assert(q.GetOptions(), DeepEquals, QueryOptions{
+		Hint:            []string{"-foo"},
+		MaxTime:         time.Second,
+		BatchSize:       10,
+		Comment:         "foo",
+		NoCursorTimeout: true,
+		ReadMode:        &mode,
+	})
+}
+
+func (s *BaseSuite) TestBaseQuery_DefaultScope(c *C) {
+	defaults := NewBaseQuery()
+	defaults.AddCriteria(bson.M{"deleted": false})
+	defaults.Sort(Sort{{NewField("name", ""), Asc}})
+
+	q := NewBaseQuery()
+	q.SetDefaultScope(defaults)
+	q.AddCriteria(bson.M{"foo": "foo"})
+
+	c.Assert(q.GetCriteria(), DeepEquals, bson.M{"$and": []bson.M{
+		{"deleted": false},
+		{"foo": "foo"},
+	}})
+	c.Assert(q.GetSort().ToList(), DeepEquals, []string{"name"})
+
+	q.Sort(Sort{{NewField("foo", ""), Desc}})
+	c.Assert(q.GetSort().ToList(), DeepEquals, []string{"-foo"})
+
+	q.Unscoped()
+	c.Assert(q.GetCriteria(), DeepEquals, bson.M{"$and": []bson.M{{"foo": "foo"}}})
+}
+
+func (s *BaseSuite) TestBaseQuery_Clone(c *C) {
+	q := NewBaseQuery()
+	q.AddCriteria(bson.M{"foo": "foo"})
+	q.Sort(Sort{{NewField("foo", ""), Asc}})
+	q.Limit(10)
+
+	clone := q.Clone()
+	clone.AddCriteria(bson.M{"qux": "qux"})
+	clone.ResetSort()
+	clone.ResetPagination()
+
+	c.Assert(q.GetClauses(), DeepEquals, []bson.M{{"foo": "foo"}})
+	c.Assert(q.GetSort(), HasLen, 1)
+	c.Assert(q.GetLimit(), Equals, 10)
+
+	c.Assert(clone.GetClauses(), DeepEquals, []bson.M{{"foo": "foo"}, {"qux": "qux"}})
+	c.Assert(clone.GetSort().IsEmpty(), Equals, true)
+	c.Assert(clone.GetLimit(), Equals, 0)
+}
+
+func (s *BaseSuite) TestBaseQuery_Merge(c *C) {
+	q := NewBaseQuery()
+	q.AddCriteria(bson.M{"foo": "foo"})
+	q.Sort(Sort{{NewField("foo", ""), Asc}})
+	q.Limit(10)
+
+	other := NewBaseQuery()
+	other.AddCriteria(bson.M{"qux": "qux"})
+	other.Select(Select{{NewField("foo", ""), Include}})
+	other.Skip(5)
+	other.Comment("foo")
+
+	q.Merge(other)
+	c.Assert(q.GetClauses(), DeepEquals, []bson.M{{"foo": "foo"}, {"qux": "qux"}})
+	c.Assert(q.GetSort().ToList(), DeepEquals, []string{"foo"})
+	c.Assert(q.GetSelect().ToMap(), DeepEquals, bson.M{"foo": 1})
+	c.Assert(q.GetSkip(), Equals, 5)
+	c.Assert(q.GetLimit(), Equals, 10)
+	c.Assert(q.GetOptions().Comment, Equals, "foo")
+	c.Assert(other.GetClauses(), HasLen, 1)
+}
+
+func (s *BaseSuite) TestBaseQuery_Hash(c *C) {
+	q := NewBaseQuery()
+	q.AddCriteria(bson.M{"foo": "foo", "qux": 42})
+	q.Limit(10)
+
+	hash, err := q.Hash()
+	c.Assert(err, IsNil)
+	c.Assert(hash, HasLen, 40)
+
+	same := NewBaseQuery()
+	same.AddCriteria(bson.M{"qux": 42, "foo": "foo"})
+	same.Limit(10)
+
+	sameHash, err := same.Hash()
+	c.Assert(err, IsNil)
+	c.Assert(sameHash, Equals, hash)
+
+	same.ResetPagination()
+	otherHash, err := same.Hash()
+	c.Assert(err, IsNil)
+	c.Assert(otherHash, Not(Equals), hash)
+}
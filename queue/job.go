@@ -0,0 +1,66 @@
+package queue
+
+import (
+	"time"
+
+	"gopkg.in/mgo.v2/bson"
+	"gopkg.in/src-d/storable.v1"
+)
+
+//go:generate storable gen
+
+// Status is the status of a Job.
+type Status int
+
+const (
+	// Pending jobs are waiting to be claimed, once their RunAt is reached.
+	Pending Status = iota
+	// Claimed jobs are being processed by a worker, they are claimed again if
+	// they are not acked before their RunAt.
+	Claimed
+	// Dead jobs failed too many times, they are not claimed anymore.
+	Dead
+)
+
+// Job is a document of the queue collection.
+type Job struct {
+	storable.Document `bson:",inline" collection:"jobs"`
+
+	Queue  string
+	Status Status
+	// Payload is the document given to Enqueue, see Decode.
+	Payload bson.Raw
+	// RunAt is the time when the job can be claimed.
+	RunAt time.Time
+	// Attempts is the number of times the job was claimed.
+	Attempts int
+	// Worker is the last worker claiming the job.
+	Worker string
+	// Claim identifies the current claim of the job, a job claimed again
+	// after its visibility timeout cannot be acked by the previous worker.
+	Claim bson.ObjectId `bson:",omitempty"`
+	// LastError is the error given to the last Nack.
+	LastError string
+	CreatedAt time.Time
+}
+
+func newJob(queue string, payload interface{}, runAt time.Time) (*Job, error) {
+	data, err := bson.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Job{
+		Queue:     queue,
+		Status:    Pending,
+		Payload:   bson.Raw{Kind: 0x03, Data: data},
+		RunAt:     runAt,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// Decode unmarshals the payload of the job into v, usually a pointer to a
+// generated model.
+func (j *Job) Decode(v interface{}) error {
+	return j.Payload.Unmarshal(v)
+}
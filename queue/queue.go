@@ -0,0 +1,263 @@
+// Package queue implements a job queue stored in a collection.
+//
+// The payloads are stored as embedded documents, Job.Payload is a bson.Raw
+// decoded with Job.Decode into the generated model of the payload, instead
+// of a typed field: the generator does not support parametrized models.
+package queue
+
+import (
+	"errors"
+	"time"
+
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+	"gopkg.in/src-d/storable.v1"
+	"gopkg.in/src-d/storable.v1/operators"
+)
+
+var (
+	// ErrEmpty is returned by Claim when no job is available
+	ErrEmpty = errors.New("no jobs available")
+	// ErrClaimLost is returned when a job is acked or nacked after its
+	// visibility timeout, once it was claimed again or deleted
+	ErrClaimLost = errors.New("the claim of the job was lost")
+	// ErrClaimExpired is the LastError of the jobs moved to the dead letters
+	// after the visibility timeout of its last attempt, as happens when the
+	// job crashes the worker
+	ErrClaimExpired = errors.New("the claim of the job expired on the last attempt")
+)
+
+const (
+	// DefaultVisibilityTimeout is the default value of Options.VisibilityTimeout
+	DefaultVisibilityTimeout = 30 * time.Second
+	// DefaultMaxAttempts is the default value of Options.MaxAttempts
+	DefaultMaxAttempts = 5
+)
+
+// DefaultBackoff is the default value of Options.Backoff
+var DefaultBackoff = ExponentialBackoff(time.Second, time.Hour)
+
+// Backoff returns the delay before retrying a job failed the given number of
+// attempts.
+type Backoff func(attempts int) time.Duration
+
+// ExponentialBackoff returns a Backoff doubling the delay on every attempt,
+// starting at min and up to max.
+func ExponentialBackoff(min, max time.Duration) Backoff {
+	return func(attempts int) time.Duration {
+		d := min
+		for i := 1; i < attempts && d < max; i++ {
+			d *= 2
+		}
+
+		if d > max {
+			return max
+		}
+
+		return d
+	}
+}
+
+// Options are the options of a Queue, the zero values are replaced by the
+// defaults.
+type Options struct {
+	// VisibilityTimeout is the time a claimed job is hidden to other workers,
+	// the job is claimed again if it is not acked or nacked before.
+	VisibilityTimeout time.Duration
+	// MaxAttempts is the number of claims before a job is moved to the dead
+	// letters, once it is nacked or its last claim expires.
+	MaxAttempts int
+	// Backoff is the delay before a nacked job can be claimed again.
+	Backoff Backoff
+}
+
+// Queue is a job queue stored in a collection, many queues can share the
+// same collection. The jobs are claimed atomically using find-and-modify, so
+// any number of workers can consume the same queue.
+type Queue struct {
+	store *JobStore
+	name  string
+	opts  Options
+}
+
+// New returns a Queue with the given name stored on the given collection.
+func New(db *mgo.Database, collection, name string, opts Options) *Queue {
+	if opts.VisibilityTimeout == 0 {
+		opts.VisibilityTimeout = DefaultVisibilityTimeout
+	}
+
+	if opts.MaxAttempts == 0 {
+		opts.MaxAttempts = DefaultMaxAttempts
+	}
+
+	if opts.Backoff == nil {
+		opts.Backoff = DefaultBackoff
+	}
+
+	return &Queue{
+		store: &JobStore{*storable.NewStore(db, collection)},
+		name:  name,
+		opts:  opts,
+	}
+}
+
+// EnsureIndexes creates the index used to claim the jobs.
+func (q *Queue) EnsureIndexes() error {
+	return q.store.EnsureIndex(mgo.Index{Key: []string{
+		storable.AscIndex.Key(Schema.Job.Queue),
+		storable.AscIndex.Key(Schema.Job.Status),
+		storable.AscIndex.Key(Schema.Job.RunAt),
+	}})
+}
+
+// Enqueue adds a job with the given payload, available to be claimed now.
+func (q *Queue) Enqueue(payload interface{}) (*Job, error) {
+	return q.Schedule(payload, time.Now())
+}
+
+// Schedule adds a job with the given payload, available to be claimed from
+// the given time.
+func (q *Queue) Schedule(payload interface{}, at time.Time) (*Job, error) {
+	job, err := newJob(q.name, payload, at)
+	if err != nil {
+		return nil, err
+	}
+
+	job.SetIsNew(true)
+	return job, q.store.Insert(job)
+}
+
+// Claim returns the next available job, the oldest by RunAt, hiding it to
+// other workers during the visibility timeout. ErrEmpty is returned if no
+// job is available. The expired claims of the jobs without attempts left are
+// moved to the dead letters.
+func (q *Queue) Claim(worker string) (*Job, error) {
+	now := time.Now()
+	if err := q.killExpired(now); err != nil {
+		return nil, err
+	}
+
+	query := q.store.Query()
+	query.AddCriteria(operators.Eq(Schema.Job.Queue, q.name))
+	query.AddCriteria(operators.In(Schema.Job.Status, Pending, Claimed))
+	query.AddCriteria(operators.Lte(Schema.Job.RunAt, now))
+	query.AddCriteria(operators.Lt(Schema.Job.Attempts, q.opts.MaxAttempts))
+	query.Sort(storable.Sort{{Schema.Job.RunAt, storable.Asc}})
+
+	job, err := q.store.FindAndModify(query, mgo.Change{
+		Update: bson.M{
+			"$set": bson.M{
+				Schema.Job.Status.String(): Claimed,
+				Schema.Job.RunAt.String():  now.Add(q.opts.VisibilityTimeout),
+				Schema.Job.Worker.String(): worker,
+				Schema.Job.Claim.String():  bson.NewObjectId(),
+			},
+			"$inc": bson.M{Schema.Job.Attempts.String(): 1},
+		},
+		ReturnNew: true,
+	})
+
+	if err == storable.ErrNotFound {
+		return nil, ErrEmpty
+	}
+
+	return job, err
+}
+
+// killExpired moves to the dead letters the jobs with an expired claim on
+// its last attempt.
+func (q *Queue) killExpired(now time.Time) error {
+	query := q.store.Query()
+	query.AddCriteria(operators.Eq(Schema.Job.Queue, q.name))
+	query.AddCriteria(operators.Eq(Schema.Job.Status, Claimed))
+	query.AddCriteria(operators.Lte(Schema.Job.RunAt, now))
+	query.AddCriteria(operators.Gte(Schema.Job.Attempts, q.opts.MaxAttempts))
+
+	return q.store.RawUpdate(query, bson.M{
+		Schema.Job.Status.String():    Dead,
+		Schema.Job.LastError.String(): ErrClaimExpired.Error(),
+	}, true)
+}
+
+// Ack removes the given job from the queue, once it was processed.
+func (q *Queue) Ack(job *Job) error {
+	return q.mapClaimErr(q.store.RawDelete(q.claimQuery(job), false))
+}
+
+// Nack releases the given job after a failure, it is claimed again after the
+// backoff delay, or moved to the dead letters once the max attempts are
+// reached.
+func (q *Queue) Nack(job *Job, cause error) error {
+	update := bson.M{
+		Schema.Job.Status.String():    Pending,
+		Schema.Job.RunAt.String():     time.Now().Add(q.opts.Backoff(job.Attempts)),
+		Schema.Job.LastError.String(): "",
+	}
+
+	if cause != nil {
+		update[Schema.Job.LastError.String()] = cause.Error()
+	}
+
+	if job.Attempts >= q.opts.MaxAttempts {
+		update[Schema.Job.Status.String()] = Dead
+	}
+
+	err := q.store.RawUpdate(q.claimQuery(job), update, false)
+	if err != nil {
+		return q.mapClaimErr(err)
+	}
+
+	job.Status = update[Schema.Job.Status.String()].(Status)
+	job.RunAt = update[Schema.Job.RunAt.String()].(time.Time)
+	job.LastError = update[Schema.Job.LastError.String()].(string)
+	return nil
+}
+
+// DeadLetters returns the jobs moved to the dead letters.
+func (q *Queue) DeadLetters() (*JobResultSet, error) {
+	query := q.store.Query()
+	query.AddCriteria(operators.Eq(Schema.Job.Queue, q.name))
+	query.AddCriteria(operators.Eq(Schema.Job.Status, Dead))
+
+	return q.store.Find(query)
+}
+
+// Retry moves the given dead job back to the queue, resetting its attempts.
+// mgo.ErrNotFound is returned if the job is not dead.
+func (q *Queue) Retry(job *Job) error {
+	query := q.store.Query()
+	query.FindById(job.Id)
+	query.AddCriteria(operators.Eq(Schema.Job.Status, Dead))
+
+	return q.store.RawUpdate(query, bson.M{
+		Schema.Job.Status.String():   Pending,
+		Schema.Job.RunAt.String():    time.Now(),
+		Schema.Job.Attempts.String(): 0,
+	}, false)
+}
+
+// Len returns the number of jobs on the queue, including the claimed and the
+// dead ones.
+func (q *Queue) Len() (int, error) {
+	query := q.store.Query()
+	query.AddCriteria(operators.Eq(Schema.Job.Queue, q.name))
+
+	return q.store.Count(query)
+}
+
+// claimQuery matches the given job only if it was not claimed again.
+func (q *Queue) claimQuery(job *Job) *JobQuery {
+	query := q.store.Query()
+	query.FindById(job.Id)
+	query.AddCriteria(operators.Eq(Schema.Job.Claim, job.Claim))
+
+	return query
+}
+
+func (q *Queue) mapClaimErr(err error) error {
+	if err == mgo.ErrNotFound {
+		return ErrClaimLost
+	}
+
+	return err
+}
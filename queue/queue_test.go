@@ -0,0 +1,224 @@
+package queue
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "gopkg.in/check.v1"
+	"gopkg.in/mgo.v2"
+)
+
+const (
+	testMongoHost = "127.0.0.1:27017"
+	testDatabase  = "storable-queue-test"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type QueueSuite struct {
+	db *mgo.Database
+}
+
+var _ = Suite(&QueueSuite{})
+
+func (s *QueueSuite) SetUpTest(c *C) {
+	conn, _ := mgo.Dial(testMongoHost)
+	s.db = conn.DB(testDatabase)
+}
+
+func (s *QueueSuite) TearDownTest(c *C) {
+	s.db.DropDatabase()
+}
+
+type payload struct {
+	Name string
+}
+
+func (s *QueueSuite) TestExponentialBackoff(c *C) {
+	b := ExponentialBackoff(time.Second, 10*time.Second)
+	c.Assert(b(0), Equals, time.Second)
+	c.Assert(b(1), Equals, time.Second)
+	c.Assert(b(2), Equals, 2*time.Second)
+	c.Assert(b(4), Equals, 8*time.Second)
+	c.Assert(b(5), Equals, 10*time.Second)
+	c.Assert(b(100), Equals, 10*time.Second)
+}
+
+func (s *QueueSuite) TestEnqueueClaimAck(c *C) {
+	q := New(s.db, "jobs", "foo", Options{})
+	c.Assert(q.EnsureIndexes(), IsNil)
+
+	_, err := q.Enqueue(&payload{"foo"})
+	c.Assert(err, IsNil)
+
+	job, err := q.Claim("worker")
+	c.Assert(err, IsNil)
+	c.Assert(job.Status, Equals, Claimed)
+	c.Assert(job.Attempts, Equals, 1)
+	c.Assert(job.Worker, Equals, "worker")
+
+	var p payload
+	c.Assert(job.Decode(&p), IsNil)
+	c.Assert(p.Name, Equals, "foo")
+
+	_, err = q.Claim("worker")
+	c.Assert(err, Equals, ErrEmpty)
+
+	c.Assert(q.Ack(job), IsNil)
+	c.Assert(q.Ack(job), Equals, ErrClaimLost)
+
+	n, err := q.Len()
+	c.Assert(err, IsNil)
+	c.Assert(n, Equals, 0)
+}
+
+func (s *QueueSuite) TestClaimOtherQueue(c *C) {
+	_, err := New(s.db, "jobs", "foo", Options{}).Enqueue(&payload{"foo"})
+	c.Assert(err, IsNil)
+
+	_, err = New(s.db, "jobs", "qux", Options{}).Claim("worker")
+	c.Assert(err, Equals, ErrEmpty)
+}
+
+func (s *QueueSuite) TestSchedule(c *C) {
+	q := New(s.db, "jobs", "foo", Options{})
+	_, err := q.Schedule(&payload{"foo"}, time.Now().Add(time.Hour))
+	c.Assert(err, IsNil)
+
+	_, err = q.Claim("worker")
+	c.Assert(err, Equals, ErrEmpty)
+}
+
+func (s *QueueSuite) TestVisibilityTimeout(c *C) {
+	q := New(s.db, "jobs", "foo", Options{VisibilityTimeout: 10 * time.Millisecond})
+	_, err := q.Enqueue(&payload{"foo"})
+	c.Assert(err, IsNil)
+
+	first, err := q.Claim("foo")
+	c.Assert(err, IsNil)
+
+	time.Sleep(20 * time.Millisecond)
+	second, err := q.Claim("qux")
+	c.Assert(err, IsNil)
+	c.Assert(second.Id, Equals, first.Id)
+	c.Assert(second.Attempts, Equals, 2)
+
+	c.Assert(q.Ack(first), Equals, ErrClaimLost)
+	c.Assert(q.Ack(second), IsNil)
+}
+
+func (s *QueueSuite) TestClaimExpiredDeadLetter(c *C) {
+	q := New(s.db, "jobs", "foo", Options{
+		VisibilityTimeout: 10 * time.Millisecond,
+		MaxAttempts:       1,
+	})
+
+	_, err := q.Enqueue(&payload{"foo"})
+	c.Assert(err, IsNil)
+
+	_, err = q.Claim("worker")
+	c.Assert(err, IsNil)
+
+	time.Sleep(20 * time.Millisecond)
+	_, err = q.Claim("worker")
+	c.Assert(err, Equals, ErrEmpty)
+
+	dead, err := q.DeadLetters()
+	c.Assert(err, IsNil)
+
+	jobs, err := dead.All()
+	c.Assert(err, IsNil)
+	c.Assert(jobs, HasLen, 1)
+	c.Assert(jobs[0].LastError, Equals, ErrClaimExpired.Error())
+}
+
+func (s *QueueSuite) TestNackDeadLetter(c *C) {
+	q := New(s.db, "jobs", "foo", Options{
+		MaxAttempts: 2,
+		Backoff:     func(int) time.Duration { return 0 },
+	})
+
+	_, err := q.Enqueue(&payload{"foo"})
+	c.Assert(err, IsNil)
+
+	for i := 0; i < 2; i++ {
+		job, err := q.Claim("worker")
+		c.Assert(err, IsNil)
+		c.Assert(q.Nack(job, errors.New("foo")), IsNil)
+		c.Assert(job.LastError, Equals, "foo")
+	}
+
+	_, err = q.Claim("worker")
+	c.Assert(err, Equals, ErrEmpty)
+
+	dead, err := q.DeadLetters()
+	c.Assert(err, IsNil)
+
+	jobs, err := dead.All()
+	c.Assert(err, IsNil)
+	c.Assert(jobs, HasLen, 1)
+	c.Assert(jobs[0].Status, Equals, Dead)
+	c.Assert(jobs[0].Attempts, Equals, 2)
+
+	c.Assert(q.Retry(jobs[0]), IsNil)
+	job, err := q.Claim("worker")
+	c.Assert(err, IsNil)
+	c.Assert(job.Attempts, Equals, 1)
+}
+
+func (s *QueueSuite) TestDeadLetters(c *C) {
+	foo := New(s.db, "jobs", "foo", Options{MaxAttempts: 1})
+	qux := New(s.db, "jobs", "qux", Options{MaxAttempts: 1})
+	for _, q := range []*Queue{foo, qux} {
+		_, err := q.Enqueue(&payload{"foo"})
+		c.Assert(err, IsNil)
+		_, err = q.Enqueue(&payload{"qux"})
+		c.Assert(err, IsNil)
+
+		job, err := q.Claim("worker")
+		c.Assert(err, IsNil)
+		c.Assert(q.Nack(job, errors.New("foo")), IsNil)
+		c.Assert(job.Status, Equals, Dead)
+	}
+
+	dead, err := foo.DeadLetters()
+	c.Assert(err, IsNil)
+
+	jobs, err := dead.All()
+	c.Assert(err, IsNil)
+	c.Assert(jobs, HasLen, 1)
+	c.Assert(jobs[0].Queue, Equals, "foo")
+	c.Assert(jobs[0].LastError, Equals, "foo")
+
+	n, err := foo.Len()
+	c.Assert(err, IsNil)
+	c.Assert(n, Equals, 2)
+}
+
+func (s *QueueSuite) TestRetry(c *C) {
+	q := New(s.db, "jobs", "foo", Options{MaxAttempts: 1})
+	_, err := q.Schedule(&payload{"foo"}, time.Now().Add(-time.Hour))
+	c.Assert(err, IsNil)
+
+	job, err := q.Claim("worker")
+	c.Assert(err, IsNil)
+	c.Assert(q.Retry(job), Equals, mgo.ErrNotFound)
+
+	c.Assert(q.Nack(job, errors.New("foo")), IsNil)
+	c.Assert(q.Retry(job), IsNil)
+	c.Assert(q.Retry(job), Equals, mgo.ErrNotFound)
+
+	dead, err := q.DeadLetters()
+	c.Assert(err, IsNil)
+
+	jobs, err := dead.All()
+	c.Assert(err, IsNil)
+	c.Assert(jobs, HasLen, 0)
+
+	retried, err := q.Claim("worker")
+	c.Assert(err, IsNil)
+	c.Assert(retried.Id, Equals, job.Id)
+	c.Assert(retried.Attempts, Equals, 1)
+	c.Assert(retried.LastError, Equals, "foo")
+}
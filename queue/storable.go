@@ -0,0 +1,356 @@
+package queue
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+	"gopkg.in/src-d/storable.v1"
+	"gopkg.in/src-d/storable.v1/operators"
+)
+
+type JobStore struct {
+	storable.Store
+}
+
+func NewJobStore(db *mgo.Database) *JobStore {
+	return &JobStore{*storable.NewStore(db, "jobs")}
+}
+
+// WithContext returns a copy of the store using the given context, see
+// storable.Store.WithContext.
+func (s *JobStore) WithContext(ctx context.Context) *JobStore {
+	return &JobStore{*s.Store.WithContext(ctx)}
+}
+
+// InTx returns a copy of the store running its operations, including the
+// hooks, inside the given transaction. See storable.WithTransaction.
+func (s *JobStore) InTx(tx *storable.Tx) *JobStore {
+	return &JobStore{*s.Store.InTx(tx)}
+}
+
+// New returns a new instance of Job.
+func (s *JobStore) New(queue string, payload interface{}, runAt time.Time) (doc *Job, err error) {
+	doc, err = newJob(queue, payload, runAt)
+	if doc != nil {
+		doc.SetIsNew(true)
+		doc.SetId(bson.NewObjectId())
+	}
+	return
+}
+
+// Query return a new instance of JobQuery.
+func (s *JobStore) Query() *JobQuery {
+	return &JobQuery{*storable.NewBaseQuery()}
+}
+
+// Find performs a find on the collection using the given query.
+func (s *JobStore) Find(query *JobQuery) (*JobResultSet, error) {
+	resultSet, err := s.Store.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &JobResultSet{ResultSet: *resultSet}, nil
+}
+
+// MustFind like Find but panics on error
+func (s *JobStore) MustFind(query *JobQuery) *JobResultSet {
+	resultSet := s.Store.MustFind(query)
+	return &JobResultSet{ResultSet: *resultSet}
+}
+
+// Tail performs a find on the capped collection using the given query,
+// waiting for new documents up to the given timeout. See storable.Store.Tail.
+func (s *JobStore) Tail(query *JobQuery, timeout time.Duration) (*JobResultSet, error) {
+	resultSet, err := s.Store.Tail(query, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &JobResultSet{ResultSet: *resultSet}, nil
+}
+
+// FindOne performs a find on the collection using the given query returning
+// the first document from the resultset.
+func (s *JobStore) FindOne(query *JobQuery) (*Job, error) {
+	resultSet, err := s.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultSet.One()
+}
+
+// MustFindOne like FindOne but panics on error
+func (s *JobStore) MustFindOne(query *JobQuery) *Job {
+	doc, err := s.FindOne(query)
+	if err != nil {
+		panic(err)
+	}
+
+	return doc
+}
+
+// FindAndModify atomically modifies the first document matching the given
+// query and returns it, before the change or after it if change.ReturnNew is
+// set. See storable.Store.FindAndModify.
+func (s *JobStore) FindAndModify(query *JobQuery, change mgo.Change) (*Job, error) {
+	var result *Job
+	_, err := s.Store.FindAndModify(query, change, &result)
+
+	return result, err
+}
+
+// Insert insert the given document on the collection, trigger BeforeInsert and
+// AfterInsert if any. Throws ErrNonNewDocument if doc is a non-new document.
+func (s *JobStore) Insert(doc *Job) error {
+
+	err := s.Store.Insert(doc)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Update update the given document on the collection, trigger BeforeUpdate and
+// AfterUpdate if any. Throws ErrNewDocument if doc is a new document.
+func (s *JobStore) Update(doc *Job) error {
+
+	err := s.Store.Update(doc)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateDiff update the given document on the collection writing only the
+// changes from old, trigger BeforeUpdate and AfterUpdate if any. See
+// storable.Store.UpdateDiff.
+func (s *JobStore) UpdateDiff(old, doc *Job) (storable.Changes, error) {
+
+	changes, err := s.Store.UpdateDiff(old, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// Save insert or update the given document on the collection using Upsert,
+// trigger BeforeUpdate and AfterUpdate if the document is non-new and
+// BeforeInsert and AfterInset if is new.
+func (s *JobStore) Save(doc *Job) (updated bool, err error) {
+	updated, err = s.Store.Save(doc)
+	if err != nil {
+		return false, err
+	}
+
+	return
+}
+
+type JobQuery struct {
+	storable.BaseQuery
+}
+
+// FindById add a new criteria to the query searching by _id
+func (q *JobQuery) FindById(ids ...bson.ObjectId) *JobQuery {
+	var vs []interface{}
+	for _, id := range ids {
+		vs = append(vs, id)
+	}
+	q.AddCriteria(operators.In(storable.IdField, vs...))
+
+	return q
+}
+
+// Clone returns a copy of the query, see storable.BaseQuery.Clone.
+func (q *JobQuery) Clone() *JobQuery {
+	return &JobQuery{*q.BaseQuery.Clone()}
+}
+
+type JobResultSet struct {
+	storable.ResultSet
+	last    *Job
+	lastErr error
+}
+
+// All returns all documents on the resultset and close the resultset
+func (r *JobResultSet) All() ([]*Job, error) {
+	var result []*Job
+	err := r.ResultSet.All(&result)
+
+	return result, err
+}
+
+// One returns the first document on the resultset and close the resultset
+func (r *JobResultSet) One() (*Job, error) {
+	var result *Job
+	err := r.ResultSet.One(&result)
+
+	return result, err
+}
+
+// Next prepares the next result document for reading with the Get method.
+func (r *JobResultSet) Next() (returned bool) {
+	r.last = nil
+	returned, r.lastErr = r.ResultSet.Next(&r.last)
+
+	return
+}
+
+// Get returns the document retrieved with the Next method.
+func (r *JobResultSet) Get() (*Job, error) {
+	return r.last, r.lastErr
+}
+
+// ForEach iterates the resultset calling to the given function.
+func (r *JobResultSet) ForEach(f func(*Job) error) error {
+	for {
+		var result *Job
+		found, err := r.ResultSet.Next(&result)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			break
+		}
+
+		err = f(result)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Watch returns a stream with the changes on the documents matching the given
+// query, the current version of the document is retrieved on every update.
+// See storable.Store.Watch.
+func (s *JobStore) Watch(query *JobQuery, opts storable.WatchOptions) (*JobChangeStream, error) {
+	opts.FullDocument = true
+	cs, err := s.Store.Watch(query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &JobChangeStream{ChangeStream: cs}, nil
+}
+
+type JobChangeStream struct {
+	*storable.ChangeStream
+	event   storable.ChangeEvent
+	last    *Job
+	lastErr error
+}
+
+// Next waits for the next event, to be read with the Get method. Returns
+// false when the stream is closed or fails.
+func (cs *JobChangeStream) Next() (returned bool) {
+	cs.event, cs.last, cs.lastErr = storable.ChangeEvent{}, nil, nil
+	if !cs.ChangeStream.Next(&cs.event) {
+		cs.lastErr = cs.ChangeStream.Err()
+		return false
+	}
+
+	if cs.event.HasDocument() {
+		cs.lastErr = cs.event.Decode(&cs.last)
+	}
+
+	return true
+}
+
+// Get returns the event and the document retrieved with the Next method, the
+// document is nil on the deletes or if it does not exist anymore.
+func (cs *JobChangeStream) Get() (*storable.ChangeEvent, *Job, error) {
+	return &cs.event, cs.last, cs.lastErr
+}
+
+// ForEach waits for the events calling to the given function, until the
+// stream is closed or storable.ErrStop is returned.
+func (cs *JobChangeStream) ForEach(f func(*storable.ChangeEvent, *Job) error) error {
+	for cs.Next() {
+		event, doc, err := cs.Get()
+		if err != nil {
+			return err
+		}
+
+		err = f(event, doc)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return cs.lastErr
+}
+
+type schema struct {
+	Job *schemaJob
+}
+
+type schemaJob struct {
+	Queue     storable.Field
+	Status    storable.Field
+	Payload   *schemaJobPayload
+	RunAt     storable.Field
+	Attempts  storable.Field
+	Worker    storable.Field
+	Claim     storable.Field
+	LastError storable.Field
+	CreatedAt storable.Field
+}
+
+type schemaJobPayload struct {
+}
+
+var Schema = schema{
+	Job: &schemaJob{
+		Queue:     storable.NewField("queue", "string"),
+		Status:    storable.NewField("status", "int"),
+		Payload:   &schemaJobPayload{},
+		RunAt:     storable.NewField("runat", "time.Time"),
+		Attempts:  storable.NewField("attempts", "int"),
+		Worker:    storable.NewField("worker", "string"),
+		Claim:     storable.NewField("claim", "string"),
+		LastError: storable.NewField("lasterror", "string"),
+		CreatedAt: storable.NewField("createdat", "time.Time"),
+	},
+}
+
+func init() {
+	storable.Register(&storable.ModelInfo{
+		Name:       "Job",
+		Collection: "jobs",
+		Type:       reflect.TypeOf(Job{}),
+		Schema:     Schema.Job,
+		Fields: []*storable.FieldInfo{
+			{Name: "Id", Path: "_id", Type: "bson.ObjectId", Findable: true},
+			{Name: "Queue", Path: "queue", Type: "string", Findable: true},
+			{Name: "Status", Path: "status", Type: "Status", Findable: true},
+			{Name: "Payload", Path: "payload", Type: "bson.Raw", Findable: true, Fields: []*storable.FieldInfo{
+				{Name: "Kind", Path: "payload.kind", Type: "byte"},
+				{Name: "Data", Path: "payload.data", Type: "[]byte"},
+			}},
+			{Name: "RunAt", Path: "runat", Type: "time.Time", Findable: true},
+			{Name: "Attempts", Path: "attempts", Type: "int", Findable: true},
+			{Name: "Worker", Path: "worker", Type: "string", Findable: true},
+			{Name: "Claim", Path: "claim", Type: "bson.ObjectId", Findable: true},
+			{Name: "LastError", Path: "lasterror", Type: "string", Findable: true},
+			{Name: "CreatedAt", Path: "createdat", Type: "time.Time", Findable: true},
+		},
+	})
+}
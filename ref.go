@@ -0,0 +1,104 @@
+package storable
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/mgo.v2/bson"
+)
+
+// RefError is returned by the generated Populate methods when some of the
+// referenced documents do not exist, the found documents are returned anyway.
+type RefError struct {
+	// Field is the path of the field holding the references.
+	Field string
+	// Collection is the collection of the referenced documents.
+	Collection string
+	// Ids are the dangling references, in order of appearance.
+	Ids []bson.ObjectId
+}
+
+func (e *RefError) Error() string {
+	ids := make([]string, len(e.Ids))
+	for i, id := range e.Ids {
+		ids[i] = id.Hex()
+	}
+
+	return fmt.Sprintf(
+		"%d dangling reference(s) from %q to %q: %s",
+		len(e.Ids), e.Field, e.Collection, strings.Join(ids, ", "),
+	)
+}
+
+// Refs collects the ids referenced by a batch of documents, used by the
+// generated Populate methods to load them with a single query.
+type Refs struct {
+	field      string
+	collection string
+	ids        []bson.ObjectId
+	found      map[bson.ObjectId]bool
+}
+
+// NewRefs returns a new empty Refs of the given field, referencing documents
+// of the given collection.
+func NewRefs(field, collection string) *Refs {
+	return &Refs{
+		field:      field,
+		collection: collection,
+		found:      make(map[bson.ObjectId]bool),
+	}
+}
+
+// Add adds the given ids, the empty and the repeated ids are ignored.
+func (r *Refs) Add(ids ...bson.ObjectId) {
+	for _, id := range ids {
+		if _, ok := r.found[id]; ok || len(id) == 0 {
+			continue
+		}
+
+		r.ids = append(r.ids, id)
+		r.found[id] = false
+	}
+}
+
+// Len returns the number of ids.
+func (r *Refs) Len() int {
+	return len(r.ids)
+}
+
+// Ids returns the ids, in order of appearance.
+func (r *Refs) Ids() []bson.ObjectId {
+	return r.ids
+}
+
+// Found marks the given id as found.
+func (r *Refs) Found(id bson.ObjectId) {
+	if _, ok := r.found[id]; ok {
+		r.found[id] = true
+	}
+}
+
+// Err returns a *RefError with the ids not found, nil if all were found.
+func (r *Refs) Err() error {
+	var dangling []bson.ObjectId
+	for _, id := range r.ids {
+		if !r.found[id] {
+			dangling = append(dangling, id)
+		}
+	}
+
+	if len(dangling) == 0 {
+		return nil
+	}
+
+	return &RefError{Field: r.field, Collection: r.collection, Ids: dangling}
+}
+
+// Related returns a copy of the store on the given collection, keeping its
+// transaction and context, used to load the referenced documents.
+func (s *Store) Related(collection string) *Store {
+	c := s.raw()
+	c.collection = collection
+
+	return c
+}
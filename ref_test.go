@@ -0,0 +1,48 @@
+package storable
+
+import (
+	. "gopkg.in/check.v1"
+	"gopkg.in/mgo.v2/bson"
+)
+
+func (s *BaseSuite) TestRefs(c *C) {
+	foo, bar, qux := bson.NewObjectId(), bson.NewObjectId(), bson.NewObjectId()
+
+	refs := NewRefs("customerid", "customers")
+	refs.Add(foo, "", bar)
+	refs.Add(foo, qux)
+	c.Assert(refs.Len(), Equals, 3)
+	c.Assert(refs.Ids(), DeepEquals, []bson.ObjectId{foo, bar, qux})
+
+	refs.Found(bar)
+	refs.Found(bson.NewObjectId())
+	c.Assert(refs.Err(), DeepEquals, &RefError{
+		Field:      "customerid",
+		Collection: "customers",
+		Ids:        []bson.ObjectId{foo, qux},
+	})
+
+	refs.Found(foo)
+	refs.Found(qux)
+	c.Assert(refs.Err(), IsNil)
+}
+
+func (s *BaseSuite) TestRefError_Error(c *C) {
+	id := bson.ObjectIdHex("5a0b5ab0f0c3f3a8c2b8f1e1")
+	err := &RefError{Field: "customerid", Collection: "customers", Ids: []bson.ObjectId{id}}
+	c.Assert(err.Error(), Equals,
+		`1 dangling reference(s) from "customerid" to "customers": 5a0b5ab0f0c3f3a8c2b8f1e1`)
+}
+
+func (s *BaseSuite) TestStore_Related(c *C) {
+	st := NewStore(s.db, "orders")
+	st.SetKind("foo")
+	st.EnableHistory()
+	tx := &Tx{}
+
+	r := st.InTx(tx).Related("customers")
+	c.Assert(r.collection, Equals, "customers")
+	c.Assert(r.kind, Equals, "")
+	c.Assert(r.history, IsNil)
+	c.Assert(r.Tx(), Equals, tx)
+}
@@ -0,0 +1,198 @@
+package storable
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// ModelInfo describes a model, the generated code registers every model on
+// the DefaultRegistry.
+type ModelInfo struct {
+	// Name of the model type.
+	Name string
+	// Collection is the name of the MongoDB collection.
+	Collection string
+	// Kind is the discriminator of the model, set by its kind tag or its name
+	// when its collection is shared with other models, see Store.SetKind.
+	Kind string
+	// Type is the Go type of the model, not a pointer to it.
+	Type reflect.Type
+	// Schema is the value of the model on the generated Schema variable.
+	Schema interface{}
+	// Fields of the model, including the _id.
+	Fields []*FieldInfo
+	// Events implemented by the store of the model, eg: BeforeInsert.
+	Events []string
+}
+
+// FieldInfo describes a field of a model.
+type FieldInfo struct {
+	// Name of the Go field.
+	Name string
+	// Path is the dotted path of the field on the document, the keys of the
+	// maps are represented as `[map]`, see Map.
+	Path string
+	// Type is the Go type of the field.
+	Type string
+	// Findable is true if the field can be used on the queries.
+	Findable bool
+	// Map is true if the field is a map or is contained by one.
+	Map bool
+	// Inline is true if the fields of the struct are inlined on its parent.
+	Inline bool
+	// Fields the fields of the struct, if any.
+	Fields []*FieldInfo
+}
+
+// Field returns the field with the given path, nil if not found.
+func (m *ModelInfo) Field(path string) *FieldInfo {
+	return findFieldInfo(m.Fields, path)
+}
+
+// AllFields returns all the fields of the model, including the fields of
+// structs, sorted depth-first.
+func (m *ModelInfo) AllFields() []*FieldInfo {
+	return flattenFieldInfo(nil, m.Fields)
+}
+
+// HasEvent returns if the store of the model implements the given event.
+func (m *ModelInfo) HasEvent(event string) bool {
+	for _, e := range m.Events {
+		if e == event {
+			return true
+		}
+	}
+
+	return false
+}
+
+func findFieldInfo(fields []*FieldInfo, path string) *FieldInfo {
+	for _, f := range fields {
+		if f.Path == path && !f.Inline {
+			return f
+		}
+
+		if f.Inline || strings.HasPrefix(path, f.Path+".") {
+			if found := findFieldInfo(f.Fields, path); found != nil {
+				return found
+			}
+		}
+	}
+
+	return nil
+}
+
+func flattenFieldInfo(dst, fields []*FieldInfo) []*FieldInfo {
+	for _, f := range fields {
+		dst = append(dst, f)
+		dst = flattenFieldInfo(dst, f.Fields)
+	}
+
+	return dst
+}
+
+// Registry holds the information of a set of models.
+type Registry struct {
+	mu     sync.RWMutex
+	models []*ModelInfo
+	byType map[reflect.Type]*ModelInfo
+}
+
+// DefaultRegistry is the registry used by the generated code.
+var DefaultRegistry = NewRegistry()
+
+// NewRegistry returns a new empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{byType: make(map[reflect.Type]*ModelInfo)}
+}
+
+// Register adds a model to the registry, panics if a model with the same type
+// is already registered.
+func (r *Registry) Register(m *ModelInfo) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.byType[m.Type]; ok {
+		panic(fmt.Sprintf("storable: model %s already registered", m.Type))
+	}
+
+	r.models = append(r.models, m)
+	r.byType[m.Type] = m
+}
+
+// Models returns all the registered models, in order of registration.
+func (r *Registry) Models() []*ModelInfo {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return append([]*ModelInfo(nil), r.models...)
+}
+
+// Model returns the model of the given document or type, nil if is not
+// registered.
+func (r *Registry) Model(v interface{}) *ModelInfo {
+	typ, ok := v.(reflect.Type)
+	if !ok {
+		typ = reflect.TypeOf(v)
+	}
+
+	for typ != nil && typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.byType[typ]
+}
+
+// Lookup returns the model with the given name, the name can be qualified
+// with the import path of its package to avoid ambiguities, eg:
+// `github.com/foo/bar.Product`. Returns nil if not found.
+func (r *Registry) Lookup(name string) *ModelInfo {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, m := range r.models {
+		if m.Name == name || m.Type.PkgPath()+"."+m.Name == name {
+			return m
+		}
+	}
+
+	return nil
+}
+
+// Collection returns the models stored on the given collection.
+func (r *Registry) Collection(collection string) []*ModelInfo {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var models []*ModelInfo
+	for _, m := range r.models {
+		if m.Collection == collection {
+			models = append(models, m)
+		}
+	}
+
+	return models
+}
+
+// Kinds returns the Kinds of the models with kind stored on the given
+// collection, including the ones of other packages.
+func (r *Registry) Kinds(collection string) Kinds {
+	kinds := make(Kinds)
+	for _, m := range r.Collection(collection) {
+		if m.Kind != "" {
+			kinds[m.Kind] = m.Type
+		}
+	}
+
+	return kinds
+}
+
+// Register adds a model to the DefaultRegistry.
+func Register(m *ModelInfo) {
+	DefaultRegistry.Register(m)
+}
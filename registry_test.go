@@ -0,0 +1,66 @@
+package storable
+
+import (
+	"reflect"
+
+	. "gopkg.in/check.v1"
+)
+
+var personInfo = &ModelInfo{
+	Name:       "Person",
+	Collection: "people",
+	Type:       reflect.TypeOf(Person{}),
+	Events:     []string{"BeforeInsert"},
+	Fields: []*FieldInfo{
+		{Name: "Id", Path: "_id", Type: "bson.ObjectId", Findable: true},
+		{Name: "FirstName", Path: "firstname", Type: "string", Findable: true},
+		{Name: "Address", Path: "address", Type: "Address", Findable: true, Fields: []*FieldInfo{
+			{Name: "Street", Path: "address.street", Type: "string", Findable: true},
+		}},
+		{Name: "Extra", Path: "", Type: "Extra", Findable: true, Inline: true, Fields: []*FieldInfo{
+			{Name: "Nick", Path: "nick", Type: "string", Findable: true},
+		}},
+	},
+}
+
+func (s *BaseSuite) TestModelInfo_Field(c *C) {
+	c.Assert(personInfo.Field("firstname").Name, Equals, "FirstName")
+	c.Assert(personInfo.Field("address.street").Name, Equals, "Street")
+	c.Assert(personInfo.Field("nick").Name, Equals, "Nick")
+	c.Assert(personInfo.Field("address.foo"), IsNil)
+	c.Assert(personInfo.Field(""), IsNil)
+}
+
+func (s *BaseSuite) TestModelInfo_AllFields(c *C) {
+	var names []string
+	for _, f := range personInfo.AllFields() {
+		names = append(names, f.Name)
+	}
+
+	c.Assert(names, DeepEquals, []string{
+		"Id", "FirstName", "Address", "Street", "Extra", "Nick",
+	})
+}
+
+func (s *BaseSuite) TestModelInfo_HasEvent(c *C) {
+	c.Assert(personInfo.HasEvent("BeforeInsert"), Equals, true)
+	c.Assert(personInfo.HasEvent("AfterInsert"), Equals, false)
+}
+
+func (s *BaseSuite) TestRegistry(c *C) {
+	r := NewRegistry()
+	r.Register(personInfo)
+
+	c.Assert(r.Models(), DeepEquals, []*ModelInfo{personInfo})
+	c.Assert(r.Model(&Person{}), Equals, personInfo)
+	c.Assert(r.Model(Person{}), Equals, personInfo)
+	c.Assert(r.Model(reflect.TypeOf(&Person{})), Equals, personInfo)
+	c.Assert(r.Model(&Document{}), IsNil)
+	c.Assert(r.Lookup("Person"), Equals, personInfo)
+	c.Assert(r.Lookup("gopkg.in/src-d/storable.v1.Person"), Equals, personInfo)
+	c.Assert(r.Lookup("Foo"), IsNil)
+	c.Assert(r.Collection("people"), DeepEquals, []*ModelInfo{personInfo})
+	c.Assert(r.Collection("foo"), HasLen, 0)
+
+	c.Assert(func() { r.Register(personInfo) }, PanicMatches, ".*already registered")
+}
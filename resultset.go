@@ -2,6 +2,7 @@ package storable
 
 import (
 	"errors"
+	"time"
 
 	"gopkg.in/mgo.v2"
 )
@@ -22,11 +23,20 @@ type ResultSet struct {
 	session  *mgo.Session
 	mgoQuery *mgo.Query
 	mgoIter  *mgo.Iter
+	// cmd is used instead of mgoQuery when the query has a collation
+	cmd *findCmd
+	// tail and timeout are used by the tailable result sets, see Store.Tail
+	tail    bool
+	timeout time.Duration
 }
 
 // Count returns the total number of documents in the ResultSet. Count DON'T
 // close the ResultSet after be called.
 func (r *ResultSet) Count() (int, error) {
+	if r.cmd != nil {
+		return r.cmd.count()
+	}
+
 	return r.mgoQuery.Count()
 }
 
@@ -34,6 +44,10 @@ func (r *ResultSet) Count() (int, error) {
 // with large results.
 func (r *ResultSet) All(result interface{}) error {
 	defer r.Close()
+	if r.cmd != nil {
+		return r.cmd.iter().All(result)
+	}
+
 	return r.mgoQuery.All(result)
 }
 
@@ -57,17 +71,36 @@ func (r *ResultSet) One(doc interface{}) error {
 // Next return a document from the ResultSet, can be called multiple times.
 func (r *ResultSet) Next(doc interface{}) (bool, error) {
 	if r.mgoIter == nil {
-		r.mgoIter = r.mgoQuery.Iter()
+		r.mgoIter = r.iter()
 	}
 
 	returned := r.mgoIter.Next(doc)
-	if !returned {
+	if !returned && !r.Timeout() {
 		r.Close()
 	}
 
 	return returned, r.mgoIter.Err()
 }
 
+// Timeout returns true if the last call to Next on a tailable ResultSet
+// returned no document because the timeout was reached, the ResultSet is
+// still open and Next can be called again.
+func (r *ResultSet) Timeout() bool {
+	return r.tail && r.mgoIter != nil && r.mgoIter.Timeout()
+}
+
+func (r *ResultSet) iter() *mgo.Iter {
+	if r.cmd != nil {
+		return r.cmd.iter()
+	}
+
+	if r.tail {
+		return r.mgoQuery.Tail(r.timeout)
+	}
+
+	return r.mgoQuery.Iter()
+}
+
 // Close close the ResultSet closing the internal iter.
 func (r *ResultSet) Close() error {
 	if r.IsClosed {
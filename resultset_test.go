@@ -82,3 +82,8 @@ func (s *BaseSuite) TestResultSet_Close(c *C) {
 	c.Assert(r.Close(), IsNil)
 	c.Assert(r.Close(), Equals, ErrResultSetClosed)
 }
+
+func (s *BaseSuite) TestResultSet_TimeoutNonTailable(c *C) {
+	r := &ResultSet{}
+	c.Assert(r.Timeout(), Equals, false)
+}
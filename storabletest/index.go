@@ -0,0 +1,81 @@
+// Package storabletest provides helpers to test the stores, as checking that
+// the queries are using the indexes:
+//
+//	func (s *MySuite) TestQueriesUseIndexes(c *C) {
+//		store := NewProductStore(s.db)
+//		c.Assert(store.Query().FindByName("foo"), storabletest.UsesIndex, store)
+//	}
+package storabletest
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/check.v1"
+	"gopkg.in/src-d/storable.v1"
+)
+
+// Explainer is implemented by storable.Store and the generated stores.
+type Explainer interface {
+	Explain(q storable.Query) (*storable.Plan, error)
+}
+
+// CheckUsesIndex returns an error if the query is not executed using an
+// index, to be used with the testing package:
+//
+//	if err := storabletest.CheckUsesIndex(store, q); err != nil {
+//		t.Error(err)
+//	}
+func CheckUsesIndex(s Explainer, q storable.Query) error {
+	p, err := s.Explain(q)
+	if err != nil {
+		return err
+	}
+
+	if !p.UsesIndex() {
+		return fmt.Errorf(
+			"query %s is not using an index, plan: %s",
+			queryString(q), strings.Join(p.Stages, " > "),
+		)
+	}
+
+	return nil
+}
+
+func queryString(q storable.Query) string {
+	if s, ok := q.(fmt.Stringer); ok {
+		return s.String()
+	}
+
+	return fmt.Sprint(q.GetCriteria())
+}
+
+type usesIndexChecker struct {
+	*check.CheckerInfo
+}
+
+// UsesIndex is a gopkg.in/check.v1 checker verifying that a query is executed
+// using an index of the given store:
+//
+//	c.Assert(query, storabletest.UsesIndex, store)
+var UsesIndex check.Checker = &usesIndexChecker{
+	&check.CheckerInfo{Name: "UsesIndex", Params: []string{"query", "store"}},
+}
+
+func (c *usesIndexChecker) Check(params []interface{}, names []string) (bool, string) {
+	q, ok := params[0].(storable.Query)
+	if !ok {
+		return false, "query should be a storable.Query"
+	}
+
+	s, ok := params[1].(Explainer)
+	if !ok {
+		return false, "store should implement Explain"
+	}
+
+	if err := CheckUsesIndex(s, q); err != nil {
+		return false, err.Error()
+	}
+
+	return true, ""
+}
@@ -0,0 +1,63 @@
+package storabletest
+
+import (
+	"errors"
+	"testing"
+
+	. "gopkg.in/check.v1"
+	"gopkg.in/mgo.v2/bson"
+	"gopkg.in/src-d/storable.v1"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type StorableTestSuite struct{}
+
+var _ = Suite(&StorableTestSuite{})
+
+type explainerExample struct {
+	plan *storable.Plan
+	err  error
+}
+
+func (e *explainerExample) Explain(storable.Query) (*storable.Plan, error) {
+	return e.plan, e.err
+}
+
+func newQuery() *storable.BaseQuery {
+	q := storable.NewBaseQuery()
+	q.AddCriteria(bson.M{"foo": "bar"})
+	return q
+}
+
+func (s *StorableTestSuite) TestUsesIndex(c *C) {
+	e := &explainerExample{plan: &storable.Plan{
+		Stage:     storable.IxScanStage,
+		Stages:    []string{"FETCH", storable.IxScanStage},
+		IndexName: "foo_1",
+	}}
+
+	c.Assert(newQuery(), UsesIndex, e)
+	c.Assert(CheckUsesIndex(e, newQuery()), IsNil)
+}
+
+func (s *StorableTestSuite) TestUsesIndexCollScan(c *C) {
+	e := &explainerExample{plan: &storable.Plan{
+		Stage:  storable.CollScanStage,
+		Stages: []string{storable.CollScanStage},
+	}}
+
+	c.Assert(newQuery(), Not(UsesIndex), e)
+	c.Assert(CheckUsesIndex(e, newQuery()), ErrorMatches,
+		`query {"\$and":\[{"foo":"bar"}\]} is not using an index, plan: COLLSCAN`,
+	)
+}
+
+func (s *StorableTestSuite) TestUsesIndexError(c *C) {
+	e := &explainerExample{err: errors.New("foo")}
+	c.Assert(CheckUsesIndex(e, newQuery()), ErrorMatches, "foo")
+
+	ok, msg := UsesIndex.Check([]interface{}{"foo", e}, nil)
+	c.Assert(ok, Equals, false)
+	c.Assert(msg, Equals, "query should be a storable.Query")
+}
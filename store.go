@@ -1,7 +1,9 @@
 package storable
 
 import (
+	"context"
 	"errors"
+	"time"
 
 	"gopkg.in/mgo.v2"
 	"gopkg.in/mgo.v2/bson"
@@ -16,11 +18,23 @@ var (
 	ErrEmptyQueryInRaw = errors.New("Empty queries are not allowed on raw ops.")
 	// ErrEmptyID a document without Id cannot be used with Save method
 	ErrEmptyID = errors.New("A document without id is not allowed.")
+	// ErrTailWithCollation a query with collation cannot be used with Tail method
+	ErrTailWithCollation = errors.New("Collations are not allowed on tailable cursors.")
+	// ErrTailInTx the Tail method cannot be used inside a transaction
+	ErrTailInTx = errors.New("Tailable cursors are not allowed inside a transaction.")
 )
 
 type Store struct {
-	db         *mgo.Database
-	collection string
+	db          *mgo.Database
+	collection  string
+	schema      interface{}
+	tx          *Tx
+	ctx         context.Context
+	history     *history
+	kind        string
+	defaultKind bool
+
+	oplogDatabase, oplogCollection string
 }
 
 // NewStore returns a new Store instance
@@ -31,6 +45,29 @@ func NewStore(db *mgo.Database, collection string) *Store {
 	}
 }
 
+// InTx returns a copy of the store running its operations inside the given
+// transaction, see WithTransaction. Inside a transaction the results of Find
+// are read entirely on the first call to Next or All, and Tail, Watch,
+// Explain and the index and collection operations are not supported.
+func (s *Store) InTx(tx *Tx) *Store {
+	c := *s
+	c.tx = tx
+
+	return &c
+}
+
+// Tx returns the transaction of the store, nil if none.
+func (s *Store) Tx() *Tx {
+	return s.tx
+}
+
+// SetStrict enables the strict mode, every query is validated against the
+// given schema before being executed, see Query.Validate. A nil schema
+// disables the strict mode.
+func (s *Store) SetStrict(schema interface{}) {
+	s.schema = schema
+}
+
 // Insert insert the given document in the collection, returns error if no-new
 // document is given. The document id is setted if is empty.
 func (s *Store) Insert(doc DocumentBase) error {
@@ -42,15 +79,30 @@ func (s *Store) Insert(doc DocumentBase) error {
 		doc.SetId(bson.NewObjectId())
 	}
 
+	written, err := s.withKind(doc)
+	if err != nil {
+		return err
+	}
+
 	sess, c := s.getSessionAndCollection()
 	defer sess.Close()
 
-	err := c.Insert(doc)
-	if err == nil {
-		doc.SetIsNew(false)
+	if s.tx != nil {
+		err = insertCmd(c, s.tx, written)
+	} else {
+		err = c.Insert(written)
+	}
+
+	if err != nil {
+		return err
 	}
 
-	return err
+	doc.SetIsNew(false)
+	if err := s.created(doc.GetId()); err != nil {
+		return err
+	}
+
+	return s.logWrite("i", doc.GetId(), written)
 }
 
 // Update update the given document in the collection, returns error if a new
@@ -60,10 +112,52 @@ func (s *Store) Update(doc DocumentBase) error {
 		return ErrNewDocument
 	}
 
-	sess, c := s.getSessionAndCollection()
-	defer sess.Close()
+	written, err := s.withKind(doc)
+	if err != nil {
+		return err
+	}
+
+	if s.history != nil {
+		_, err = s.archivedWrite(doc.GetId(), mgo.Change{Update: written}, written)
+	} else {
+		err = s.update(doc.GetId(), written)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return s.logWrite("u", doc.GetId(), written)
+}
+
+// UpdateDiff updates the given document in the collection writing only the
+// changes from old, a previous version of the document, see Diff. Nothing is
+// written if there are no changes. Returns error if a new document is given.
+func (s *Store) UpdateDiff(old, doc DocumentBase) (Changes, error) {
+	if doc.IsNew() {
+		return nil, ErrNewDocument
+	}
+
+	changes, err := Diff(old, doc)
+	if err != nil || len(changes) == 0 {
+		return changes, err
+	}
+
+	if s.history != nil {
+		_, err = s.archivedWrite(doc.GetId(), mgo.Change{Update: changes.Update()}, changes)
+	} else {
+		err = s.update(doc.GetId(), changes.Update())
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.logUpdate(doc.GetId(), changes.Update()); err != nil {
+		return nil, err
+	}
 
-	return c.UpdateId(doc.GetId(), doc)
+	return changes, nil
 }
 
 // Save insert or update the given document in the collection, a document with
@@ -74,29 +168,158 @@ func (s *Store) Save(doc DocumentBase) (updated bool, err error) {
 		return false, ErrEmptyID
 	}
 
-	sess, c := s.getSessionAndCollection()
-	defer sess.Close()
+	written, err := s.withKind(doc)
+	if err != nil {
+		return false, err
+	}
+
+	if s.history != nil {
+		var info *mgo.ChangeInfo
+		info, err = s.archivedWrite(id, mgo.Change{Update: written, Upsert: true}, written)
+		updated = err == nil && info.UpsertedId == nil
+	} else {
+		updated, err = s.upsert(id, written)
+	}
 
-	inf, err := c.UpsertId(id, doc)
 	if err != nil {
 		return false, err
 	}
 
+	if !updated {
+		if err := s.created(id); err != nil {
+			return false, err
+		}
+	}
+
 	doc.SetIsNew(false)
-	return inf.Updated > 0, nil
+	if updated {
+		err = s.logWrite("u", id, written)
+	} else {
+		err = s.logWrite("i", id, written)
+	}
+
+	return updated, err
 }
 
 // Delete remove the document from the collection
 func (s *Store) Delete(doc DocumentBase) error {
+	var err error
+	if s.history != nil {
+		_, err = s.archivedWrite(doc.GetId(), mgo.Change{Remove: true}, nil)
+	} else {
+		err = s.remove(doc.GetId())
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return s.logDelete(doc.GetId())
+}
+
+// update applies the given update to the document with the given id.
+func (s *Store) update(id bson.ObjectId, update interface{}) error {
 	sess, c := s.getSessionAndCollection()
 	defer sess.Close()
 
-	return c.RemoveId(doc.GetId())
+	q := s.idQuery(id)
+	if s.tx != nil {
+		return updateCmd(c, s.tx, q, update, false)
+	}
+
+	return c.Update(q.GetCriteria(), update)
+}
+
+// upsert replaces the document with the given id, inserting it if it does not
+// exist, returns true if the document existed.
+func (s *Store) upsert(id bson.ObjectId, doc interface{}) (bool, error) {
+	sess, c := s.getSessionAndCollection()
+	defer sess.Close()
+
+	q := s.idQuery(id)
+	if s.tx != nil {
+		return upsertCmd(c, s.tx, q, doc)
+	}
+
+	info, err := c.Upsert(q.GetCriteria(), doc)
+	return err == nil && info.Updated > 0, err
+}
+
+// remove removes the document with the given id.
+func (s *Store) remove(id bson.ObjectId) error {
+	sess, c := s.getSessionAndCollection()
+	defer sess.Close()
+
+	q := s.idQuery(id)
+	if s.tx != nil {
+		return deleteCmd(c, s.tx, q, false)
+	}
+
+	return c.Remove(q.GetCriteria())
 }
 
 // Find executes the given query in the collection
 func (s *Store) Find(q Query) (*ResultSet, error) {
+	if err := s.validate(q); err != nil {
+		return nil, err
+	}
+
+	q = s.scope(q)
+
 	sess, c := s.getSessionAndCollection()
+	opts := q.GetOptions()
+	if opts.ReadMode != nil {
+		sess.SetMode(*opts.ReadMode, true)
+	}
+
+	if opts.NoCursorTimeout {
+		sess.SetCursorTimeout(0)
+	}
+
+	if q.GetCollation() != nil || s.tx != nil {
+		return &ResultSet{session: sess, cmd: &findCmd{c, q, s.tx}}, nil
+	}
+
+	return &ResultSet{session: sess, mgoQuery: findQuery(c, q)}, nil
+}
+
+// Tail executes the given query in the collection returning a tailable
+// ResultSet, only capped collections can be tailed. Once the last document is
+// reached Next waits for new documents up to the given timeout, a negative
+// timeout waits forever. The ResultSet is not closed when the timeout is
+// reached, see ResultSet.Timeout:
+//
+//	rs, _ := store.Tail(q, 5*time.Second)
+//	for {
+//	    found, err := rs.Next(&doc)
+//	    if !found && !rs.Timeout() {
+//	        break
+//	    }
+//	}
+//
+// The cursor is closed by the server if the query matches no documents, as
+// happens on empty collections. The collations and the transactions are not
+// supported.
+func (s *Store) Tail(q Query, timeout time.Duration) (*ResultSet, error) {
+	if q.GetCollation() != nil {
+		return nil, ErrTailWithCollation
+	}
+
+	if s.tx != nil {
+		return nil, ErrTailInTx
+	}
+
+	rs, err := s.Find(q)
+	if err != nil {
+		return nil, err
+	}
+
+	rs.tail, rs.timeout = true, timeout
+	return rs, nil
+}
+
+func findQuery(c *mgo.Collection, q Query) *mgo.Query {
+	opts := q.GetOptions()
 	mq := c.Find(q.GetCriteria())
 
 	if !q.GetSort().IsEmpty() {
@@ -111,11 +334,27 @@ func (s *Store) Find(q Query) (*ResultSet, error) {
 		mq.Limit(q.GetLimit())
 	}
 
-	if !q.GetSelect().IsEmpty() {
-		mq.Select(q.GetSelect().ToMap())
+	if p := q.GetProjection(); p != nil {
+		mq.Select(p)
+	}
+
+	if len(opts.Hint) != 0 {
+		mq.Hint(opts.Hint...)
+	}
+
+	if opts.MaxTime != 0 {
+		mq.SetMaxTime(opts.MaxTime)
+	}
+
+	if opts.BatchSize != 0 {
+		mq.Batch(opts.BatchSize)
 	}
 
-	return &ResultSet{session: sess, mgoQuery: mq}, nil
+	if opts.Comment != "" {
+		mq.Comment(opts.Comment)
+	}
+
+	return mq
 }
 
 // MustFind like Find but panics on error
@@ -149,6 +388,25 @@ func (s *Store) MustCount(q Query) int {
 	return count
 }
 
+// Distinct unmarshals into result the distinct values of the given field on
+// the documents matching the query, result should be a pointer to a slice.
+func (s *Store) Distinct(q Query, field Field, result interface{}) error {
+	if err := s.validate(q); err != nil {
+		return err
+	}
+
+	q = s.scope(q)
+
+	sess, c := s.getSessionAndCollection()
+	defer sess.Close()
+
+	if q.GetCollation() != nil || s.tx != nil {
+		return distinctCmd(c, s.tx, q, field.String(), result)
+	}
+
+	return c.Find(q.GetCriteria()).Distinct(field.String(), result)
+}
+
 // RawUpdate performes a direct update in the collection, update is wrapped on
 // a $set operator. If a query without criteria is given EmptyQueryInRawErr is
 // returned
@@ -158,16 +416,37 @@ func (s *Store) RawUpdate(query Query, update interface{}, multi bool) error {
 		return ErrEmptyQueryInRaw
 	}
 
+	if err := s.validate(query); err != nil {
+		return err
+	}
+
+	query = s.scope(query)
+	criteria = query.GetCriteria()
+
+	ids, err := s.matchingIds(query, multi)
+	if err != nil {
+		return err
+	}
+
 	sess, c := s.getSessionAndCollection()
 	defer sess.Close()
 
-	var err error
-	if multi {
+	if query.GetCollation() != nil || s.tx != nil {
+		err = updateCmd(c, s.tx, query, bson.M{"$set": update}, multi)
+	} else if multi {
 		_, err = c.UpdateAll(criteria, bson.M{"$set": update})
 	} else {
 		err = c.Update(criteria, bson.M{"$set": update})
 	}
 
+	for _, id := range ids {
+		if err != nil {
+			break
+		}
+
+		err = s.logUpdate(id, bson.M{"$set": update})
+	}
+
 	return err
 }
 
@@ -179,21 +458,115 @@ func (s *Store) RawDelete(query Query, multi bool) error {
 		return ErrEmptyQueryInRaw
 	}
 
+	if err := s.validate(query); err != nil {
+		return err
+	}
+
+	query = s.scope(query)
+	criteria = query.GetCriteria()
+
+	ids, err := s.matchingIds(query, multi)
+	if err != nil {
+		return err
+	}
+
 	sess, c := s.getSessionAndCollection()
 	defer sess.Close()
 
-	var err error
-	if multi {
+	if query.GetCollation() != nil || s.tx != nil {
+		err = deleteCmd(c, s.tx, query, multi)
+	} else if multi {
 		_, err = c.RemoveAll(criteria)
 	} else {
 		err = c.Remove(criteria)
 	}
 
+	for _, id := range ids {
+		if err != nil {
+			break
+		}
+
+		err = s.logDelete(id)
+	}
+
 	return err
 }
 
+// FindAndModify atomically modifies the first document matching the query, in
+// the order of its sort, and unmarshals it into result: the document before
+// the change, or after it if change.ReturnNew is set. ErrNotFound is returned
+// if no document matches and the change is not an upsert.
+func (s *Store) FindAndModify(q Query, change mgo.Change, result interface{}) (*mgo.ChangeInfo, error) {
+	if err := s.validate(q); err != nil {
+		return nil, err
+	}
+
+	q = s.scope(q)
+
+	sess, c := s.getSessionAndCollection()
+	defer sess.Close()
+
+	// the document is needed to log the write
+	target := result
+	var doc bson.Raw
+	if s.oplogCollection != "" {
+		target = &doc
+	}
+
+	var info *mgo.ChangeInfo
+	var err error
+	if q.GetCollation() != nil || s.tx != nil {
+		info, err = findAndModifyCmd(c, s.tx, q, change, target)
+	} else {
+		info, err = findQuery(c, q).Apply(change, target)
+	}
+
+	if err == mgo.ErrNotFound {
+		return nil, ErrNotFound
+	}
+
+	if err != nil || target == result {
+		return info, err
+	}
+
+	if doc.Kind == 0x03 && result != nil {
+		if err := doc.Unmarshal(result); err != nil {
+			return nil, err
+		}
+	}
+
+	return info, s.logFindAndModify(change, info, doc)
+}
+
+func (s *Store) validate(q Query) error {
+	if s.schema == nil {
+		return nil
+	}
+
+	return q.Validate(s.schema)
+}
+
+// idQuery returns a query matching the document with the given id.
+func idQuery(id bson.ObjectId) Query {
+	q := NewBaseQuery()
+	q.AddCriteria(bson.M{"_id": id})
+
+	return q
+}
+
+// idQuery returns the query of the document with the given id, filtered by
+// the kind of the store, so a document of another kind is never written.
+func (s *Store) idQuery(id bson.ObjectId) Query {
+	return s.scope(idQuery(id))
+}
+
 func (s *Store) getSessionAndCollection() (*mgo.Session, *mgo.Collection) {
-	sess := s.db.Session.Copy()
+	var sess *mgo.Session
+	if s.tx != nil {
+		sess = s.tx.session.Copy()
+	} else {
+		sess = s.db.Session.Copy()
+	}
 
 	return sess, sess.DB(s.db.Name).C(s.collection)
 }
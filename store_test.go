@@ -1,7 +1,10 @@
 package storable
 
 import (
+	"time"
+
 	. "gopkg.in/check.v1"
+	"gopkg.in/mgo.v2"
 	"gopkg.in/mgo.v2/bson"
 )
 
@@ -281,3 +284,59 @@ func (s *BaseSuite) TestStore_RawDeleteEmpty(c *C) {
 	err := st.RawDelete(q, false)
 	c.Assert(err, Equals, ErrEmptyQueryInRaw)
 }
+
+func (s *BaseSuite) TestStore_FindOptions(c *C) {
+	st := NewStore(s.db, "test")
+	st.Insert(NewPerson("foo"))
+	st.Insert(NewPerson("bar"))
+
+	q := NewBaseQuery()
+	q.Hint(AscIndex.Key(IdField))
+	q.MaxTime(time.Minute)
+	q.BatchSize(1)
+	q.Comment("find options")
+	q.NoCursorTimeout()
+	q.ReadPreference(mgo.PrimaryPreferred)
+
+	var result []*Person
+	c.Assert(st.MustFind(q).All(&result), IsNil)
+	c.Assert(result, HasLen, 2)
+
+	q.Collation(&mgo.Collation{Locale: "en"})
+	c.Assert(st.MustFind(q).All(&result), IsNil)
+	c.Assert(result, HasLen, 2)
+}
+
+func (s *BaseSuite) TestStore_FindHintUnknownIndex(c *C) {
+	st := NewStore(s.db, "test")
+	st.Insert(NewPerson("foo"))
+
+	q := NewBaseQuery()
+	q.Hint("foo")
+
+	var result []*Person
+	c.Assert(st.MustFind(q).All(&result), NotNil)
+}
+
+func (s *BaseSuite) TestStore_FindAndModify(c *C) {
+	st := NewStore(s.db, "test")
+	st.Insert(NewPerson("foo"))
+	st.Insert(NewPerson("bar"))
+
+	q := NewBaseQuery()
+	q.Sort(Sort{{NewField("firstname", ""), Asc}})
+
+	var result *Person
+	info, err := st.FindAndModify(q, mgo.Change{
+		Update:    bson.M{"$set": bson.M{"firstname": "qux"}},
+		ReturnNew: true,
+	}, &result)
+	c.Assert(err, IsNil)
+	c.Assert(info.Updated, Equals, 1)
+	c.Assert(result.FirstName, Equals, "qux")
+
+	q = NewBaseQuery()
+	q.AddCriteria(bson.M{"firstname": "baz"})
+	_, err = st.FindAndModify(q, mgo.Change{Remove: true}, &result)
+	c.Assert(err, Equals, ErrNotFound)
+}
@@ -0,0 +1,8 @@
+package tests
+
+import "gopkg.in/src-d/storable.v1"
+
+type CappedFixture struct {
+	storable.Document `bson:",inline" collection:"capped"`
+	Message           string
+}
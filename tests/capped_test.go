@@ -0,0 +1,92 @@
+package tests
+
+import (
+	"errors"
+	"time"
+
+	. "gopkg.in/check.v1"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/src-d/storable.v1"
+)
+
+func (s *MongoSuite) insertCappedFixtures(c *C, store *CappedFixtureStore, msgs ...string) {
+	for _, msg := range msgs {
+		doc := store.New()
+		doc.Message = msg
+		c.Assert(store.Insert(doc), IsNil)
+	}
+}
+
+func (s *MongoSuite) TestCreateCapped(c *C) {
+	store := NewCappedFixtureStore(s.db)
+	c.Assert(store.CreateCapped(storable.CappedOptions{MaxBytes: 4096, MaxDocs: 2}), IsNil)
+
+	capped, err := store.IsCapped()
+	c.Assert(err, IsNil)
+	c.Assert(capped, Equals, true)
+
+	s.insertCappedFixtures(c, store, "foo", "bar", "qux")
+
+	docs, err := store.MustFind(store.Query()).All()
+	c.Assert(err, IsNil)
+	c.Assert(docs, HasLen, 2)
+	c.Assert(docs[0].Message, Equals, "bar")
+	c.Assert(docs[1].Message, Equals, "qux")
+}
+
+func (s *MongoSuite) TestConvertToCapped(c *C) {
+	store := NewCappedFixtureStore(s.db)
+	s.insertCappedFixtures(c, store, "foo")
+
+	capped, err := store.IsCapped()
+	c.Assert(err, IsNil)
+	c.Assert(capped, Equals, false)
+
+	c.Assert(store.ConvertToCapped(storable.CappedOptions{MaxBytes: 4096}), IsNil)
+
+	capped, err = store.IsCapped()
+	c.Assert(err, IsNil)
+	c.Assert(capped, Equals, true)
+}
+
+func (s *MongoSuite) TestTail(c *C) {
+	store := NewCappedFixtureStore(s.db)
+	c.Assert(store.CreateCapped(storable.CappedOptions{MaxBytes: 4096}), IsNil)
+	s.insertCappedFixtures(c, store, "foo")
+
+	rs, err := store.Tail(store.Query(), 100*time.Millisecond)
+	c.Assert(err, IsNil)
+	defer rs.Close()
+
+	var msgs []string
+	c.Assert(rs.ForEach(func(doc *CappedFixture) error {
+		msgs = append(msgs, doc.Message)
+		return nil
+	}), IsNil)
+	c.Assert(msgs, DeepEquals, []string{"foo"})
+	c.Assert(rs.Timeout(), Equals, true)
+	c.Assert(rs.IsClosed, Equals, false)
+
+	s.insertCappedFixtures(c, store, "bar", "qux")
+
+	stop := errors.New("stop")
+	c.Assert(rs.ForEach(func(doc *CappedFixture) error {
+		msgs = append(msgs, doc.Message)
+		if len(msgs) == 3 {
+			return stop
+		}
+
+		return nil
+	}), Equals, stop)
+	c.Assert(msgs, DeepEquals, []string{"foo", "bar", "qux"})
+}
+
+func (s *MongoSuite) TestTailCollation(c *C) {
+	store := NewCappedFixtureStore(s.db)
+
+	q := store.Query()
+	q.Collation(&mgo.Collation{Locale: "en"})
+
+	_, err := store.Tail(q, time.Second)
+	c.Assert(err, Equals, storable.ErrTailWithCollation)
+}
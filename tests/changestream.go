@@ -0,0 +1,9 @@
+package tests
+
+import "gopkg.in/src-d/storable.v1"
+
+type WatchFixture struct {
+	storable.Document `bson:",inline" collection:"watch"`
+	Name              string
+	Count             int
+}
@@ -0,0 +1,221 @@
+package tests
+
+import (
+	"time"
+
+	. "gopkg.in/check.v1"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+	"gopkg.in/src-d/storable.v1"
+	"gopkg.in/src-d/storable.v1/operators"
+)
+
+// watchOplog returns the options to poll a fake oplog, since the tests do
+// not run on a replica set.
+func (s *MongoSuite) watchOplog(c *C) storable.WatchOptions {
+	oplog := s.db.C("watch_oplog")
+	c.Assert(oplog.Insert(bson.M{"ts": bson.MongoTimestamp(1), "op": "n"}), IsNil)
+
+	return storable.WatchOptions{
+		Polling:         true,
+		OplogDatabase:   s.db.Name,
+		OplogCollection: oplog.Name,
+		PollInterval:    10 * time.Millisecond,
+	}
+}
+
+func (s *MongoSuite) writeOplog(c *C, ts int, op string, o, o2 bson.M) {
+	c.Assert(s.db.C("watch_oplog").Insert(bson.M{
+		"ts": bson.MongoTimestamp(ts),
+		"op": op,
+		"ns": s.db.Name + ".watch",
+		"o":  o,
+		"o2": o2,
+	}), IsNil)
+}
+
+func (s *MongoSuite) TestWatchPolling(c *C) {
+	store := NewWatchFixtureStore(s.db)
+	opts := s.watchOplog(c)
+
+	cs, err := store.Watch(store.Query(), opts)
+	c.Assert(err, IsNil)
+	defer cs.Close()
+	c.Assert(cs.IsPolling(), Equals, true)
+
+	doc := store.New()
+	doc.Name = "foo"
+	c.Assert(store.Insert(doc), IsNil)
+
+	s.writeOplog(c, 2, "i", bson.M{"_id": doc.Id, "name": "foo", "count": 0}, nil)
+	s.writeOplog(c, 3, "u", bson.M{"$set": bson.M{"count": 1}}, bson.M{"_id": doc.Id})
+	s.writeOplog(c, 4, "d", bson.M{"_id": doc.Id}, nil)
+
+	c.Assert(cs.Next(), Equals, true)
+	event, result, err := cs.Get()
+	c.Assert(err, IsNil)
+	c.Assert(event.Operation, Equals, storable.InsertOperation)
+	c.Assert(event.Id, Equals, doc.Id)
+	c.Assert(result.Name, Equals, "foo")
+
+	c.Assert(cs.Next(), Equals, true)
+	event, result, err = cs.Get()
+	c.Assert(err, IsNil)
+	c.Assert(event.Operation, Equals, storable.UpdateOperation)
+	c.Assert(event.UpdatedFields, DeepEquals, bson.M{"count": 1})
+	c.Assert(result.Id, Equals, doc.Id)
+
+	c.Assert(cs.Next(), Equals, true)
+	event, result, err = cs.Get()
+	c.Assert(err, IsNil)
+	c.Assert(event.Operation, Equals, storable.DeleteOperation)
+	c.Assert(result, IsNil)
+	c.Assert(cs.ResumeToken(), DeepEquals, storable.ResumeToken{
+		"ts": bson.MongoTimestamp(4),
+	})
+}
+
+func (s *MongoSuite) TestWatchPollingResumeAndQuery(c *C) {
+	store := NewWatchFixtureStore(s.db)
+	opts := s.watchOplog(c)
+
+	foo, qux := store.New(), store.New()
+	foo.Name, qux.Name = "foo", "qux"
+	c.Assert(store.Insert(foo), IsNil)
+	c.Assert(store.Insert(qux), IsNil)
+
+	s.writeOplog(c, 2, "i", bson.M{"_id": foo.Id, "name": "foo"}, nil)
+	s.writeOplog(c, 3, "i", bson.M{"_id": qux.Id, "name": "qux"}, nil)
+	s.writeOplog(c, 4, "u", bson.M{"_id": qux.Id, "name": "qux"}, bson.M{"_id": qux.Id})
+
+	q := store.Query()
+	q.AddCriteria(operators.Eq(Schema.WatchFixture.Name, "qux"))
+	opts.ResumeAfter = storable.ResumeToken{"ts": bson.MongoTimestamp(1)}
+
+	cs, err := store.Watch(q, opts)
+	c.Assert(err, IsNil)
+
+	var events []storable.OperationType
+	err = cs.ForEach(func(e *storable.ChangeEvent, doc *WatchFixture) error {
+		c.Assert(doc.Id, Equals, qux.Id)
+		events = append(events, e.Operation)
+		if len(events) == 2 {
+			return storable.ErrStop
+		}
+
+		return nil
+	})
+
+	c.Assert(err, IsNil)
+	c.Assert(events, DeepEquals, []storable.OperationType{
+		storable.InsertOperation, storable.ReplaceOperation,
+	})
+	c.Assert(cs.Close(), IsNil)
+	c.Assert(cs.Close(), Equals, storable.ErrChangeStreamClosed)
+}
+
+func (s *MongoSuite) TestWatchStoreOplog(c *C) {
+	store := NewWatchFixtureStore(s.db)
+	store.EnableOplog(s.db.Name, "watch_store_oplog")
+
+	_, err := store.Watch(store.Query(), storable.WatchOptions{})
+	c.Assert(err, Equals, storable.ErrOplogNotFound)
+
+	doc := store.New()
+	doc.Name = "foo"
+	c.Assert(store.Insert(doc), IsNil)
+
+	cs, err := store.Watch(store.Query(), storable.WatchOptions{
+		PollInterval: 10 * time.Millisecond,
+	})
+	c.Assert(err, IsNil)
+	defer cs.Close()
+	c.Assert(cs.IsPolling(), Equals, true)
+
+	doc.Count = 1
+	c.Assert(store.Update(doc), IsNil)
+	c.Assert(store.Delete(doc), IsNil)
+
+	var events []storable.OperationType
+	err = cs.ForEach(func(e *storable.ChangeEvent, doc *WatchFixture) error {
+		events = append(events, e.Operation)
+		if len(events) == 2 {
+			return storable.ErrStop
+		}
+
+		return nil
+	})
+
+	c.Assert(err, IsNil)
+	c.Assert(events, DeepEquals, []storable.OperationType{
+		storable.ReplaceOperation, storable.DeleteOperation,
+	})
+}
+
+func (s *MongoSuite) TestWatchStoreOplogQuery(c *C) {
+	store := NewWatchFixtureStore(s.db)
+	store.EnableOplog(s.db.Name, "watch_store_oplog")
+	c.Assert(s.db.C("watch_store_oplog").Create(&mgo.CollectionInfo{}), IsNil)
+
+	q := store.Query()
+	q.AddCriteria(operators.Gte(Schema.WatchFixture.Count, 1))
+	cs, err := store.Watch(q, storable.WatchOptions{
+		PollInterval: 10 * time.Millisecond,
+	})
+	c.Assert(err, IsNil)
+	defer cs.Close()
+
+	doc := store.New()
+	c.Assert(store.Insert(doc), IsNil)
+
+	byId := store.Query()
+	byId.FindById(doc.Id)
+	c.Assert(store.RawUpdate(byId, bson.M{"count": 1}, false), IsNil)
+	c.Assert(store.RawUpdate(byId, bson.M{"count": 0}, false), IsNil)
+	c.Assert(store.Delete(doc), IsNil)
+
+	var events []*storable.ChangeEvent
+	err = cs.ForEach(func(e *storable.ChangeEvent, doc *WatchFixture) error {
+		events = append(events, e)
+		if len(events) == 2 {
+			return storable.ErrStop
+		}
+
+		return nil
+	})
+
+	c.Assert(err, IsNil)
+	c.Assert(events[0].Operation, Equals, storable.UpdateOperation)
+	c.Assert(events[0].UpdatedFields, DeepEquals, bson.M{"count": 1})
+	c.Assert(events[1].Operation, Equals, storable.DeleteOperation)
+}
+
+func (s *MongoSuite) TestWatchPollingApplyOps(c *C) {
+	store := NewWatchFixtureStore(s.db)
+	opts := s.watchOplog(c)
+
+	foo, qux := store.New(), store.New()
+	c.Assert(s.db.C("watch_oplog").Insert(bson.M{
+		"ts": bson.MongoTimestamp(2),
+		"op": "c",
+		"ns": "admin.$cmd",
+		"o": bson.M{"applyOps": []bson.M{
+			{"op": "i", "ns": s.db.Name + ".watch", "o": bson.M{"_id": foo.Id}},
+			{"op": "i", "ns": s.db.Name + ".other", "o": bson.M{"_id": foo.Id}},
+			{"op": "i", "ns": s.db.Name + ".watch", "o": bson.M{"_id": qux.Id}},
+		}},
+	}), IsNil)
+
+	opts.ResumeAfter = storable.ResumeToken{"ts": bson.MongoTimestamp(2), "i": 0}
+	cs, err := store.Watch(store.Query(), opts)
+	c.Assert(err, IsNil)
+	defer cs.Close()
+
+	c.Assert(cs.Next(), Equals, true)
+	event, _, err := cs.Get()
+	c.Assert(err, IsNil)
+	c.Assert(event.Id, Equals, qux.Id)
+	c.Assert(cs.ResumeToken(), DeepEquals, storable.ResumeToken{
+		"ts": bson.MongoTimestamp(2), "i": 2,
+	})
+}
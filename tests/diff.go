@@ -0,0 +1,30 @@
+package tests
+
+import "gopkg.in/src-d/storable.v1"
+
+type DiffFixture struct {
+	storable.Document `bson:",inline" collection:"diff"`
+	Name              string
+	Address           DiffAddress
+	DiffAudit         `bson:",inline"`
+	Tags              []string
+	Labels            map[string]string
+}
+
+type DiffAddress struct {
+	Street string
+	City   string
+}
+
+type DiffAudit struct {
+	Revision int
+}
+
+func newDiffFixture() *DiffFixture {
+	return &DiffFixture{
+		Name:    "foo",
+		Address: DiffAddress{Street: "foo", City: "bar"},
+		Tags:    []string{"foo", "bar"},
+		Labels:  map[string]string{"foo": "bar"},
+	}
+}
@@ -0,0 +1,56 @@
+package tests
+
+import (
+	. "gopkg.in/check.v1"
+	"gopkg.in/src-d/storable.v1"
+)
+
+func (s *MongoSuite) TestUpdateDiff(c *C) {
+	store := NewDiffFixtureStore(s.db)
+	doc := store.New()
+	c.Assert(store.Insert(doc), IsNil)
+
+	old := *doc
+	old.Tags = append([]string(nil), doc.Tags...)
+	old.Labels = map[string]string{"foo": "bar"}
+
+	doc.Address.City = "qux"
+	doc.Revision = 2
+	doc.Tags = append(doc.Tags, "qux")
+	doc.Labels = map[string]string{"qux": "foo"}
+
+	changes, err := store.UpdateDiff(&old, doc)
+	c.Assert(err, IsNil)
+	c.Assert(changes.Paths(), DeepEquals, []string{
+		"address.city", "revision", "tags.2", "labels.qux", "labels.foo",
+	})
+
+	q := store.Query()
+	q.FindById(doc.Id)
+	stored, err := store.FindOne(q)
+	c.Assert(err, IsNil)
+	c.Assert(stored.Name, Equals, "foo")
+	c.Assert(stored.Address, DeepEquals, DiffAddress{Street: "foo", City: "qux"})
+	c.Assert(stored.Revision, Equals, 2)
+	c.Assert(stored.Tags, DeepEquals, []string{"foo", "bar", "qux"})
+	c.Assert(stored.Labels, DeepEquals, map[string]string{"qux": "foo"})
+}
+
+func (s *MongoSuite) TestUpdateDiffNoChanges(c *C) {
+	store := NewDiffFixtureStore(s.db)
+	doc := store.New()
+	c.Assert(store.Insert(doc), IsNil)
+
+	old := *doc
+	changes, err := store.UpdateDiff(&old, doc)
+	c.Assert(err, IsNil)
+	c.Assert(changes, HasLen, 0)
+}
+
+func (s *MongoSuite) TestUpdateDiffNew(c *C) {
+	store := NewDiffFixtureStore(s.db)
+	doc := store.New()
+
+	_, err := store.UpdateDiff(doc, doc)
+	c.Assert(err, Equals, storable.ErrNewDocument)
+}
@@ -50,6 +50,27 @@ func (s *MongoSuite) TestEventsUpdateError(c *C) {
 	c.Assert(err, Equals, doc.MustFailBefore)
 }
 
+func (s *MongoSuite) TestEventsUpdateDiff(c *C) {
+	store := NewEventsFixtureStore(s.db)
+
+	doc := store.New()
+	c.Assert(store.Insert(doc), IsNil)
+
+	old := *doc
+	old.Checks = map[string]bool{"BeforeInsert": true}
+	doc.Checks = make(map[string]bool, 0)
+
+	changes, err := store.UpdateDiff(&old, doc)
+	c.Assert(err, IsNil)
+	c.Assert(changes.Paths(), DeepEquals, []string{
+		"checks.BeforeUpdate", "checks.BeforeInsert",
+	})
+	c.Assert(doc.Checks, DeepEquals, map[string]bool{
+		"BeforeUpdate": true,
+		"AfterUpdate":  true,
+	})
+}
+
 func (s *MongoSuite) TestEventsSaveOnInsert(c *C) {
 	store := NewEventsFixtureStore(s.db)
 
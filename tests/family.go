@@ -0,0 +1,22 @@
+package tests
+
+import "gopkg.in/src-d/storable.v1"
+
+type FamilyDogFixture struct {
+	storable.Document `bson:",inline" collection:"animals" kind:"dog"`
+	Name              string
+	Breed             string
+}
+
+type FamilyCatFixture struct {
+	storable.Document `bson:",inline" collection:"animals" kind:"cat"`
+	Name              string
+	Lives             int
+}
+
+//storable:history
+type FamilyBirdFixture struct {
+	storable.Document `bson:",inline" collection:"animals" kind:"bird"`
+	Name              string
+	Wings             int
+}
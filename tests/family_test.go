@@ -0,0 +1,145 @@
+package tests
+
+import (
+	"time"
+
+	. "gopkg.in/check.v1"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+	"gopkg.in/src-d/storable.v1"
+)
+
+func (s *MongoSuite) insertFamilyFixtures(c *C) {
+	dogs := NewFamilyDogFixtureStore(s.db)
+	dog := dogs.New()
+	dog.Name, dog.Breed = "foo", "beagle"
+	c.Assert(dogs.Insert(dog), IsNil)
+
+	cats := NewFamilyCatFixtureStore(s.db)
+	cat := cats.New()
+	cat.Name, cat.Lives = "bar", 7
+	c.Assert(cats.Insert(cat), IsNil)
+}
+
+func (s *MongoSuite) TestFamilyStoreFind(c *C) {
+	s.insertFamilyFixtures(c)
+
+	store := NewAnimalsFamilyStore(s.db)
+	q := store.Query()
+	q.Sort(storable.Sort{{storable.IdField, storable.Asc}})
+
+	rs, err := store.Find(q)
+	c.Assert(err, IsNil)
+
+	docs, err := rs.All()
+	c.Assert(err, IsNil)
+	c.Assert(docs, HasLen, 2)
+
+	dog, ok := docs[0].(*FamilyDogFixture)
+	c.Assert(ok, Equals, true)
+	c.Assert(dog.Breed, Equals, "beagle")
+	c.Assert(dog.IsNew(), Equals, false)
+
+	cat, ok := docs[1].(*FamilyCatFixture)
+	c.Assert(ok, Equals, true)
+	c.Assert(cat.Lives, Equals, 7)
+}
+
+func (s *MongoSuite) TestFamilyStoreWithoutKind(c *C) {
+	c.Assert(s.db.C("animals").Insert(bson.M{"name": "qux"}), IsNil)
+	c.Assert(s.db.C("animals").Insert(bson.M{"_kind": "fish", "name": "qux"}), IsNil)
+
+	store := NewAnimalsFamilyStore(s.db)
+	_, err := store.FindOne(store.Query())
+	c.Assert(err, Equals, storable.ErrNotFound)
+
+	s.insertFamilyFixtures(c)
+	rs, err := store.Find(store.Query())
+	c.Assert(err, IsNil)
+
+	docs, err := rs.All()
+	c.Assert(err, IsNil)
+	c.Assert(docs, HasLen, 2)
+}
+
+func (s *MongoSuite) TestFamilySubtypeStore(c *C) {
+	s.insertFamilyFixtures(c)
+
+	dogs := NewFamilyDogFixtureStore(s.db)
+	count, err := dogs.Count(dogs.Query())
+	c.Assert(err, IsNil)
+	c.Assert(count, Equals, 1)
+
+	dog, err := dogs.FindOne(dogs.Query())
+	c.Assert(err, IsNil)
+	c.Assert(dog.Name, Equals, "foo")
+
+	dog.Breed = "collie"
+	c.Assert(dogs.Update(dog), IsNil)
+
+	var raw bson.M
+	c.Assert(s.db.C("animals").FindId(dog.Id).One(&raw), IsNil)
+	c.Assert(raw["_kind"], Equals, "dog")
+	c.Assert(raw["breed"], Equals, "collie")
+
+	cats := NewFamilyCatFixtureStore(s.db)
+	q := cats.Query()
+	q.FindById(dog.Id)
+	_, err = cats.FindOne(q)
+	c.Assert(err, Equals, storable.ErrNotFound)
+}
+
+func (s *MongoSuite) TestFamilySubtypeStoreOtherKind(c *C) {
+	s.insertFamilyFixtures(c)
+
+	dogs := NewFamilyDogFixtureStore(s.db)
+	dog, err := dogs.FindOne(dogs.Query())
+	c.Assert(err, IsNil)
+
+	cats := NewFamilyCatFixtureStore(s.db)
+	cat := cats.New()
+	cat.Id, cat.Name = dog.Id, "qux"
+	cat.SetIsNew(false)
+	c.Assert(cats.Update(cat), Equals, mgo.ErrNotFound)
+	c.Assert(cats.Delete(cat), Equals, mgo.ErrNotFound)
+
+	_, err = cats.Save(cat)
+	c.Assert(mgo.IsDup(err), Equals, true)
+
+	dog, err = dogs.FindOne(dogs.Query())
+	c.Assert(err, IsNil)
+	c.Assert(dog.Name, Equals, "foo")
+}
+
+func (s *MongoSuite) TestFamilyHistory(c *C) {
+	birds := NewFamilyBirdFixtureStore(s.db)
+	bird := birds.New()
+	bird.Name, bird.Wings = "foo", 2
+	c.Assert(birds.Insert(bird), IsNil)
+
+	created := time.Now()
+	time.Sleep(10 * time.Millisecond)
+
+	bird.Wings = 1
+	c.Assert(birds.Update(bird), IsNil)
+	c.Assert(birds.Delete(bird), IsNil)
+
+	history, err := birds.History(bird.Id)
+	c.Assert(err, IsNil)
+	c.Assert(history, HasLen, 2)
+	c.Assert(history[0].Version, Equals, 1)
+	c.Assert(history[1].Version, Equals, 2)
+
+	past, err := birds.AsOf(bird.Id, created)
+	c.Assert(err, IsNil)
+	c.Assert(past.Wings, Equals, 2)
+
+	var versions bson.M
+	c.Assert(s.db.C("animals"+storable.HistoryVersionsSuffix).FindId(bird.Id).One(&versions), IsNil)
+	c.Assert(versions["_kind"], IsNil)
+}
+
+func (s *MongoSuite) TestFamilyRegistry(c *C) {
+	c.Assert(storable.DefaultRegistry.Model(&FamilyDogFixture{}).Kind, Equals, "dog")
+	c.Assert(storable.DefaultRegistry.Kinds("animals"), DeepEquals, AnimalsKinds)
+}
@@ -0,0 +1,36 @@
+package tests
+
+import (
+	"time"
+
+	"gopkg.in/mgo.v2/bson"
+	"gopkg.in/src-d/storable.v1"
+)
+
+type FindersFixture struct {
+	storable.Document `bson:",inline" collection:"finders"`
+	Name              string
+	Status            int
+	Tags              []string
+	Price             struct {
+		Amount float64
+	}
+	CreatedAt time.Time
+}
+
+func newFindersFixture(name string, status int, tags []string) *FindersFixture {
+	return &FindersFixture{Name: name, Status: status, Tags: tags}
+}
+
+//storable:finders
+type FindersFixtureFinders interface {
+	FindByName(name string) (*FindersFixture, error)
+	FindByNameAndStatus(name string, status int) (*FindersFixture, error)
+	FindByStatusIn(status []int) ([]*FindersFixture, error)
+	FindByStatusGreaterThan(status int) (*FindersFixtureResultSet, error)
+	FindByNameMatches(pattern string) ([]*FindersFixture, error)
+	FindByPriceAmountLessThanEqual(amount float64) ([]*FindersFixture, error)
+	FindByIdIn(ids ...bson.ObjectId) ([]*FindersFixture, error)
+	CountByTagsContains(tag string) (int, error)
+	CountByStatusNot(status int) (int, error)
+}
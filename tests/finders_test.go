@@ -0,0 +1,80 @@
+package tests
+
+import (
+	. "gopkg.in/check.v1"
+	"gopkg.in/src-d/storable.v1"
+)
+
+func (s *MongoSuite) TestFindersFindOne(c *C) {
+	store := s.newFindersFixtureStore(c)
+
+	doc, err := store.FindByName("foo")
+	c.Assert(err, IsNil)
+	c.Assert(doc.Name, Equals, "foo")
+
+	doc, err = store.FindByNameAndStatus("foo", 2)
+	c.Assert(err, Equals, storable.ErrNotFound)
+
+	doc, err = store.FindByNameAndStatus("bar", 2)
+	c.Assert(err, IsNil)
+	c.Assert(doc.Name, Equals, "bar")
+}
+
+func (s *MongoSuite) TestFindersFindAll(c *C) {
+	store := s.newFindersFixtureStore(c)
+
+	docs, err := store.FindByStatusIn([]int{1, 3})
+	c.Assert(err, IsNil)
+	c.Assert(docs, HasLen, 2)
+
+	docs, err = store.FindByNameMatches("^ba")
+	c.Assert(err, IsNil)
+	c.Assert(docs, HasLen, 2)
+
+	docs, err = store.FindByPriceAmountLessThanEqual(20)
+	c.Assert(err, IsNil)
+	c.Assert(docs, HasLen, 2)
+
+	docs, err = store.FindByIdIn(docs[0].Id, docs[1].Id)
+	c.Assert(err, IsNil)
+	c.Assert(docs, HasLen, 2)
+}
+
+func (s *MongoSuite) TestFindersFindResultSet(c *C) {
+	store := s.newFindersFixtureStore(c)
+
+	rs, err := store.FindByStatusGreaterThan(1)
+	c.Assert(err, IsNil)
+
+	docs, err := rs.All()
+	c.Assert(err, IsNil)
+	c.Assert(docs, HasLen, 2)
+}
+
+func (s *MongoSuite) TestFindersCount(c *C) {
+	store := s.newFindersFixtureStore(c)
+
+	count, err := store.CountByTagsContains("qux")
+	c.Assert(err, IsNil)
+	c.Assert(count, Equals, 2)
+
+	count, err = store.CountByStatusNot(1)
+	c.Assert(err, IsNil)
+	c.Assert(count, Equals, 2)
+}
+
+func (s *MongoSuite) newFindersFixtureStore(c *C) *FindersFixtureStore {
+	store := NewFindersFixtureStore(s.db)
+	for i, name := range []string{"foo", "bar", "baz"} {
+		tags := []string{name}
+		if i != 1 {
+			tags = append(tags, "qux")
+		}
+
+		doc := store.New(name, i+1, tags)
+		doc.Price.Amount = float64(i+1) * 10
+		c.Assert(store.Insert(doc), IsNil)
+	}
+
+	return store
+}
@@ -0,0 +1,10 @@
+package tests
+
+import "gopkg.in/src-d/storable.v1"
+
+type GeoFixture struct {
+	storable.Document `bson:",inline" collection:"geo"`
+	Name              string
+	Location          storable.Point
+	Area              *storable.Polygon `bson:",omitempty"`
+}
@@ -0,0 +1,81 @@
+package tests
+
+import (
+	. "gopkg.in/check.v1"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/src-d/storable.v1"
+	"gopkg.in/src-d/storable.v1/operators"
+)
+
+func (s *MongoSuite) insertGeoFixtures(c *C) *GeoFixtureStore {
+	store := NewGeoFixtureStore(s.db)
+	c.Assert(store.EnsureIndex(mgo.Index{Key: []string{
+		storable.Geo2DSphereIndex.Key(Schema.GeoFixture.Location),
+	}}), IsNil)
+
+	for name, p := range map[string]storable.Point{
+		"sol":     storable.NewPoint(-3.7038, 40.4168),
+		"retiro":  storable.NewPoint(-3.6823, 40.4153),
+		"toledo":  storable.NewPoint(-4.0273, 39.8628),
+		"segovia": storable.NewPoint(-4.1088, 40.9429),
+	} {
+		doc := store.New()
+		doc.Name = name
+		doc.Location = p
+		c.Assert(store.Insert(doc), IsNil)
+	}
+
+	return store
+}
+
+func (s *MongoSuite) TestGeoNear(c *C) {
+	store := s.insertGeoFixtures(c)
+
+	q := store.Query()
+	q.AddCriteria(operators.Near(Schema.GeoFixture.Location, storable.NewPoint(-3.7038, 40.4168), 5000, 0))
+
+	docs, err := store.MustFind(q).All()
+	c.Assert(err, IsNil)
+	c.Assert(docs, HasLen, 2)
+	c.Assert(docs[0].Name, Equals, "sol")
+	c.Assert(docs[1].Name, Equals, "retiro")
+	c.Assert(docs[1].Location, Equals, storable.NewPoint(-3.6823, 40.4153))
+}
+
+func (s *MongoSuite) TestGeoNearSphereMinDistance(c *C) {
+	store := s.insertGeoFixtures(c)
+
+	q := store.Query()
+	q.AddCriteria(operators.NearSphere(Schema.GeoFixture.Location, storable.NewPoint(-3.7038, 40.4168), 0, 5000))
+
+	docs, err := store.MustFind(q).All()
+	c.Assert(err, IsNil)
+	c.Assert(docs, HasLen, 2)
+	c.Assert(docs[0].Name, Equals, "toledo")
+}
+
+func (s *MongoSuite) TestGeoWithin(c *C) {
+	store := s.insertGeoFixtures(c)
+
+	madrid := storable.Polygon{{{-3.8, 40.3}, {-3.6, 40.3}, {-3.6, 40.5}, {-3.8, 40.5}, {-3.8, 40.3}}}
+
+	q := store.Query()
+	q.AddCriteria(operators.GeoWithin(Schema.GeoFixture.Location, madrid))
+	c.Assert(store.MustCount(q), Equals, 2)
+}
+
+func (s *MongoSuite) TestGeoIntersects(c *C) {
+	store := NewGeoFixtureStore(s.db)
+
+	area := storable.Polygon{{{0, 0}, {0, 1}, {1, 1}, {1, 0}, {0, 0}}}
+	doc := store.New()
+	doc.Area = &area
+	c.Assert(store.Insert(doc), IsNil)
+
+	line := storable.LineString{{-1, 0.5}, {2, 0.5}}
+	q := store.Query()
+	q.AddCriteria(operators.GeoIntersects(Schema.GeoFixture.Area, line))
+
+	found := store.MustFindOne(q)
+	c.Assert(*found.Area, DeepEquals, area)
+}
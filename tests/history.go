@@ -0,0 +1,11 @@
+package tests
+
+import "gopkg.in/src-d/storable.v1"
+
+//storable:history
+type HistoryFixture struct {
+	storable.Document `bson:",inline" collection:"history"`
+	Name              string
+	Count             int
+	Note              string `bson:",omitempty"`
+}
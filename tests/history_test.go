@@ -0,0 +1,199 @@
+package tests
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	. "gopkg.in/check.v1"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+	"gopkg.in/src-d/storable.v1"
+)
+
+func (s *MongoSuite) TestHistory(c *C) {
+	store := NewHistoryFixtureStore(s.db)
+
+	doc := store.New()
+	doc.Name, doc.Note = "foo", "note"
+	c.Assert(store.Insert(doc), IsNil)
+
+	ctx := storable.WithActor(context.Background(), "john")
+	doc.Name, doc.Count, doc.Note = "qux", 1, ""
+	c.Assert(store.WithContext(ctx).Update(doc), IsNil)
+
+	doc.Count = 2
+	_, err := store.Save(doc)
+	c.Assert(err, IsNil)
+
+	history, err := store.History(doc.Id)
+	c.Assert(err, IsNil)
+	c.Assert(history, HasLen, 2)
+
+	c.Assert(history[0].Version, Equals, 1)
+	c.Assert(history[0].Operation, Equals, storable.UpdateOperation)
+	c.Assert(history[0].Actor, Equals, "john")
+	c.Assert(history[0].Changes.Update(), DeepEquals, bson.M{
+		"$set":   bson.M{"name": "qux", "count": 1},
+		"$unset": bson.M{"note": ""},
+	})
+
+	var first HistoryFixture
+	c.Assert(history[0].Decode(&first), IsNil)
+	c.Assert(first.Name, Equals, "foo")
+	c.Assert(first.Note, Equals, "note")
+
+	c.Assert(history[1].Version, Equals, 2)
+	c.Assert(history[1].Actor, Equals, "")
+	c.Assert(history[1].Changes.Update(), DeepEquals, bson.M{"$set": bson.M{"count": 2}})
+
+	c.Assert(store.Delete(doc), IsNil)
+
+	history, err = store.History(doc.Id)
+	c.Assert(err, IsNil)
+	c.Assert(history, HasLen, 3)
+	c.Assert(history[2].Operation, Equals, storable.DeleteOperation)
+	c.Assert(history[2].Changes, HasLen, 0)
+}
+
+func (s *MongoSuite) TestHistoryFailedWrite(c *C) {
+	store := NewHistoryFixtureStore(s.db)
+	c.Assert(store.EnsureIndex(mgo.Index{Key: []string{"name"}, Unique: true}), IsNil)
+
+	foo, qux := store.New(), store.New()
+	foo.Name, qux.Name = "foo", "qux"
+	c.Assert(store.Insert(foo), IsNil)
+	c.Assert(store.Insert(qux), IsNil)
+
+	qux.Name = "foo"
+	c.Assert(mgo.IsDup(store.Update(qux)), Equals, true)
+
+	history, err := store.History(qux.Id)
+	c.Assert(err, IsNil)
+	c.Assert(history, HasLen, 0)
+
+	indexes, err := s.db.C("history" + storable.HistorySuffix).Indexes()
+	c.Assert(err, IsNil)
+	c.Assert(indexes, HasLen, 2)
+	c.Assert(indexes[1].Unique, Equals, true)
+}
+
+func (s *MongoSuite) TestHistoryConcurrentUpdates(c *C) {
+	store := NewHistoryFixtureStore(s.db)
+
+	doc := store.New()
+	c.Assert(store.Insert(doc), IsNil)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 1; i <= 10; i++ {
+		wg.Add(1)
+		go func(count int) {
+			defer wg.Done()
+			other := *doc
+			other.Count = count
+			errs <- store.Update(&other)
+		}(i)
+	}
+
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		c.Assert(err, IsNil)
+	}
+
+	history, err := store.History(doc.Id)
+	c.Assert(err, IsNil)
+	c.Assert(history, HasLen, 10)
+
+	current, err := store.FindOne(store.Query().FindById(doc.Id))
+	c.Assert(err, IsNil)
+
+	seen := map[int]bool{current.Count: true}
+	for _, r := range history {
+		var version HistoryFixture
+		c.Assert(r.Decode(&version), IsNil)
+		seen[version.Count] = true
+	}
+
+	c.Assert(seen, HasLen, 11)
+}
+
+func (s *MongoSuite) TestHistoryUpdateDiff(c *C) {
+	store := NewHistoryFixtureStore(s.db)
+
+	doc := store.New()
+	doc.Name, doc.Note = "foo", "note"
+	c.Assert(store.Insert(doc), IsNil)
+
+	// the note is changed by other writer, so only the count is written
+	other := *doc
+	other.Note = "other"
+	c.Assert(store.Update(&other), IsNil)
+
+	old := *doc
+	doc.Count = 1
+	_, err := store.UpdateDiff(&old, doc)
+	c.Assert(err, IsNil)
+
+	history, err := store.History(doc.Id)
+	c.Assert(err, IsNil)
+	c.Assert(history, HasLen, 2)
+	c.Assert(history[1].Changes.Update(), DeepEquals, bson.M{"$set": bson.M{"count": 1}})
+
+	current, err := store.FindOne(store.Query().FindById(doc.Id))
+	c.Assert(err, IsNil)
+	c.Assert(current.Note, Equals, "other")
+	c.Assert(current.Count, Equals, 1)
+}
+
+func (s *MongoSuite) TestHistoryAsOf(c *C) {
+	store := NewHistoryFixtureStore(s.db)
+
+	doc := store.New()
+	doc.Name = "foo"
+
+	// the id is set by New, with the time before the insert
+	time.Sleep(10 * time.Millisecond)
+	before := time.Now()
+	time.Sleep(10 * time.Millisecond)
+	c.Assert(store.Insert(doc), IsNil)
+
+	time.Sleep(10 * time.Millisecond)
+	created := time.Now()
+	time.Sleep(10 * time.Millisecond)
+
+	doc.Name = "qux"
+	c.Assert(store.Update(doc), IsNil)
+	updated := time.Now()
+
+	past, err := store.AsOf(doc.Id, created)
+	c.Assert(err, IsNil)
+	c.Assert(past.Name, Equals, "foo")
+
+	current, err := store.AsOf(doc.Id, updated)
+	c.Assert(err, IsNil)
+	c.Assert(current.Name, Equals, "qux")
+
+	_, err = store.AsOf(doc.Id, created.Add(-time.Hour))
+	c.Assert(err, Equals, storable.ErrNotFound)
+
+	_, err = store.AsOf(doc.Id, before)
+	c.Assert(err, Equals, storable.ErrNotFound)
+
+	c.Assert(store.Delete(doc), IsNil)
+	_, err = store.AsOf(doc.Id, time.Now())
+	c.Assert(err, Equals, storable.ErrNotFound)
+}
+
+func (s *MongoSuite) TestHistoryDisabled(c *C) {
+	store := NewEventsFixtureStore(s.db)
+
+	doc := store.New()
+	c.Assert(store.Insert(doc), IsNil)
+	c.Assert(store.Update(doc), IsNil)
+
+	history, err := store.Store.History(doc.Id)
+	c.Assert(err, IsNil)
+	c.Assert(history, HasLen, 0)
+}
@@ -0,0 +1,10 @@
+package tests
+
+import (
+	"gopkg.in/src-d/storable.v1"
+)
+
+type OutboxFixture struct {
+	storable.Document `bson:",inline" collection:"outbox"`
+	Total             float64
+}
@@ -0,0 +1,71 @@
+package tests
+
+import (
+	"errors"
+
+	. "gopkg.in/check.v1"
+	"gopkg.in/src-d/storable.v1/outbox"
+)
+
+func (s *MongoSuite) TestOutboxRelay(c *C) {
+	store := NewOutboxFixtureStore(s.db)
+	events := outbox.New(s.db, "outbox_events")
+	c.Assert(events.EnsureIndexes(), IsNil)
+
+	first, second := store.New(), store.New()
+	first.Total, second.Total = 10, 20
+	for _, doc := range []*OutboxFixture{first, second} {
+		c.Assert(store.Insert(doc), IsNil)
+		_, err := events.InTx(store.Tx()).Publish("created", doc)
+		c.Assert(err, IsNil)
+	}
+
+	c.Assert(store.Update(first), IsNil)
+	_, err := events.Publish("paid", first)
+	c.Assert(err, IsNil)
+
+	var delivered []float64
+	var sequences []int64
+	relay := outbox.NewRelay(events, outbox.SinkFunc(func(e *outbox.Event) error {
+		var doc OutboxFixture
+		c.Assert(e.Decode(&doc), IsNil)
+		delivered = append(delivered, doc.Total)
+		sequences = append(sequences, e.Sequence)
+		return nil
+	}))
+
+	n, err := relay.Dispatch()
+	c.Assert(err, IsNil)
+	c.Assert(n, Equals, 3)
+	c.Assert(delivered, DeepEquals, []float64{10, 20, 10})
+	c.Assert(sequences, DeepEquals, []int64{1, 2, 3})
+
+	pending, err := events.Pending()
+	c.Assert(err, IsNil)
+	c.Assert(pending, Equals, 0)
+
+	// the updates of the documents do not write the events again
+	c.Assert(store.Update(second), IsNil)
+	n, err = relay.Dispatch()
+	c.Assert(err, IsNil)
+	c.Assert(n, Equals, 0)
+}
+
+func (s *MongoSuite) TestOutboxRelayFailure(c *C) {
+	events := outbox.New(s.db, "outbox_events")
+	_, err := events.Publish("created", &OutboxFixture{Total: 10})
+	c.Assert(err, IsNil)
+
+	failure := errors.New("failure")
+	relay := outbox.NewRelay(events, outbox.SinkFunc(func(e *outbox.Event) error {
+		return failure
+	}))
+
+	n, err := relay.Dispatch()
+	c.Assert(err, Equals, failure)
+	c.Assert(n, Equals, 0)
+
+	pending, err := events.Pending()
+	c.Assert(err, IsNil)
+	c.Assert(pending, Equals, 1)
+}
@@ -1,6 +1,11 @@
 package tests
 
-import "gopkg.in/src-d/storable.v1"
+import (
+	"time"
+
+	"gopkg.in/src-d/storable.v1"
+	"gopkg.in/src-d/storable.v1/operators"
+)
 
 type QueryFixture struct {
 	storable.Document `bson:",inline" collection:"query"`
@@ -10,3 +15,35 @@ type QueryFixture struct {
 func newQueryFixture(f string) *QueryFixture {
 	return &QueryFixture{Foo: f}
 }
+
+type ElemMatchFixture struct {
+	storable.Document `bson:",inline" collection:"query"`
+	Items             []ElemMatchItem
+	Scores            []int
+}
+
+type ElemMatchItem struct {
+	Name     string
+	Quantity int
+}
+
+type ExprFixture struct {
+	storable.Document `bson:",inline" collection:"query"`
+	Price             ExprPrice
+	Discount          float64
+	CreatedAt         time.Time
+}
+
+type ExprPrice struct {
+	Amount float64
+}
+
+type BitsFixture struct {
+	storable.Document `bson:",inline" collection:"query"`
+	Flags             int
+}
+
+func (q *QueryFixtureQuery) FindByFoo(foo string) *QueryFixtureQuery {
+	q.AddCriteria(operators.Eq(Schema.QueryFixture.Foo, foo))
+	return q
+}
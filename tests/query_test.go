@@ -1,6 +1,16 @@
 package tests
 
-import . "gopkg.in/check.v1"
+import (
+	"time"
+
+	. "gopkg.in/check.v1"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+	"gopkg.in/src-d/storable.v1"
+	"gopkg.in/src-d/storable.v1/operators"
+	"gopkg.in/src-d/storable.v1/operators/expr"
+	"gopkg.in/src-d/storable.v1/storabletest"
+)
 
 func (s *MongoSuite) TestQueryFindById(c *C) {
 	store := NewResultSetFixtureStore(s.db)
@@ -11,3 +21,230 @@ func (s *MongoSuite) TestQueryFindById(c *C) {
 	q := store.Query().FindById(doc.Id)
 	c.Assert(store.MustFindOne(q).Foo, Equals, "bar")
 }
+
+func (s *MongoSuite) TestQueryValidate(c *C) {
+	store := NewQueryFixtureStore(s.db)
+
+	q := store.Query()
+	q.AddCriteria(operators.Eq(Schema.QueryFixture.Foo, "bar"))
+	c.Assert(q.Validate(Schema.QueryFixture), IsNil)
+
+	q.AddCriteria(operators.Eq(Schema.QueryFixture.Foo, 42))
+	c.Assert(q.Validate(Schema.QueryFixture), ErrorMatches, `criteria 1: incompatible value "foo": 42`)
+}
+
+func (s *MongoSuite) TestQueryStrictStore(c *C) {
+	store := NewQueryFixtureStore(s.db)
+	store.SetStrict(storable.DefaultRegistry.Model(&QueryFixture{}))
+
+	q := store.Query()
+	q.AddCriteria(operators.Eq(storable.NewField("bar", "string"), "bar"))
+
+	_, err := store.Find(q)
+	c.Assert(err, ErrorMatches, `criteria 0: unknown path "bar"`)
+}
+
+func (s *MongoSuite) TestQueryElemMatch(c *C) {
+	store := NewElemMatchFixtureStore(s.db)
+
+	doc := store.New()
+	doc.Items = []ElemMatchItem{{"foo", 1}, {"bar", 5}}
+	c.Assert(store.Insert(doc), IsNil)
+
+	doc = store.New()
+	doc.Items = []ElemMatchItem{{"foo", 5}}
+	c.Assert(store.Insert(doc), IsNil)
+
+	q := store.Query()
+	q.AddCriteria(operators.ElemMatch(Schema.ElemMatchFixture.Items,
+		operators.Eq(Schema.ElemMatchFixture.Items.Name, "foo"),
+		operators.Gt(Schema.ElemMatchFixture.Items.Quantity, 2),
+	))
+
+	docs, err := store.MustFind(q).All()
+	c.Assert(err, IsNil)
+	c.Assert(docs, HasLen, 1)
+	c.Assert(docs[0].Id, Equals, doc.Id)
+}
+
+func (s *MongoSuite) TestQueryElemMatchScalar(c *C) {
+	store := NewElemMatchFixtureStore(s.db)
+
+	doc := store.New()
+	doc.Scores = []int{10, 95}
+	c.Assert(store.Insert(doc), IsNil)
+
+	doc = store.New()
+	doc.Scores = []int{85}
+	c.Assert(store.Insert(doc), IsNil)
+
+	q := store.Query()
+	q.AddCriteria(operators.ElemMatch(Schema.ElemMatchFixture.Scores,
+		operators.Gte(Schema.ElemMatchFixture.Scores, 80),
+		operators.Lt(Schema.ElemMatchFixture.Scores, 90),
+	))
+
+	docs, err := store.MustFind(q).All()
+	c.Assert(err, IsNil)
+	c.Assert(docs, HasLen, 1)
+	c.Assert(docs[0].Id, Equals, doc.Id)
+}
+
+func (s *MongoSuite) TestQueryPositionalProjection(c *C) {
+	store := NewElemMatchFixtureStore(s.db)
+
+	doc := store.New()
+	doc.Items = []ElemMatchItem{{"foo", 1}, {"bar", 5}, {"qux", 7}}
+	c.Assert(store.Insert(doc), IsNil)
+
+	q := store.Query()
+	q.AddCriteria(operators.Eq(Schema.ElemMatchFixture.Items.Name, "bar"))
+	q.Select(storable.Select{{Schema.ElemMatchFixture.Items.Positional(), storable.Include}})
+	c.Assert(store.MustFindOne(q).Items, DeepEquals, []ElemMatchItem{{"bar", 5}})
+
+	q = store.Query()
+	q.Project(operators.Slice(Schema.ElemMatchFixture.Items, -2))
+	c.Assert(store.MustFindOne(q).Items, DeepEquals, []ElemMatchItem{{"bar", 5}, {"qux", 7}})
+
+	q = store.Query()
+	q.Project(operators.ElemMatch(Schema.ElemMatchFixture.Items,
+		operators.Gt(Schema.ElemMatchFixture.Items.Quantity, 6),
+	))
+	c.Assert(store.MustFindOne(q).Items, DeepEquals, []ElemMatchItem{{"qux", 7}})
+}
+
+func (s *MongoSuite) insertExprFixtures(c *C) *ExprFixtureStore {
+	store := NewExprFixtureStore(s.db)
+	for i, discount := range []float64{5, 15, 25} {
+		doc := store.New()
+		doc.Price.Amount = 10
+		doc.Discount = discount
+		doc.CreatedAt = time.Date(2015+i, time.March, 1, 0, 0, 0, 0, time.UTC)
+		c.Assert(store.Insert(doc), IsNil)
+	}
+
+	return store
+}
+
+func (s *MongoSuite) TestQueryExprCompareFields(c *C) {
+	store := s.insertExprFixtures(c)
+
+	q := store.Query()
+	q.AddCriteria(operators.Expr(expr.Gt(
+		expr.Ref(Schema.ExprFixture.Discount),
+		expr.Ref(Schema.ExprFixture.Price.Amount),
+	)))
+	c.Assert(q.Validate(Schema.ExprFixture), IsNil)
+
+	count, err := store.Count(q)
+	c.Assert(err, IsNil)
+	c.Assert(count, Equals, 2)
+}
+
+func (s *MongoSuite) TestQueryExprArithmeticAndDates(c *C) {
+	store := s.insertExprFixtures(c)
+
+	q := store.Query()
+	q.AddCriteria(operators.Expr(expr.And(
+		expr.Gt(expr.Subtract(expr.Ref(Schema.ExprFixture.Discount), expr.Ref(Schema.ExprFixture.Price.Amount)), 0),
+		expr.Eq(expr.Year(expr.Ref(Schema.ExprFixture.CreatedAt)), 2017),
+	)))
+
+	docs, err := store.MustFind(q).All()
+	c.Assert(err, IsNil)
+	c.Assert(docs, HasLen, 1)
+	c.Assert(docs[0].Discount, Equals, 25.0)
+}
+
+func (s *MongoSuite) TestQueryBits(c *C) {
+	store := NewBitsFixtureStore(s.db)
+	for _, flags := range []int{1, 3, 6} {
+		doc := store.New()
+		doc.Flags = flags
+		c.Assert(store.Insert(doc), IsNil)
+	}
+
+	q := store.Query()
+	q.AddCriteria(operators.BitsAllSet(Schema.BitsFixture.Flags, 3))
+	count, err := store.Count(q)
+	c.Assert(err, IsNil)
+	c.Assert(count, Equals, 1)
+
+	q = store.Query()
+	q.AddCriteria(operators.BitsAnySet(Schema.BitsFixture.Flags, []int{1}))
+	count, err = store.Count(q)
+	c.Assert(err, IsNil)
+	c.Assert(count, Equals, 2)
+
+	q = store.Query()
+	q.AddCriteria(operators.BitsAllClear(Schema.BitsFixture.Flags, 1))
+	count, err = store.Count(q)
+	c.Assert(err, IsNil)
+	c.Assert(count, Equals, 1)
+}
+
+func (s *MongoSuite) TestQueryJSONSchema(c *C) {
+	store := NewBitsFixtureStore(s.db)
+	c.Assert(store.Insert(store.New()), IsNil)
+	c.Assert(store.Insert(store.New()), IsNil)
+	c.Assert(s.db.C("query").Insert(bson.M{"flags": "foo"}), IsNil)
+
+	q := store.Query()
+	q.AddCriteria(operators.Nor(operators.JSONSchema(bson.M{
+		"properties": bson.M{"flags": bson.M{"bsonType": "number"}},
+	})))
+
+	count, err := store.Count(q)
+	c.Assert(err, IsNil)
+	c.Assert(count, Equals, 1)
+
+	q = store.Query()
+	q.AddCriteria(operators.TypeAlias(Schema.BitsFixture.Flags, operators.AliasString))
+	count, err = store.Count(q)
+	c.Assert(err, IsNil)
+	c.Assert(count, Equals, 1)
+}
+
+func (s *MongoSuite) TestQueryUsesIndex(c *C) {
+	store := NewQueryFixtureStore(s.db)
+	c.Assert(store.Insert(store.New("bar")), IsNil)
+
+	q := store.Query().FindByFoo("bar")
+	c.Assert(q, Not(storabletest.UsesIndex), store)
+
+	c.Assert(store.EnsureIndex(mgo.Index{Key: []string{
+		storable.AscIndex.Key(Schema.QueryFixture.Foo),
+	}}), IsNil)
+
+	c.Assert(q, storabletest.UsesIndex, store)
+	c.Assert(store.Query().FindById(bson.NewObjectId()), storabletest.UsesIndex, store)
+}
+
+func (s *MongoSuite) TestQueryCloneAndMerge(c *C) {
+	store := NewQueryFixtureStore(s.db)
+	for _, foo := range []string{"foo", "bar", "qux"} {
+		c.Assert(store.Insert(store.New(foo)), IsNil)
+	}
+
+	base := store.Query()
+	base.AddCriteria(operators.Ne(Schema.QueryFixture.Foo, "qux"))
+	base.Sort(storable.Sort{{Schema.QueryFixture.Foo, storable.Asc}})
+	base.Limit(1)
+
+	count := base.Clone()
+	count.ResetPagination()
+	c.Assert(store.MustCount(count), Equals, 2)
+
+	page := base.Clone()
+	page.Merge(store.Query().FindByFoo("foo"))
+
+	docs, err := store.MustFind(page).All()
+	c.Assert(err, IsNil)
+	c.Assert(docs, HasLen, 1)
+	c.Assert(docs[0].Foo, Equals, "foo")
+
+	first, err := store.MustFind(base).All()
+	c.Assert(err, IsNil)
+	c.Assert(first, HasLen, 1)
+	c.Assert(first[0].Foo, Equals, "bar")
+}
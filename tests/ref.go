@@ -0,0 +1,17 @@
+package tests
+
+import (
+	"gopkg.in/mgo.v2/bson"
+	"gopkg.in/src-d/storable.v1"
+)
+
+type RefCustomerFixture struct {
+	storable.Document `bson:",inline" collection:"customers"`
+	Name              string
+}
+
+type RefOrderFixture struct {
+	storable.Document `bson:",inline" collection:"orders"`
+	CustomerId        bson.ObjectId   `ref:"customers"`
+	WatcherIds        []bson.ObjectId `ref:"RefCustomerFixture"`
+}
@@ -0,0 +1,71 @@
+package tests
+
+import (
+	. "gopkg.in/check.v1"
+	"gopkg.in/mgo.v2/bson"
+	"gopkg.in/src-d/storable.v1"
+)
+
+func (s *MongoSuite) insertRefFixtures(c *C) (*RefOrderFixtureStore, []*RefCustomerFixture) {
+	customers := NewRefCustomerFixtureStore(s.db)
+	var docs []*RefCustomerFixture
+	for _, name := range []string{"foo", "bar", "qux"} {
+		doc := customers.New()
+		doc.Name = name
+		c.Assert(customers.Insert(doc), IsNil)
+		docs = append(docs, doc)
+	}
+
+	return NewRefOrderFixtureStore(s.db), docs
+}
+
+func (s *MongoSuite) TestRefPopulate(c *C) {
+	store, customers := s.insertRefFixtures(c)
+
+	orders := []*RefOrderFixture{store.New(), store.New(), store.New()}
+	orders[0].CustomerId = customers[0].Id
+	orders[1].CustomerId = customers[1].Id
+	orders[2].CustomerId = customers[0].Id
+
+	result, err := store.PopulateCustomerId(orders)
+	c.Assert(err, IsNil)
+	c.Assert(result, HasLen, 2)
+	c.Assert(result[customers[0].Id].Name, Equals, "foo")
+	c.Assert(result[customers[1].Id].Name, Equals, "bar")
+}
+
+func (s *MongoSuite) TestRefPopulateSlice(c *C) {
+	store, customers := s.insertRefFixtures(c)
+
+	order := store.New()
+	order.WatcherIds = []bson.ObjectId{customers[1].Id, customers[2].Id}
+
+	result, err := store.PopulateWatcherIds([]*RefOrderFixture{order, store.New()})
+	c.Assert(err, IsNil)
+	c.Assert(result, HasLen, 2)
+	c.Assert(result[customers[2].Id].Name, Equals, "qux")
+}
+
+func (s *MongoSuite) TestRefPopulateDangling(c *C) {
+	store, customers := s.insertRefFixtures(c)
+
+	dangling := bson.NewObjectId()
+	order := store.New()
+	order.WatcherIds = []bson.ObjectId{customers[0].Id, dangling}
+
+	result, err := store.PopulateWatcherIds([]*RefOrderFixture{order})
+	c.Assert(result, HasLen, 1)
+	c.Assert(err, DeepEquals, &storable.RefError{
+		Field:      "watcherids",
+		Collection: "customers",
+		Ids:        []bson.ObjectId{dangling},
+	})
+}
+
+func (s *MongoSuite) TestRefPopulateEmpty(c *C) {
+	store := NewRefOrderFixtureStore(s.db)
+
+	result, err := store.PopulateCustomerId([]*RefOrderFixture{store.New()})
+	c.Assert(err, IsNil)
+	c.Assert(result, HasLen, 0)
+}
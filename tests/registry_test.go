@@ -0,0 +1,45 @@
+package tests
+
+import (
+	"reflect"
+
+	. "gopkg.in/check.v1"
+	"gopkg.in/src-d/storable.v1"
+)
+
+func (s *MongoSuite) TestRegistryModel(c *C) {
+	m := storable.DefaultRegistry.Model(&SchemaFixture{})
+	c.Assert(m, NotNil)
+	c.Assert(m.Name, Equals, "SchemaFixture")
+	c.Assert(m.Collection, Equals, "schema")
+	c.Assert(m.Type, Equals, reflect.TypeOf(SchemaFixture{}))
+	c.Assert(m.Schema, Equals, Schema.SchemaFixture)
+}
+
+func (s *MongoSuite) TestRegistryFields(c *C) {
+	m := storable.DefaultRegistry.Lookup("SchemaFixture")
+
+	id := m.Field("_id")
+	c.Assert(id.Type, Equals, "bson.ObjectId")
+
+	f := m.Field("foo")
+	c.Assert(f.Name, Equals, "Int")
+	c.Assert(f.Type, Equals, "int")
+	c.Assert(f.Findable, Equals, true)
+
+	f = m.Field("inline")
+	c.Assert(f.Name, Equals, "Inline")
+	c.Assert(f.Type, Equals, "string")
+
+	f = m.Field("nested._id")
+	c.Assert(f.Name, Equals, "Id")
+
+	f = m.Field("mapofstring.[map]")
+	c.Assert(f.Map, Equals, true)
+	c.Assert(f.Type, Equals, "map[string]string")
+}
+
+func (s *MongoSuite) TestRegistryEvents(c *C) {
+	m := storable.DefaultRegistry.Model(&EventsSaveFixture{})
+	c.Assert(m.Events, DeepEquals, []string{"BeforeSave", "AfterSave"})
+}
@@ -0,0 +1,29 @@
+package tests
+
+import (
+	"gopkg.in/src-d/storable.v1"
+	"gopkg.in/src-d/storable.v1/operators"
+)
+
+type ScopesFixture struct {
+	storable.Document `bson:",inline" collection:"scopes"`
+	Name              string
+	Price             float64
+	Published         bool
+	Deleted           bool
+}
+
+//storable:scope default
+func (q *ScopesFixtureQuery) scopeNotDeleted() {
+	q.AddCriteria(operators.Eq(Schema.ScopesFixture.Deleted, false))
+	q.Sort(storable.Sort{{Schema.ScopesFixture.Price, storable.Asc}})
+}
+
+func (q *ScopesFixtureQuery) scopePublished() {
+	q.AddCriteria(operators.Eq(Schema.ScopesFixture.Published, true))
+}
+
+//storable:scope
+func (q *ScopesFixtureQuery) cheaperThan(price float64) {
+	q.AddCriteria(operators.Lt(Schema.ScopesFixture.Price, price))
+}
@@ -0,0 +1,59 @@
+package tests
+
+import (
+	. "gopkg.in/check.v1"
+	"gopkg.in/src-d/storable.v1"
+)
+
+func (s *MongoSuite) insertScopesFixtures(c *C) *ScopesFixtureStore {
+	store := NewScopesFixtureStore(s.db)
+	for _, doc := range []*ScopesFixture{
+		{Name: "foo", Price: 30, Published: true},
+		{Name: "bar", Price: 10, Published: true},
+		{Name: "baz", Price: 5, Published: false},
+		{Name: "qux", Price: 1, Published: true, Deleted: true},
+	} {
+		doc.SetIsNew(true)
+		c.Assert(store.Insert(doc), IsNil)
+	}
+
+	return store
+}
+
+func (s *MongoSuite) TestScopes(c *C) {
+	store := s.insertScopesFixtures(c)
+
+	docs, err := store.MustFind(store.Query().Published().CheaperThan(20)).All()
+	c.Assert(err, IsNil)
+	c.Assert(docs, HasLen, 1)
+	c.Assert(docs[0].Name, Equals, "bar")
+}
+
+func (s *MongoSuite) TestScopesDefault(c *C) {
+	store := s.insertScopesFixtures(c)
+
+	docs, err := store.MustFind(store.Query()).All()
+	c.Assert(err, IsNil)
+	c.Assert(docs, HasLen, 3)
+	c.Assert(docs[0].Name, Equals, "baz")
+
+	q := store.Query().CheaperThan(20)
+	q.Sort(storable.Sort{{Schema.ScopesFixture.Price, storable.Desc}})
+
+	docs, err = store.MustFind(q).All()
+	c.Assert(err, IsNil)
+	c.Assert(docs, HasLen, 2)
+	c.Assert(docs[0].Name, Equals, "bar")
+}
+
+func (s *MongoSuite) TestScopesUnscoped(c *C) {
+	store := s.insertScopesFixtures(c)
+
+	count, err := store.Count(store.Query().Unscoped().Published())
+	c.Assert(err, IsNil)
+	c.Assert(count, Equals, 3)
+
+	count, err = store.Count(store.Query().Published())
+	c.Assert(err, IsNil)
+	c.Assert(count, Equals, 2)
+}
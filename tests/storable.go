@@ -1,23 +1,41 @@
 package tests
 
 import (
+	"context"
+	"reflect"
+	"time"
+
 	"gopkg.in/mgo.v2"
 	"gopkg.in/mgo.v2/bson"
 	"gopkg.in/src-d/storable.v1"
 	"gopkg.in/src-d/storable.v1/operators"
 )
 
-type EventsFixtureStore struct {
+type BitsFixtureStore struct {
 	storable.Store
 }
 
-func NewEventsFixtureStore(db *mgo.Database) *EventsFixtureStore {
-	return &EventsFixtureStore{*storable.NewStore(db, "event")}
+func NewBitsFixtureStore(db *mgo.Database) *BitsFixtureStore {
+	s := &BitsFixtureStore{*storable.NewStore(db, "query")}
+	s.SetDefaultKind("BitsFixture")
+	return s
 }
 
-// New returns a new instance of EventsFixture.
-func (s *EventsFixtureStore) New() (doc *EventsFixture) {
-	doc = newEventsFixture()
+// WithContext returns a copy of the store using the given context, see
+// storable.Store.WithContext.
+func (s *BitsFixtureStore) WithContext(ctx context.Context) *BitsFixtureStore {
+	return &BitsFixtureStore{*s.Store.WithContext(ctx)}
+}
+
+// InTx returns a copy of the store running its operations, including the
+// hooks, inside the given transaction. See storable.WithTransaction.
+func (s *BitsFixtureStore) InTx(tx *storable.Tx) *BitsFixtureStore {
+	return &BitsFixtureStore{*s.Store.InTx(tx)}
+}
+
+// New returns a new instance of BitsFixture.
+func (s *BitsFixtureStore) New() (doc *BitsFixture) {
+	doc = &BitsFixture{}
 	if doc != nil {
 		doc.SetIsNew(true)
 		doc.SetId(bson.NewObjectId())
@@ -25,30 +43,41 @@ func (s *EventsFixtureStore) New() (doc *EventsFixture) {
 	return
 }
 
-// Query return a new instance of EventsFixtureQuery.
-func (s *EventsFixtureStore) Query() *EventsFixtureQuery {
-	return &EventsFixtureQuery{*storable.NewBaseQuery()}
+// Query return a new instance of BitsFixtureQuery.
+func (s *BitsFixtureStore) Query() *BitsFixtureQuery {
+	return &BitsFixtureQuery{*storable.NewBaseQuery()}
 }
 
 // Find performs a find on the collection using the given query.
-func (s *EventsFixtureStore) Find(query *EventsFixtureQuery) (*EventsFixtureResultSet, error) {
+func (s *BitsFixtureStore) Find(query *BitsFixtureQuery) (*BitsFixtureResultSet, error) {
 	resultSet, err := s.Store.Find(query)
 	if err != nil {
 		return nil, err
 	}
 
-	return &EventsFixtureResultSet{ResultSet: *resultSet}, nil
+	return &BitsFixtureResultSet{ResultSet: *resultSet}, nil
 }
 
 // MustFind like Find but panics on error
-func (s *EventsFixtureStore) MustFind(query *EventsFixtureQuery) *EventsFixtureResultSet {
+func (s *BitsFixtureStore) MustFind(query *BitsFixtureQuery) *BitsFixtureResultSet {
 	resultSet := s.Store.MustFind(query)
-	return &EventsFixtureResultSet{ResultSet: *resultSet}
+	return &BitsFixtureResultSet{ResultSet: *resultSet}
+}
+
+// Tail performs a find on the capped collection using the given query,
+// waiting for new documents up to the given timeout. See storable.Store.Tail.
+func (s *BitsFixtureStore) Tail(query *BitsFixtureQuery, timeout time.Duration) (*BitsFixtureResultSet, error) {
+	resultSet, err := s.Store.Tail(query, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BitsFixtureResultSet{ResultSet: *resultSet}, nil
 }
 
 // FindOne performs a find on the collection using the given query returning
 // the first document from the resultset.
-func (s *EventsFixtureStore) FindOne(query *EventsFixtureQuery) (*EventsFixture, error) {
+func (s *BitsFixtureStore) FindOne(query *BitsFixtureQuery) (*BitsFixture, error) {
 	resultSet, err := s.Find(query)
 	if err != nil {
 		return nil, err
@@ -58,7 +87,7 @@ func (s *EventsFixtureStore) FindOne(query *EventsFixtureQuery) (*EventsFixture,
 }
 
 // MustFindOne like FindOne but panics on error
-func (s *EventsFixtureStore) MustFindOne(query *EventsFixtureQuery) *EventsFixture {
+func (s *BitsFixtureStore) MustFindOne(query *BitsFixtureQuery) *BitsFixture {
 	doc, err := s.FindOne(query)
 	if err != nil {
 		panic(err)
@@ -67,76 +96,71 @@ func (s *EventsFixtureStore) MustFindOne(query *EventsFixtureQuery) *EventsFixtu
 	return doc
 }
 
+// FindAndModify atomically modifies the first document matching the given
+// query and returns it, before the change or after it if change.ReturnNew is
+// set. See storable.Store.FindAndModify.
+func (s *BitsFixtureStore) FindAndModify(query *BitsFixtureQuery, change mgo.Change) (*BitsFixture, error) {
+	var result *BitsFixture
+	_, err := s.Store.FindAndModify(query, change, &result)
+
+	return result, err
+}
+
 // Insert insert the given document on the collection, trigger BeforeInsert and
 // AfterInsert if any. Throws ErrNonNewDocument if doc is a non-new document.
-func (s *EventsFixtureStore) Insert(doc *EventsFixture) error {
-	if err := s.BeforeInsert(doc); err != nil {
-		return err
-	}
+func (s *BitsFixtureStore) Insert(doc *BitsFixture) error {
 
 	err := s.Store.Insert(doc)
 	if err != nil {
 		return err
 	}
 
-	return s.AfterInsert(doc)
+	return nil
 }
 
 // Update update the given document on the collection, trigger BeforeUpdate and
 // AfterUpdate if any. Throws ErrNewDocument if doc is a new document.
-func (s *EventsFixtureStore) Update(doc *EventsFixture) error {
-	if err := s.BeforeUpdate(doc); err != nil {
-		return err
-	}
+func (s *BitsFixtureStore) Update(doc *BitsFixture) error {
 
 	err := s.Store.Update(doc)
 	if err != nil {
 		return err
 	}
 
-	return s.AfterUpdate(doc)
+	return nil
+}
+
+// UpdateDiff update the given document on the collection writing only the
+// changes from old, trigger BeforeUpdate and AfterUpdate if any. See
+// storable.Store.UpdateDiff.
+func (s *BitsFixtureStore) UpdateDiff(old, doc *BitsFixture) (storable.Changes, error) {
+
+	changes, err := s.Store.UpdateDiff(old, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
 }
 
 // Save insert or update the given document on the collection using Upsert,
 // trigger BeforeUpdate and AfterUpdate if the document is non-new and
 // BeforeInsert and AfterInset if is new.
-func (s *EventsFixtureStore) Save(doc *EventsFixture) (updated bool, err error) {
-	switch doc.IsNew() {
-	case true:
-		if err := s.BeforeInsert(doc); err != nil {
-			return false, err
-		}
-	case false:
-		if err := s.BeforeUpdate(doc); err != nil {
-			return false, err
-		}
-	}
-
+func (s *BitsFixtureStore) Save(doc *BitsFixture) (updated bool, err error) {
 	updated, err = s.Store.Save(doc)
 	if err != nil {
 		return false, err
 	}
 
-	switch updated {
-	case false:
-		if err := s.AfterInsert(doc); err != nil {
-			return false, err
-		}
-	case true:
-		if err := s.AfterUpdate(doc); err != nil {
-			return false, err
-		}
-	}
-
 	return
 }
 
-type EventsFixtureQuery struct {
+type BitsFixtureQuery struct {
 	storable.BaseQuery
 }
 
 // FindById add a new criteria to the query searching by _id
-func (q *EventsFixtureQuery) FindById(ids ...bson.ObjectId) *EventsFixtureQuery {
+func (q *BitsFixtureQuery) FindById(ids ...bson.ObjectId) *BitsFixtureQuery {
 	var vs []interface{}
 	for _, id := range ids {
 		vs = append(vs, id)
@@ -146,30 +170,35 @@ func (q *EventsFixtureQuery) FindById(ids ...bson.ObjectId) *EventsFixtureQuery
 	return q
 }
 
-type EventsFixtureResultSet struct {
+// Clone returns a copy of the query, see storable.BaseQuery.Clone.
+func (q *BitsFixtureQuery) Clone() *BitsFixtureQuery {
+	return &BitsFixtureQuery{*q.BaseQuery.Clone()}
+}
+
+type BitsFixtureResultSet struct {
 	storable.ResultSet
-	last    *EventsFixture
+	last    *BitsFixture
 	lastErr error
 }
 
 // All returns all documents on the resultset and close the resultset
-func (r *EventsFixtureResultSet) All() ([]*EventsFixture, error) {
-	var result []*EventsFixture
+func (r *BitsFixtureResultSet) All() ([]*BitsFixture, error) {
+	var result []*BitsFixture
 	err := r.ResultSet.All(&result)
 
 	return result, err
 }
 
 // One returns the first document on the resultset and close the resultset
-func (r *EventsFixtureResultSet) One() (*EventsFixture, error) {
-	var result *EventsFixture
+func (r *BitsFixtureResultSet) One() (*BitsFixture, error) {
+	var result *BitsFixture
 	err := r.ResultSet.One(&result)
 
 	return result, err
 }
 
 // Next prepares the next result document for reading with the Get method.
-func (r *EventsFixtureResultSet) Next() (returned bool) {
+func (r *BitsFixtureResultSet) Next() (returned bool) {
 	r.last = nil
 	returned, r.lastErr = r.ResultSet.Next(&r.last)
 
@@ -177,14 +206,14 @@ func (r *EventsFixtureResultSet) Next() (returned bool) {
 }
 
 // Get returns the document retrieved with the Next method.
-func (r *EventsFixtureResultSet) Get() (*EventsFixture, error) {
+func (r *BitsFixtureResultSet) Get() (*BitsFixture, error) {
 	return r.last, r.lastErr
 }
 
 // ForEach iterates the resultset calling to the given function.
-func (r *EventsFixtureResultSet) ForEach(f func(*EventsFixture) error) error {
+func (r *BitsFixtureResultSet) ForEach(f func(*BitsFixture) error) error {
 	for {
-		var result *EventsFixture
+		var result *BitsFixture
 		found, err := r.ResultSet.Next(&result)
 		if err != nil {
 			return err
@@ -207,17 +236,93 @@ func (r *EventsFixtureResultSet) ForEach(f func(*EventsFixture) error) error {
 	return nil
 }
 
-type EventsSaveFixtureStore struct {
+// Watch returns a stream with the changes on the documents matching the given
+// query, the current version of the document is retrieved on every update.
+// See storable.Store.Watch.
+func (s *BitsFixtureStore) Watch(query *BitsFixtureQuery, opts storable.WatchOptions) (*BitsFixtureChangeStream, error) {
+	opts.FullDocument = true
+	cs, err := s.Store.Watch(query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BitsFixtureChangeStream{ChangeStream: cs}, nil
+}
+
+type BitsFixtureChangeStream struct {
+	*storable.ChangeStream
+	event   storable.ChangeEvent
+	last    *BitsFixture
+	lastErr error
+}
+
+// Next waits for the next event, to be read with the Get method. Returns
+// false when the stream is closed or fails.
+func (cs *BitsFixtureChangeStream) Next() (returned bool) {
+	cs.event, cs.last, cs.lastErr = storable.ChangeEvent{}, nil, nil
+	if !cs.ChangeStream.Next(&cs.event) {
+		cs.lastErr = cs.ChangeStream.Err()
+		return false
+	}
+
+	if cs.event.HasDocument() {
+		cs.lastErr = cs.event.Decode(&cs.last)
+	}
+
+	return true
+}
+
+// Get returns the event and the document retrieved with the Next method, the
+// document is nil on the deletes or if it does not exist anymore.
+func (cs *BitsFixtureChangeStream) Get() (*storable.ChangeEvent, *BitsFixture, error) {
+	return &cs.event, cs.last, cs.lastErr
+}
+
+// ForEach waits for the events calling to the given function, until the
+// stream is closed or storable.ErrStop is returned.
+func (cs *BitsFixtureChangeStream) ForEach(f func(*storable.ChangeEvent, *BitsFixture) error) error {
+	for cs.Next() {
+		event, doc, err := cs.Get()
+		if err != nil {
+			return err
+		}
+
+		err = f(event, doc)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return cs.lastErr
+}
+
+type CappedFixtureStore struct {
 	storable.Store
 }
 
-func NewEventsSaveFixtureStore(db *mgo.Database) *EventsSaveFixtureStore {
-	return &EventsSaveFixtureStore{*storable.NewStore(db, "event")}
+func NewCappedFixtureStore(db *mgo.Database) *CappedFixtureStore {
+	return &CappedFixtureStore{*storable.NewStore(db, "capped")}
 }
 
-// New returns a new instance of EventsSaveFixture.
-func (s *EventsSaveFixtureStore) New() (doc *EventsSaveFixture) {
-	doc = newEventsSaveFixture()
+// WithContext returns a copy of the store using the given context, see
+// storable.Store.WithContext.
+func (s *CappedFixtureStore) WithContext(ctx context.Context) *CappedFixtureStore {
+	return &CappedFixtureStore{*s.Store.WithContext(ctx)}
+}
+
+// InTx returns a copy of the store running its operations, including the
+// hooks, inside the given transaction. See storable.WithTransaction.
+func (s *CappedFixtureStore) InTx(tx *storable.Tx) *CappedFixtureStore {
+	return &CappedFixtureStore{*s.Store.InTx(tx)}
+}
+
+// New returns a new instance of CappedFixture.
+func (s *CappedFixtureStore) New() (doc *CappedFixture) {
+	doc = &CappedFixture{}
 	if doc != nil {
 		doc.SetIsNew(true)
 		doc.SetId(bson.NewObjectId())
@@ -225,30 +330,41 @@ func (s *EventsSaveFixtureStore) New() (doc *EventsSaveFixture) {
 	return
 }
 
-// Query return a new instance of EventsSaveFixtureQuery.
-func (s *EventsSaveFixtureStore) Query() *EventsSaveFixtureQuery {
-	return &EventsSaveFixtureQuery{*storable.NewBaseQuery()}
+// Query return a new instance of CappedFixtureQuery.
+func (s *CappedFixtureStore) Query() *CappedFixtureQuery {
+	return &CappedFixtureQuery{*storable.NewBaseQuery()}
 }
 
 // Find performs a find on the collection using the given query.
-func (s *EventsSaveFixtureStore) Find(query *EventsSaveFixtureQuery) (*EventsSaveFixtureResultSet, error) {
+func (s *CappedFixtureStore) Find(query *CappedFixtureQuery) (*CappedFixtureResultSet, error) {
 	resultSet, err := s.Store.Find(query)
 	if err != nil {
 		return nil, err
 	}
 
-	return &EventsSaveFixtureResultSet{ResultSet: *resultSet}, nil
+	return &CappedFixtureResultSet{ResultSet: *resultSet}, nil
 }
 
 // MustFind like Find but panics on error
-func (s *EventsSaveFixtureStore) MustFind(query *EventsSaveFixtureQuery) *EventsSaveFixtureResultSet {
+func (s *CappedFixtureStore) MustFind(query *CappedFixtureQuery) *CappedFixtureResultSet {
 	resultSet := s.Store.MustFind(query)
-	return &EventsSaveFixtureResultSet{ResultSet: *resultSet}
+	return &CappedFixtureResultSet{ResultSet: *resultSet}
+}
+
+// Tail performs a find on the capped collection using the given query,
+// waiting for new documents up to the given timeout. See storable.Store.Tail.
+func (s *CappedFixtureStore) Tail(query *CappedFixtureQuery, timeout time.Duration) (*CappedFixtureResultSet, error) {
+	resultSet, err := s.Store.Tail(query, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CappedFixtureResultSet{ResultSet: *resultSet}, nil
 }
 
 // FindOne performs a find on the collection using the given query returning
 // the first document from the resultset.
-func (s *EventsSaveFixtureStore) FindOne(query *EventsSaveFixtureQuery) (*EventsSaveFixture, error) {
+func (s *CappedFixtureStore) FindOne(query *CappedFixtureQuery) (*CappedFixture, error) {
 	resultSet, err := s.Find(query)
 	if err != nil {
 		return nil, err
@@ -258,7 +374,7 @@ func (s *EventsSaveFixtureStore) FindOne(query *EventsSaveFixtureQuery) (*Events
 }
 
 // MustFindOne like FindOne but panics on error
-func (s *EventsSaveFixtureStore) MustFindOne(query *EventsSaveFixtureQuery) *EventsSaveFixture {
+func (s *CappedFixtureStore) MustFindOne(query *CappedFixtureQuery) *CappedFixture {
 	doc, err := s.FindOne(query)
 	if err != nil {
 		panic(err)
@@ -267,61 +383,71 @@ func (s *EventsSaveFixtureStore) MustFindOne(query *EventsSaveFixtureQuery) *Eve
 	return doc
 }
 
+// FindAndModify atomically modifies the first document matching the given
+// query and returns it, before the change or after it if change.ReturnNew is
+// set. See storable.Store.FindAndModify.
+func (s *CappedFixtureStore) FindAndModify(query *CappedFixtureQuery, change mgo.Change) (*CappedFixture, error) {
+	var result *CappedFixture
+	_, err := s.Store.FindAndModify(query, change, &result)
+
+	return result, err
+}
+
 // Insert insert the given document on the collection, trigger BeforeInsert and
 // AfterInsert if any. Throws ErrNonNewDocument if doc is a non-new document.
-func (s *EventsSaveFixtureStore) Insert(doc *EventsSaveFixture) error {
-	if err := s.BeforeSave(doc); err != nil {
-		return err
-	}
+func (s *CappedFixtureStore) Insert(doc *CappedFixture) error {
 
 	err := s.Store.Insert(doc)
 	if err != nil {
 		return err
 	}
 
-	return s.AfterSave(doc)
+	return nil
 }
 
 // Update update the given document on the collection, trigger BeforeUpdate and
 // AfterUpdate if any. Throws ErrNewDocument if doc is a new document.
-func (s *EventsSaveFixtureStore) Update(doc *EventsSaveFixture) error {
-	if err := s.BeforeSave(doc); err != nil {
-		return err
-	}
+func (s *CappedFixtureStore) Update(doc *CappedFixture) error {
 
 	err := s.Store.Update(doc)
 	if err != nil {
 		return err
 	}
 
-	return s.AfterSave(doc)
+	return nil
+}
+
+// UpdateDiff update the given document on the collection writing only the
+// changes from old, trigger BeforeUpdate and AfterUpdate if any. See
+// storable.Store.UpdateDiff.
+func (s *CappedFixtureStore) UpdateDiff(old, doc *CappedFixture) (storable.Changes, error) {
+
+	changes, err := s.Store.UpdateDiff(old, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
 }
 
 // Save insert or update the given document on the collection using Upsert,
 // trigger BeforeUpdate and AfterUpdate if the document is non-new and
 // BeforeInsert and AfterInset if is new.
-func (s *EventsSaveFixtureStore) Save(doc *EventsSaveFixture) (updated bool, err error) {
-	if err := s.BeforeSave(doc); err != nil {
-		return false, err
-	}
-
+func (s *CappedFixtureStore) Save(doc *CappedFixture) (updated bool, err error) {
 	updated, err = s.Store.Save(doc)
 	if err != nil {
 		return false, err
 	}
 
-	if err := s.AfterSave(doc); err != nil {
-		return false, err
-	}
 	return
 }
 
-type EventsSaveFixtureQuery struct {
+type CappedFixtureQuery struct {
 	storable.BaseQuery
 }
 
 // FindById add a new criteria to the query searching by _id
-func (q *EventsSaveFixtureQuery) FindById(ids ...bson.ObjectId) *EventsSaveFixtureQuery {
+func (q *CappedFixtureQuery) FindById(ids ...bson.ObjectId) *CappedFixtureQuery {
 	var vs []interface{}
 	for _, id := range ids {
 		vs = append(vs, id)
@@ -331,30 +457,35 @@ func (q *EventsSaveFixtureQuery) FindById(ids ...bson.ObjectId) *EventsSaveFixtu
 	return q
 }
 
-type EventsSaveFixtureResultSet struct {
+// Clone returns a copy of the query, see storable.BaseQuery.Clone.
+func (q *CappedFixtureQuery) Clone() *CappedFixtureQuery {
+	return &CappedFixtureQuery{*q.BaseQuery.Clone()}
+}
+
+type CappedFixtureResultSet struct {
 	storable.ResultSet
-	last    *EventsSaveFixture
+	last    *CappedFixture
 	lastErr error
 }
 
 // All returns all documents on the resultset and close the resultset
-func (r *EventsSaveFixtureResultSet) All() ([]*EventsSaveFixture, error) {
-	var result []*EventsSaveFixture
+func (r *CappedFixtureResultSet) All() ([]*CappedFixture, error) {
+	var result []*CappedFixture
 	err := r.ResultSet.All(&result)
 
 	return result, err
 }
 
 // One returns the first document on the resultset and close the resultset
-func (r *EventsSaveFixtureResultSet) One() (*EventsSaveFixture, error) {
-	var result *EventsSaveFixture
+func (r *CappedFixtureResultSet) One() (*CappedFixture, error) {
+	var result *CappedFixture
 	err := r.ResultSet.One(&result)
 
 	return result, err
 }
 
 // Next prepares the next result document for reading with the Get method.
-func (r *EventsSaveFixtureResultSet) Next() (returned bool) {
+func (r *CappedFixtureResultSet) Next() (returned bool) {
 	r.last = nil
 	returned, r.lastErr = r.ResultSet.Next(&r.last)
 
@@ -362,14 +493,14 @@ func (r *EventsSaveFixtureResultSet) Next() (returned bool) {
 }
 
 // Get returns the document retrieved with the Next method.
-func (r *EventsSaveFixtureResultSet) Get() (*EventsSaveFixture, error) {
+func (r *CappedFixtureResultSet) Get() (*CappedFixture, error) {
 	return r.last, r.lastErr
 }
 
 // ForEach iterates the resultset calling to the given function.
-func (r *EventsSaveFixtureResultSet) ForEach(f func(*EventsSaveFixture) error) error {
+func (r *CappedFixtureResultSet) ForEach(f func(*CappedFixture) error) error {
 	for {
-		var result *EventsSaveFixture
+		var result *CappedFixture
 		found, err := r.ResultSet.Next(&result)
 		if err != nil {
 			return err
@@ -392,17 +523,93 @@ func (r *EventsSaveFixtureResultSet) ForEach(f func(*EventsSaveFixture) error) e
 	return nil
 }
 
-type MultiKeySortFixtureStore struct {
+// Watch returns a stream with the changes on the documents matching the given
+// query, the current version of the document is retrieved on every update.
+// See storable.Store.Watch.
+func (s *CappedFixtureStore) Watch(query *CappedFixtureQuery, opts storable.WatchOptions) (*CappedFixtureChangeStream, error) {
+	opts.FullDocument = true
+	cs, err := s.Store.Watch(query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CappedFixtureChangeStream{ChangeStream: cs}, nil
+}
+
+type CappedFixtureChangeStream struct {
+	*storable.ChangeStream
+	event   storable.ChangeEvent
+	last    *CappedFixture
+	lastErr error
+}
+
+// Next waits for the next event, to be read with the Get method. Returns
+// false when the stream is closed or fails.
+func (cs *CappedFixtureChangeStream) Next() (returned bool) {
+	cs.event, cs.last, cs.lastErr = storable.ChangeEvent{}, nil, nil
+	if !cs.ChangeStream.Next(&cs.event) {
+		cs.lastErr = cs.ChangeStream.Err()
+		return false
+	}
+
+	if cs.event.HasDocument() {
+		cs.lastErr = cs.event.Decode(&cs.last)
+	}
+
+	return true
+}
+
+// Get returns the event and the document retrieved with the Next method, the
+// document is nil on the deletes or if it does not exist anymore.
+func (cs *CappedFixtureChangeStream) Get() (*storable.ChangeEvent, *CappedFixture, error) {
+	return &cs.event, cs.last, cs.lastErr
+}
+
+// ForEach waits for the events calling to the given function, until the
+// stream is closed or storable.ErrStop is returned.
+func (cs *CappedFixtureChangeStream) ForEach(f func(*storable.ChangeEvent, *CappedFixture) error) error {
+	for cs.Next() {
+		event, doc, err := cs.Get()
+		if err != nil {
+			return err
+		}
+
+		err = f(event, doc)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return cs.lastErr
+}
+
+type DiffFixtureStore struct {
 	storable.Store
 }
 
-func NewMultiKeySortFixtureStore(db *mgo.Database) *MultiKeySortFixtureStore {
-	return &MultiKeySortFixtureStore{*storable.NewStore(db, "query")}
+func NewDiffFixtureStore(db *mgo.Database) *DiffFixtureStore {
+	return &DiffFixtureStore{*storable.NewStore(db, "diff")}
 }
 
-// New returns a new instance of MultiKeySortFixture.
-func (s *MultiKeySortFixtureStore) New() (doc *MultiKeySortFixture) {
-	doc = &MultiKeySortFixture{}
+// WithContext returns a copy of the store using the given context, see
+// storable.Store.WithContext.
+func (s *DiffFixtureStore) WithContext(ctx context.Context) *DiffFixtureStore {
+	return &DiffFixtureStore{*s.Store.WithContext(ctx)}
+}
+
+// InTx returns a copy of the store running its operations, including the
+// hooks, inside the given transaction. See storable.WithTransaction.
+func (s *DiffFixtureStore) InTx(tx *storable.Tx) *DiffFixtureStore {
+	return &DiffFixtureStore{*s.Store.InTx(tx)}
+}
+
+// New returns a new instance of DiffFixture.
+func (s *DiffFixtureStore) New() (doc *DiffFixture) {
+	doc = newDiffFixture()
 	if doc != nil {
 		doc.SetIsNew(true)
 		doc.SetId(bson.NewObjectId())
@@ -410,30 +617,41 @@ func (s *MultiKeySortFixtureStore) New() (doc *MultiKeySortFixture) {
 	return
 }
 
-// Query return a new instance of MultiKeySortFixtureQuery.
-func (s *MultiKeySortFixtureStore) Query() *MultiKeySortFixtureQuery {
-	return &MultiKeySortFixtureQuery{*storable.NewBaseQuery()}
+// Query return a new instance of DiffFixtureQuery.
+func (s *DiffFixtureStore) Query() *DiffFixtureQuery {
+	return &DiffFixtureQuery{*storable.NewBaseQuery()}
 }
 
 // Find performs a find on the collection using the given query.
-func (s *MultiKeySortFixtureStore) Find(query *MultiKeySortFixtureQuery) (*MultiKeySortFixtureResultSet, error) {
+func (s *DiffFixtureStore) Find(query *DiffFixtureQuery) (*DiffFixtureResultSet, error) {
 	resultSet, err := s.Store.Find(query)
 	if err != nil {
 		return nil, err
 	}
 
-	return &MultiKeySortFixtureResultSet{ResultSet: *resultSet}, nil
+	return &DiffFixtureResultSet{ResultSet: *resultSet}, nil
 }
 
 // MustFind like Find but panics on error
-func (s *MultiKeySortFixtureStore) MustFind(query *MultiKeySortFixtureQuery) *MultiKeySortFixtureResultSet {
+func (s *DiffFixtureStore) MustFind(query *DiffFixtureQuery) *DiffFixtureResultSet {
 	resultSet := s.Store.MustFind(query)
-	return &MultiKeySortFixtureResultSet{ResultSet: *resultSet}
+	return &DiffFixtureResultSet{ResultSet: *resultSet}
+}
+
+// Tail performs a find on the capped collection using the given query,
+// waiting for new documents up to the given timeout. See storable.Store.Tail.
+func (s *DiffFixtureStore) Tail(query *DiffFixtureQuery, timeout time.Duration) (*DiffFixtureResultSet, error) {
+	resultSet, err := s.Store.Tail(query, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DiffFixtureResultSet{ResultSet: *resultSet}, nil
 }
 
 // FindOne performs a find on the collection using the given query returning
 // the first document from the resultset.
-func (s *MultiKeySortFixtureStore) FindOne(query *MultiKeySortFixtureQuery) (*MultiKeySortFixture, error) {
+func (s *DiffFixtureStore) FindOne(query *DiffFixtureQuery) (*DiffFixture, error) {
 	resultSet, err := s.Find(query)
 	if err != nil {
 		return nil, err
@@ -443,7 +661,7 @@ func (s *MultiKeySortFixtureStore) FindOne(query *MultiKeySortFixtureQuery) (*Mu
 }
 
 // MustFindOne like FindOne but panics on error
-func (s *MultiKeySortFixtureStore) MustFindOne(query *MultiKeySortFixtureQuery) *MultiKeySortFixture {
+func (s *DiffFixtureStore) MustFindOne(query *DiffFixtureQuery) *DiffFixture {
 	doc, err := s.FindOne(query)
 	if err != nil {
 		panic(err)
@@ -452,9 +670,19 @@ func (s *MultiKeySortFixtureStore) MustFindOne(query *MultiKeySortFixtureQuery)
 	return doc
 }
 
+// FindAndModify atomically modifies the first document matching the given
+// query and returns it, before the change or after it if change.ReturnNew is
+// set. See storable.Store.FindAndModify.
+func (s *DiffFixtureStore) FindAndModify(query *DiffFixtureQuery, change mgo.Change) (*DiffFixture, error) {
+	var result *DiffFixture
+	_, err := s.Store.FindAndModify(query, change, &result)
+
+	return result, err
+}
+
 // Insert insert the given document on the collection, trigger BeforeInsert and
 // AfterInsert if any. Throws ErrNonNewDocument if doc is a non-new document.
-func (s *MultiKeySortFixtureStore) Insert(doc *MultiKeySortFixture) error {
+func (s *DiffFixtureStore) Insert(doc *DiffFixture) error {
 
 	err := s.Store.Insert(doc)
 	if err != nil {
@@ -466,7 +694,7 @@ func (s *MultiKeySortFixtureStore) Insert(doc *MultiKeySortFixture) error {
 
 // Update update the given document on the collection, trigger BeforeUpdate and
 // AfterUpdate if any. Throws ErrNewDocument if doc is a new document.
-func (s *MultiKeySortFixtureStore) Update(doc *MultiKeySortFixture) error {
+func (s *DiffFixtureStore) Update(doc *DiffFixture) error {
 
 	err := s.Store.Update(doc)
 	if err != nil {
@@ -476,10 +704,23 @@ func (s *MultiKeySortFixtureStore) Update(doc *MultiKeySortFixture) error {
 	return nil
 }
 
+// UpdateDiff update the given document on the collection writing only the
+// changes from old, trigger BeforeUpdate and AfterUpdate if any. See
+// storable.Store.UpdateDiff.
+func (s *DiffFixtureStore) UpdateDiff(old, doc *DiffFixture) (storable.Changes, error) {
+
+	changes, err := s.Store.UpdateDiff(old, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
 // Save insert or update the given document on the collection using Upsert,
 // trigger BeforeUpdate and AfterUpdate if the document is non-new and
 // BeforeInsert and AfterInset if is new.
-func (s *MultiKeySortFixtureStore) Save(doc *MultiKeySortFixture) (updated bool, err error) {
+func (s *DiffFixtureStore) Save(doc *DiffFixture) (updated bool, err error) {
 	updated, err = s.Store.Save(doc)
 	if err != nil {
 		return false, err
@@ -488,12 +729,12 @@ func (s *MultiKeySortFixtureStore) Save(doc *MultiKeySortFixture) (updated bool,
 	return
 }
 
-type MultiKeySortFixtureQuery struct {
+type DiffFixtureQuery struct {
 	storable.BaseQuery
 }
 
 // FindById add a new criteria to the query searching by _id
-func (q *MultiKeySortFixtureQuery) FindById(ids ...bson.ObjectId) *MultiKeySortFixtureQuery {
+func (q *DiffFixtureQuery) FindById(ids ...bson.ObjectId) *DiffFixtureQuery {
 	var vs []interface{}
 	for _, id := range ids {
 		vs = append(vs, id)
@@ -503,30 +744,35 @@ func (q *MultiKeySortFixtureQuery) FindById(ids ...bson.ObjectId) *MultiKeySortF
 	return q
 }
 
-type MultiKeySortFixtureResultSet struct {
+// Clone returns a copy of the query, see storable.BaseQuery.Clone.
+func (q *DiffFixtureQuery) Clone() *DiffFixtureQuery {
+	return &DiffFixtureQuery{*q.BaseQuery.Clone()}
+}
+
+type DiffFixtureResultSet struct {
 	storable.ResultSet
-	last    *MultiKeySortFixture
+	last    *DiffFixture
 	lastErr error
 }
 
 // All returns all documents on the resultset and close the resultset
-func (r *MultiKeySortFixtureResultSet) All() ([]*MultiKeySortFixture, error) {
-	var result []*MultiKeySortFixture
+func (r *DiffFixtureResultSet) All() ([]*DiffFixture, error) {
+	var result []*DiffFixture
 	err := r.ResultSet.All(&result)
 
 	return result, err
 }
 
 // One returns the first document on the resultset and close the resultset
-func (r *MultiKeySortFixtureResultSet) One() (*MultiKeySortFixture, error) {
-	var result *MultiKeySortFixture
+func (r *DiffFixtureResultSet) One() (*DiffFixture, error) {
+	var result *DiffFixture
 	err := r.ResultSet.One(&result)
 
 	return result, err
 }
 
 // Next prepares the next result document for reading with the Get method.
-func (r *MultiKeySortFixtureResultSet) Next() (returned bool) {
+func (r *DiffFixtureResultSet) Next() (returned bool) {
 	r.last = nil
 	returned, r.lastErr = r.ResultSet.Next(&r.last)
 
@@ -534,14 +780,14 @@ func (r *MultiKeySortFixtureResultSet) Next() (returned bool) {
 }
 
 // Get returns the document retrieved with the Next method.
-func (r *MultiKeySortFixtureResultSet) Get() (*MultiKeySortFixture, error) {
+func (r *DiffFixtureResultSet) Get() (*DiffFixture, error) {
 	return r.last, r.lastErr
 }
 
 // ForEach iterates the resultset calling to the given function.
-func (r *MultiKeySortFixtureResultSet) ForEach(f func(*MultiKeySortFixture) error) error {
+func (r *DiffFixtureResultSet) ForEach(f func(*DiffFixture) error) error {
 	for {
-		var result *MultiKeySortFixture
+		var result *DiffFixture
 		found, err := r.ResultSet.Next(&result)
 		if err != nil {
 			return err
@@ -564,17 +810,95 @@ func (r *MultiKeySortFixtureResultSet) ForEach(f func(*MultiKeySortFixture) erro
 	return nil
 }
 
-type QueryFixtureStore struct {
+// Watch returns a stream with the changes on the documents matching the given
+// query, the current version of the document is retrieved on every update.
+// See storable.Store.Watch.
+func (s *DiffFixtureStore) Watch(query *DiffFixtureQuery, opts storable.WatchOptions) (*DiffFixtureChangeStream, error) {
+	opts.FullDocument = true
+	cs, err := s.Store.Watch(query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DiffFixtureChangeStream{ChangeStream: cs}, nil
+}
+
+type DiffFixtureChangeStream struct {
+	*storable.ChangeStream
+	event   storable.ChangeEvent
+	last    *DiffFixture
+	lastErr error
+}
+
+// Next waits for the next event, to be read with the Get method. Returns
+// false when the stream is closed or fails.
+func (cs *DiffFixtureChangeStream) Next() (returned bool) {
+	cs.event, cs.last, cs.lastErr = storable.ChangeEvent{}, nil, nil
+	if !cs.ChangeStream.Next(&cs.event) {
+		cs.lastErr = cs.ChangeStream.Err()
+		return false
+	}
+
+	if cs.event.HasDocument() {
+		cs.lastErr = cs.event.Decode(&cs.last)
+	}
+
+	return true
+}
+
+// Get returns the event and the document retrieved with the Next method, the
+// document is nil on the deletes or if it does not exist anymore.
+func (cs *DiffFixtureChangeStream) Get() (*storable.ChangeEvent, *DiffFixture, error) {
+	return &cs.event, cs.last, cs.lastErr
+}
+
+// ForEach waits for the events calling to the given function, until the
+// stream is closed or storable.ErrStop is returned.
+func (cs *DiffFixtureChangeStream) ForEach(f func(*storable.ChangeEvent, *DiffFixture) error) error {
+	for cs.Next() {
+		event, doc, err := cs.Get()
+		if err != nil {
+			return err
+		}
+
+		err = f(event, doc)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return cs.lastErr
+}
+
+type ElemMatchFixtureStore struct {
 	storable.Store
 }
 
-func NewQueryFixtureStore(db *mgo.Database) *QueryFixtureStore {
-	return &QueryFixtureStore{*storable.NewStore(db, "query")}
+func NewElemMatchFixtureStore(db *mgo.Database) *ElemMatchFixtureStore {
+	s := &ElemMatchFixtureStore{*storable.NewStore(db, "query")}
+	s.SetDefaultKind("ElemMatchFixture")
+	return s
 }
 
-// New returns a new instance of QueryFixture.
-func (s *QueryFixtureStore) New(f string) (doc *QueryFixture) {
-	doc = newQueryFixture(f)
+// WithContext returns a copy of the store using the given context, see
+// storable.Store.WithContext.
+func (s *ElemMatchFixtureStore) WithContext(ctx context.Context) *ElemMatchFixtureStore {
+	return &ElemMatchFixtureStore{*s.Store.WithContext(ctx)}
+}
+
+// InTx returns a copy of the store running its operations, including the
+// hooks, inside the given transaction. See storable.WithTransaction.
+func (s *ElemMatchFixtureStore) InTx(tx *storable.Tx) *ElemMatchFixtureStore {
+	return &ElemMatchFixtureStore{*s.Store.InTx(tx)}
+}
+
+// New returns a new instance of ElemMatchFixture.
+func (s *ElemMatchFixtureStore) New() (doc *ElemMatchFixture) {
+	doc = &ElemMatchFixture{}
 	if doc != nil {
 		doc.SetIsNew(true)
 		doc.SetId(bson.NewObjectId())
@@ -582,30 +906,41 @@ func (s *QueryFixtureStore) New(f string) (doc *QueryFixture) {
 	return
 }
 
-// Query return a new instance of QueryFixtureQuery.
-func (s *QueryFixtureStore) Query() *QueryFixtureQuery {
-	return &QueryFixtureQuery{*storable.NewBaseQuery()}
+// Query return a new instance of ElemMatchFixtureQuery.
+func (s *ElemMatchFixtureStore) Query() *ElemMatchFixtureQuery {
+	return &ElemMatchFixtureQuery{*storable.NewBaseQuery()}
 }
 
 // Find performs a find on the collection using the given query.
-func (s *QueryFixtureStore) Find(query *QueryFixtureQuery) (*QueryFixtureResultSet, error) {
+func (s *ElemMatchFixtureStore) Find(query *ElemMatchFixtureQuery) (*ElemMatchFixtureResultSet, error) {
 	resultSet, err := s.Store.Find(query)
 	if err != nil {
 		return nil, err
 	}
 
-	return &QueryFixtureResultSet{ResultSet: *resultSet}, nil
+	return &ElemMatchFixtureResultSet{ResultSet: *resultSet}, nil
 }
 
 // MustFind like Find but panics on error
-func (s *QueryFixtureStore) MustFind(query *QueryFixtureQuery) *QueryFixtureResultSet {
+func (s *ElemMatchFixtureStore) MustFind(query *ElemMatchFixtureQuery) *ElemMatchFixtureResultSet {
 	resultSet := s.Store.MustFind(query)
-	return &QueryFixtureResultSet{ResultSet: *resultSet}
+	return &ElemMatchFixtureResultSet{ResultSet: *resultSet}
+}
+
+// Tail performs a find on the capped collection using the given query,
+// waiting for new documents up to the given timeout. See storable.Store.Tail.
+func (s *ElemMatchFixtureStore) Tail(query *ElemMatchFixtureQuery, timeout time.Duration) (*ElemMatchFixtureResultSet, error) {
+	resultSet, err := s.Store.Tail(query, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ElemMatchFixtureResultSet{ResultSet: *resultSet}, nil
 }
 
 // FindOne performs a find on the collection using the given query returning
 // the first document from the resultset.
-func (s *QueryFixtureStore) FindOne(query *QueryFixtureQuery) (*QueryFixture, error) {
+func (s *ElemMatchFixtureStore) FindOne(query *ElemMatchFixtureQuery) (*ElemMatchFixture, error) {
 	resultSet, err := s.Find(query)
 	if err != nil {
 		return nil, err
@@ -615,7 +950,7 @@ func (s *QueryFixtureStore) FindOne(query *QueryFixtureQuery) (*QueryFixture, er
 }
 
 // MustFindOne like FindOne but panics on error
-func (s *QueryFixtureStore) MustFindOne(query *QueryFixtureQuery) *QueryFixture {
+func (s *ElemMatchFixtureStore) MustFindOne(query *ElemMatchFixtureQuery) *ElemMatchFixture {
 	doc, err := s.FindOne(query)
 	if err != nil {
 		panic(err)
@@ -624,9 +959,19 @@ func (s *QueryFixtureStore) MustFindOne(query *QueryFixtureQuery) *QueryFixture
 	return doc
 }
 
+// FindAndModify atomically modifies the first document matching the given
+// query and returns it, before the change or after it if change.ReturnNew is
+// set. See storable.Store.FindAndModify.
+func (s *ElemMatchFixtureStore) FindAndModify(query *ElemMatchFixtureQuery, change mgo.Change) (*ElemMatchFixture, error) {
+	var result *ElemMatchFixture
+	_, err := s.Store.FindAndModify(query, change, &result)
+
+	return result, err
+}
+
 // Insert insert the given document on the collection, trigger BeforeInsert and
 // AfterInsert if any. Throws ErrNonNewDocument if doc is a non-new document.
-func (s *QueryFixtureStore) Insert(doc *QueryFixture) error {
+func (s *ElemMatchFixtureStore) Insert(doc *ElemMatchFixture) error {
 
 	err := s.Store.Insert(doc)
 	if err != nil {
@@ -638,7 +983,7 @@ func (s *QueryFixtureStore) Insert(doc *QueryFixture) error {
 
 // Update update the given document on the collection, trigger BeforeUpdate and
 // AfterUpdate if any. Throws ErrNewDocument if doc is a new document.
-func (s *QueryFixtureStore) Update(doc *QueryFixture) error {
+func (s *ElemMatchFixtureStore) Update(doc *ElemMatchFixture) error {
 
 	err := s.Store.Update(doc)
 	if err != nil {
@@ -648,10 +993,23 @@ func (s *QueryFixtureStore) Update(doc *QueryFixture) error {
 	return nil
 }
 
+// UpdateDiff update the given document on the collection writing only the
+// changes from old, trigger BeforeUpdate and AfterUpdate if any. See
+// storable.Store.UpdateDiff.
+func (s *ElemMatchFixtureStore) UpdateDiff(old, doc *ElemMatchFixture) (storable.Changes, error) {
+
+	changes, err := s.Store.UpdateDiff(old, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
 // Save insert or update the given document on the collection using Upsert,
 // trigger BeforeUpdate and AfterUpdate if the document is non-new and
 // BeforeInsert and AfterInset if is new.
-func (s *QueryFixtureStore) Save(doc *QueryFixture) (updated bool, err error) {
+func (s *ElemMatchFixtureStore) Save(doc *ElemMatchFixture) (updated bool, err error) {
 	updated, err = s.Store.Save(doc)
 	if err != nil {
 		return false, err
@@ -660,12 +1018,12 @@ func (s *QueryFixtureStore) Save(doc *QueryFixture) (updated bool, err error) {
 	return
 }
 
-type QueryFixtureQuery struct {
+type ElemMatchFixtureQuery struct {
 	storable.BaseQuery
 }
 
 // FindById add a new criteria to the query searching by _id
-func (q *QueryFixtureQuery) FindById(ids ...bson.ObjectId) *QueryFixtureQuery {
+func (q *ElemMatchFixtureQuery) FindById(ids ...bson.ObjectId) *ElemMatchFixtureQuery {
 	var vs []interface{}
 	for _, id := range ids {
 		vs = append(vs, id)
@@ -675,30 +1033,35 @@ func (q *QueryFixtureQuery) FindById(ids ...bson.ObjectId) *QueryFixtureQuery {
 	return q
 }
 
-type QueryFixtureResultSet struct {
+// Clone returns a copy of the query, see storable.BaseQuery.Clone.
+func (q *ElemMatchFixtureQuery) Clone() *ElemMatchFixtureQuery {
+	return &ElemMatchFixtureQuery{*q.BaseQuery.Clone()}
+}
+
+type ElemMatchFixtureResultSet struct {
 	storable.ResultSet
-	last    *QueryFixture
+	last    *ElemMatchFixture
 	lastErr error
 }
 
 // All returns all documents on the resultset and close the resultset
-func (r *QueryFixtureResultSet) All() ([]*QueryFixture, error) {
-	var result []*QueryFixture
+func (r *ElemMatchFixtureResultSet) All() ([]*ElemMatchFixture, error) {
+	var result []*ElemMatchFixture
 	err := r.ResultSet.All(&result)
 
 	return result, err
 }
 
 // One returns the first document on the resultset and close the resultset
-func (r *QueryFixtureResultSet) One() (*QueryFixture, error) {
-	var result *QueryFixture
+func (r *ElemMatchFixtureResultSet) One() (*ElemMatchFixture, error) {
+	var result *ElemMatchFixture
 	err := r.ResultSet.One(&result)
 
 	return result, err
 }
 
 // Next prepares the next result document for reading with the Get method.
-func (r *QueryFixtureResultSet) Next() (returned bool) {
+func (r *ElemMatchFixtureResultSet) Next() (returned bool) {
 	r.last = nil
 	returned, r.lastErr = r.ResultSet.Next(&r.last)
 
@@ -706,14 +1069,14 @@ func (r *QueryFixtureResultSet) Next() (returned bool) {
 }
 
 // Get returns the document retrieved with the Next method.
-func (r *QueryFixtureResultSet) Get() (*QueryFixture, error) {
+func (r *ElemMatchFixtureResultSet) Get() (*ElemMatchFixture, error) {
 	return r.last, r.lastErr
 }
 
 // ForEach iterates the resultset calling to the given function.
-func (r *QueryFixtureResultSet) ForEach(f func(*QueryFixture) error) error {
+func (r *ElemMatchFixtureResultSet) ForEach(f func(*ElemMatchFixture) error) error {
 	for {
-		var result *QueryFixture
+		var result *ElemMatchFixture
 		found, err := r.ResultSet.Next(&result)
 		if err != nil {
 			return err
@@ -736,17 +1099,95 @@ func (r *QueryFixtureResultSet) ForEach(f func(*QueryFixture) error) error {
 	return nil
 }
 
-type ResultSetFixtureStore struct {
+// Watch returns a stream with the changes on the documents matching the given
+// query, the current version of the document is retrieved on every update.
+// See storable.Store.Watch.
+func (s *ElemMatchFixtureStore) Watch(query *ElemMatchFixtureQuery, opts storable.WatchOptions) (*ElemMatchFixtureChangeStream, error) {
+	opts.FullDocument = true
+	cs, err := s.Store.Watch(query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ElemMatchFixtureChangeStream{ChangeStream: cs}, nil
+}
+
+type ElemMatchFixtureChangeStream struct {
+	*storable.ChangeStream
+	event   storable.ChangeEvent
+	last    *ElemMatchFixture
+	lastErr error
+}
+
+// Next waits for the next event, to be read with the Get method. Returns
+// false when the stream is closed or fails.
+func (cs *ElemMatchFixtureChangeStream) Next() (returned bool) {
+	cs.event, cs.last, cs.lastErr = storable.ChangeEvent{}, nil, nil
+	if !cs.ChangeStream.Next(&cs.event) {
+		cs.lastErr = cs.ChangeStream.Err()
+		return false
+	}
+
+	if cs.event.HasDocument() {
+		cs.lastErr = cs.event.Decode(&cs.last)
+	}
+
+	return true
+}
+
+// Get returns the event and the document retrieved with the Next method, the
+// document is nil on the deletes or if it does not exist anymore.
+func (cs *ElemMatchFixtureChangeStream) Get() (*storable.ChangeEvent, *ElemMatchFixture, error) {
+	return &cs.event, cs.last, cs.lastErr
+}
+
+// ForEach waits for the events calling to the given function, until the
+// stream is closed or storable.ErrStop is returned.
+func (cs *ElemMatchFixtureChangeStream) ForEach(f func(*storable.ChangeEvent, *ElemMatchFixture) error) error {
+	for cs.Next() {
+		event, doc, err := cs.Get()
+		if err != nil {
+			return err
+		}
+
+		err = f(event, doc)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return cs.lastErr
+}
+
+type EventsFixtureStore struct {
 	storable.Store
 }
 
-func NewResultSetFixtureStore(db *mgo.Database) *ResultSetFixtureStore {
-	return &ResultSetFixtureStore{*storable.NewStore(db, "resultset")}
+func NewEventsFixtureStore(db *mgo.Database) *EventsFixtureStore {
+	s := &EventsFixtureStore{*storable.NewStore(db, "event")}
+	s.SetDefaultKind("EventsFixture")
+	return s
 }
 
-// New returns a new instance of ResultSetFixture.
-func (s *ResultSetFixtureStore) New(f string) (doc *ResultSetFixture) {
-	doc = newResultSetFixture(f)
+// WithContext returns a copy of the store using the given context, see
+// storable.Store.WithContext.
+func (s *EventsFixtureStore) WithContext(ctx context.Context) *EventsFixtureStore {
+	return &EventsFixtureStore{*s.Store.WithContext(ctx)}
+}
+
+// InTx returns a copy of the store running its operations, including the
+// hooks, inside the given transaction. See storable.WithTransaction.
+func (s *EventsFixtureStore) InTx(tx *storable.Tx) *EventsFixtureStore {
+	return &EventsFixtureStore{*s.Store.InTx(tx)}
+}
+
+// New returns a new instance of EventsFixture.
+func (s *EventsFixtureStore) New() (doc *EventsFixture) {
+	doc = newEventsFixture()
 	if doc != nil {
 		doc.SetIsNew(true)
 		doc.SetId(bson.NewObjectId())
@@ -754,30 +1195,41 @@ func (s *ResultSetFixtureStore) New(f string) (doc *ResultSetFixture) {
 	return
 }
 
-// Query return a new instance of ResultSetFixtureQuery.
-func (s *ResultSetFixtureStore) Query() *ResultSetFixtureQuery {
-	return &ResultSetFixtureQuery{*storable.NewBaseQuery()}
+// Query return a new instance of EventsFixtureQuery.
+func (s *EventsFixtureStore) Query() *EventsFixtureQuery {
+	return &EventsFixtureQuery{*storable.NewBaseQuery()}
 }
 
 // Find performs a find on the collection using the given query.
-func (s *ResultSetFixtureStore) Find(query *ResultSetFixtureQuery) (*ResultSetFixtureResultSet, error) {
+func (s *EventsFixtureStore) Find(query *EventsFixtureQuery) (*EventsFixtureResultSet, error) {
 	resultSet, err := s.Store.Find(query)
 	if err != nil {
 		return nil, err
 	}
 
-	return &ResultSetFixtureResultSet{ResultSet: *resultSet}, nil
+	return &EventsFixtureResultSet{ResultSet: *resultSet}, nil
 }
 
 // MustFind like Find but panics on error
-func (s *ResultSetFixtureStore) MustFind(query *ResultSetFixtureQuery) *ResultSetFixtureResultSet {
+func (s *EventsFixtureStore) MustFind(query *EventsFixtureQuery) *EventsFixtureResultSet {
 	resultSet := s.Store.MustFind(query)
-	return &ResultSetFixtureResultSet{ResultSet: *resultSet}
+	return &EventsFixtureResultSet{ResultSet: *resultSet}
+}
+
+// Tail performs a find on the capped collection using the given query,
+// waiting for new documents up to the given timeout. See storable.Store.Tail.
+func (s *EventsFixtureStore) Tail(query *EventsFixtureQuery, timeout time.Duration) (*EventsFixtureResultSet, error) {
+	resultSet, err := s.Store.Tail(query, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventsFixtureResultSet{ResultSet: *resultSet}, nil
 }
 
 // FindOne performs a find on the collection using the given query returning
 // the first document from the resultset.
-func (s *ResultSetFixtureStore) FindOne(query *ResultSetFixtureQuery) (*ResultSetFixture, error) {
+func (s *EventsFixtureStore) FindOne(query *EventsFixtureQuery) (*EventsFixture, error) {
 	resultSet, err := s.Find(query)
 	if err != nil {
 		return nil, err
@@ -787,7 +1239,7 @@ func (s *ResultSetFixtureStore) FindOne(query *ResultSetFixtureQuery) (*ResultSe
 }
 
 // MustFindOne like FindOne but panics on error
-func (s *ResultSetFixtureStore) MustFindOne(query *ResultSetFixtureQuery) *ResultSetFixture {
+func (s *EventsFixtureStore) MustFindOne(query *EventsFixtureQuery) *EventsFixture {
 	doc, err := s.FindOne(query)
 	if err != nil {
 		panic(err)
@@ -796,48 +1248,106 @@ func (s *ResultSetFixtureStore) MustFindOne(query *ResultSetFixtureQuery) *Resul
 	return doc
 }
 
+// FindAndModify atomically modifies the first document matching the given
+// query and returns it, before the change or after it if change.ReturnNew is
+// set. See storable.Store.FindAndModify.
+func (s *EventsFixtureStore) FindAndModify(query *EventsFixtureQuery, change mgo.Change) (*EventsFixture, error) {
+	var result *EventsFixture
+	_, err := s.Store.FindAndModify(query, change, &result)
+
+	return result, err
+}
+
 // Insert insert the given document on the collection, trigger BeforeInsert and
 // AfterInsert if any. Throws ErrNonNewDocument if doc is a non-new document.
-func (s *ResultSetFixtureStore) Insert(doc *ResultSetFixture) error {
+func (s *EventsFixtureStore) Insert(doc *EventsFixture) error {
+	if err := s.BeforeInsert(doc); err != nil {
+		return err
+	}
 
 	err := s.Store.Insert(doc)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return s.AfterInsert(doc)
 }
 
 // Update update the given document on the collection, trigger BeforeUpdate and
 // AfterUpdate if any. Throws ErrNewDocument if doc is a new document.
-func (s *ResultSetFixtureStore) Update(doc *ResultSetFixture) error {
+func (s *EventsFixtureStore) Update(doc *EventsFixture) error {
+	if err := s.BeforeUpdate(doc); err != nil {
+		return err
+	}
 
 	err := s.Store.Update(doc)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return s.AfterUpdate(doc)
+}
+
+// UpdateDiff update the given document on the collection writing only the
+// changes from old, trigger BeforeUpdate and AfterUpdate if any. See
+// storable.Store.UpdateDiff.
+func (s *EventsFixtureStore) UpdateDiff(old, doc *EventsFixture) (storable.Changes, error) {
+	if err := s.BeforeUpdate(doc); err != nil {
+		return nil, err
+	}
+
+	changes, err := s.Store.UpdateDiff(old, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.AfterUpdate(doc); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
 }
 
 // Save insert or update the given document on the collection using Upsert,
 // trigger BeforeUpdate and AfterUpdate if the document is non-new and
 // BeforeInsert and AfterInset if is new.
-func (s *ResultSetFixtureStore) Save(doc *ResultSetFixture) (updated bool, err error) {
+func (s *EventsFixtureStore) Save(doc *EventsFixture) (updated bool, err error) {
+	switch doc.IsNew() {
+	case true:
+		if err := s.BeforeInsert(doc); err != nil {
+			return false, err
+		}
+	case false:
+		if err := s.BeforeUpdate(doc); err != nil {
+			return false, err
+		}
+	}
+
 	updated, err = s.Store.Save(doc)
 	if err != nil {
 		return false, err
 	}
 
+	switch updated {
+	case false:
+		if err := s.AfterInsert(doc); err != nil {
+			return false, err
+		}
+	case true:
+		if err := s.AfterUpdate(doc); err != nil {
+			return false, err
+		}
+	}
+
 	return
 }
 
-type ResultSetFixtureQuery struct {
+type EventsFixtureQuery struct {
 	storable.BaseQuery
 }
 
 // FindById add a new criteria to the query searching by _id
-func (q *ResultSetFixtureQuery) FindById(ids ...bson.ObjectId) *ResultSetFixtureQuery {
+func (q *EventsFixtureQuery) FindById(ids ...bson.ObjectId) *EventsFixtureQuery {
 	var vs []interface{}
 	for _, id := range ids {
 		vs = append(vs, id)
@@ -847,30 +1357,35 @@ func (q *ResultSetFixtureQuery) FindById(ids ...bson.ObjectId) *ResultSetFixture
 	return q
 }
 
-type ResultSetFixtureResultSet struct {
+// Clone returns a copy of the query, see storable.BaseQuery.Clone.
+func (q *EventsFixtureQuery) Clone() *EventsFixtureQuery {
+	return &EventsFixtureQuery{*q.BaseQuery.Clone()}
+}
+
+type EventsFixtureResultSet struct {
 	storable.ResultSet
-	last    *ResultSetFixture
+	last    *EventsFixture
 	lastErr error
 }
 
 // All returns all documents on the resultset and close the resultset
-func (r *ResultSetFixtureResultSet) All() ([]*ResultSetFixture, error) {
-	var result []*ResultSetFixture
+func (r *EventsFixtureResultSet) All() ([]*EventsFixture, error) {
+	var result []*EventsFixture
 	err := r.ResultSet.All(&result)
 
 	return result, err
 }
 
 // One returns the first document on the resultset and close the resultset
-func (r *ResultSetFixtureResultSet) One() (*ResultSetFixture, error) {
-	var result *ResultSetFixture
+func (r *EventsFixtureResultSet) One() (*EventsFixture, error) {
+	var result *EventsFixture
 	err := r.ResultSet.One(&result)
 
 	return result, err
 }
 
 // Next prepares the next result document for reading with the Get method.
-func (r *ResultSetFixtureResultSet) Next() (returned bool) {
+func (r *EventsFixtureResultSet) Next() (returned bool) {
 	r.last = nil
 	returned, r.lastErr = r.ResultSet.Next(&r.last)
 
@@ -878,14 +1393,14 @@ func (r *ResultSetFixtureResultSet) Next() (returned bool) {
 }
 
 // Get returns the document retrieved with the Next method.
-func (r *ResultSetFixtureResultSet) Get() (*ResultSetFixture, error) {
+func (r *EventsFixtureResultSet) Get() (*EventsFixture, error) {
 	return r.last, r.lastErr
 }
 
 // ForEach iterates the resultset calling to the given function.
-func (r *ResultSetFixtureResultSet) ForEach(f func(*ResultSetFixture) error) error {
+func (r *EventsFixtureResultSet) ForEach(f func(*EventsFixture) error) error {
 	for {
-		var result *ResultSetFixture
+		var result *EventsFixture
 		found, err := r.ResultSet.Next(&result)
 		if err != nil {
 			return err
@@ -908,17 +1423,95 @@ func (r *ResultSetFixtureResultSet) ForEach(f func(*ResultSetFixture) error) err
 	return nil
 }
 
-type ResultSetInitFixtureStore struct {
-	storable.Store
-}
-
-func NewResultSetInitFixtureStore(db *mgo.Database) *ResultSetInitFixtureStore {
-	return &ResultSetInitFixtureStore{*storable.NewStore(db, "resultset")}
+// Watch returns a stream with the changes on the documents matching the given
+// query, the current version of the document is retrieved on every update.
+// See storable.Store.Watch.
+func (s *EventsFixtureStore) Watch(query *EventsFixtureQuery, opts storable.WatchOptions) (*EventsFixtureChangeStream, error) {
+	opts.FullDocument = true
+	cs, err := s.Store.Watch(query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventsFixtureChangeStream{ChangeStream: cs}, nil
 }
 
-// New returns a new instance of ResultSetInitFixture.
-func (s *ResultSetInitFixtureStore) New() (doc *ResultSetInitFixture) {
-	doc = &ResultSetInitFixture{}
+type EventsFixtureChangeStream struct {
+	*storable.ChangeStream
+	event   storable.ChangeEvent
+	last    *EventsFixture
+	lastErr error
+}
+
+// Next waits for the next event, to be read with the Get method. Returns
+// false when the stream is closed or fails.
+func (cs *EventsFixtureChangeStream) Next() (returned bool) {
+	cs.event, cs.last, cs.lastErr = storable.ChangeEvent{}, nil, nil
+	if !cs.ChangeStream.Next(&cs.event) {
+		cs.lastErr = cs.ChangeStream.Err()
+		return false
+	}
+
+	if cs.event.HasDocument() {
+		cs.lastErr = cs.event.Decode(&cs.last)
+	}
+
+	return true
+}
+
+// Get returns the event and the document retrieved with the Next method, the
+// document is nil on the deletes or if it does not exist anymore.
+func (cs *EventsFixtureChangeStream) Get() (*storable.ChangeEvent, *EventsFixture, error) {
+	return &cs.event, cs.last, cs.lastErr
+}
+
+// ForEach waits for the events calling to the given function, until the
+// stream is closed or storable.ErrStop is returned.
+func (cs *EventsFixtureChangeStream) ForEach(f func(*storable.ChangeEvent, *EventsFixture) error) error {
+	for cs.Next() {
+		event, doc, err := cs.Get()
+		if err != nil {
+			return err
+		}
+
+		err = f(event, doc)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return cs.lastErr
+}
+
+type EventsSaveFixtureStore struct {
+	storable.Store
+}
+
+func NewEventsSaveFixtureStore(db *mgo.Database) *EventsSaveFixtureStore {
+	s := &EventsSaveFixtureStore{*storable.NewStore(db, "event")}
+	s.SetDefaultKind("EventsSaveFixture")
+	return s
+}
+
+// WithContext returns a copy of the store using the given context, see
+// storable.Store.WithContext.
+func (s *EventsSaveFixtureStore) WithContext(ctx context.Context) *EventsSaveFixtureStore {
+	return &EventsSaveFixtureStore{*s.Store.WithContext(ctx)}
+}
+
+// InTx returns a copy of the store running its operations, including the
+// hooks, inside the given transaction. See storable.WithTransaction.
+func (s *EventsSaveFixtureStore) InTx(tx *storable.Tx) *EventsSaveFixtureStore {
+	return &EventsSaveFixtureStore{*s.Store.InTx(tx)}
+}
+
+// New returns a new instance of EventsSaveFixture.
+func (s *EventsSaveFixtureStore) New() (doc *EventsSaveFixture) {
+	doc = newEventsSaveFixture()
 	if doc != nil {
 		doc.SetIsNew(true)
 		doc.SetId(bson.NewObjectId())
@@ -926,30 +1519,41 @@ func (s *ResultSetInitFixtureStore) New() (doc *ResultSetInitFixture) {
 	return
 }
 
-// Query return a new instance of ResultSetInitFixtureQuery.
-func (s *ResultSetInitFixtureStore) Query() *ResultSetInitFixtureQuery {
-	return &ResultSetInitFixtureQuery{*storable.NewBaseQuery()}
+// Query return a new instance of EventsSaveFixtureQuery.
+func (s *EventsSaveFixtureStore) Query() *EventsSaveFixtureQuery {
+	return &EventsSaveFixtureQuery{*storable.NewBaseQuery()}
 }
 
 // Find performs a find on the collection using the given query.
-func (s *ResultSetInitFixtureStore) Find(query *ResultSetInitFixtureQuery) (*ResultSetInitFixtureResultSet, error) {
+func (s *EventsSaveFixtureStore) Find(query *EventsSaveFixtureQuery) (*EventsSaveFixtureResultSet, error) {
 	resultSet, err := s.Store.Find(query)
 	if err != nil {
 		return nil, err
 	}
 
-	return &ResultSetInitFixtureResultSet{ResultSet: *resultSet}, nil
+	return &EventsSaveFixtureResultSet{ResultSet: *resultSet}, nil
 }
 
 // MustFind like Find but panics on error
-func (s *ResultSetInitFixtureStore) MustFind(query *ResultSetInitFixtureQuery) *ResultSetInitFixtureResultSet {
+func (s *EventsSaveFixtureStore) MustFind(query *EventsSaveFixtureQuery) *EventsSaveFixtureResultSet {
 	resultSet := s.Store.MustFind(query)
-	return &ResultSetInitFixtureResultSet{ResultSet: *resultSet}
+	return &EventsSaveFixtureResultSet{ResultSet: *resultSet}
+}
+
+// Tail performs a find on the capped collection using the given query,
+// waiting for new documents up to the given timeout. See storable.Store.Tail.
+func (s *EventsSaveFixtureStore) Tail(query *EventsSaveFixtureQuery, timeout time.Duration) (*EventsSaveFixtureResultSet, error) {
+	resultSet, err := s.Store.Tail(query, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventsSaveFixtureResultSet{ResultSet: *resultSet}, nil
 }
 
 // FindOne performs a find on the collection using the given query returning
 // the first document from the resultset.
-func (s *ResultSetInitFixtureStore) FindOne(query *ResultSetInitFixtureQuery) (*ResultSetInitFixture, error) {
+func (s *EventsSaveFixtureStore) FindOne(query *EventsSaveFixtureQuery) (*EventsSaveFixture, error) {
 	resultSet, err := s.Find(query)
 	if err != nil {
 		return nil, err
@@ -959,7 +1563,7 @@ func (s *ResultSetInitFixtureStore) FindOne(query *ResultSetInitFixtureQuery) (*
 }
 
 // MustFindOne like FindOne but panics on error
-func (s *ResultSetInitFixtureStore) MustFindOne(query *ResultSetInitFixtureQuery) *ResultSetInitFixture {
+func (s *EventsSaveFixtureStore) MustFindOne(query *EventsSaveFixtureQuery) *EventsSaveFixture {
 	doc, err := s.FindOne(query)
 	if err != nil {
 		panic(err)
@@ -968,48 +1572,91 @@ func (s *ResultSetInitFixtureStore) MustFindOne(query *ResultSetInitFixtureQuery
 	return doc
 }
 
+// FindAndModify atomically modifies the first document matching the given
+// query and returns it, before the change or after it if change.ReturnNew is
+// set. See storable.Store.FindAndModify.
+func (s *EventsSaveFixtureStore) FindAndModify(query *EventsSaveFixtureQuery, change mgo.Change) (*EventsSaveFixture, error) {
+	var result *EventsSaveFixture
+	_, err := s.Store.FindAndModify(query, change, &result)
+
+	return result, err
+}
+
 // Insert insert the given document on the collection, trigger BeforeInsert and
 // AfterInsert if any. Throws ErrNonNewDocument if doc is a non-new document.
-func (s *ResultSetInitFixtureStore) Insert(doc *ResultSetInitFixture) error {
+func (s *EventsSaveFixtureStore) Insert(doc *EventsSaveFixture) error {
+	if err := s.BeforeSave(doc); err != nil {
+		return err
+	}
 
 	err := s.Store.Insert(doc)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return s.AfterSave(doc)
 }
 
 // Update update the given document on the collection, trigger BeforeUpdate and
 // AfterUpdate if any. Throws ErrNewDocument if doc is a new document.
-func (s *ResultSetInitFixtureStore) Update(doc *ResultSetInitFixture) error {
+func (s *EventsSaveFixtureStore) Update(doc *EventsSaveFixture) error {
+	if err := s.BeforeSave(doc); err != nil {
+		return err
+	}
 
 	err := s.Store.Update(doc)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return s.AfterSave(doc)
+}
+
+// UpdateDiff update the given document on the collection writing only the
+// changes from old, trigger BeforeUpdate and AfterUpdate if any. See
+// storable.Store.UpdateDiff.
+func (s *EventsSaveFixtureStore) UpdateDiff(old, doc *EventsSaveFixture) (storable.Changes, error) {
+	if err := s.BeforeSave(doc); err != nil {
+		return nil, err
+	}
+
+	changes, err := s.Store.UpdateDiff(old, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.AfterSave(doc); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
 }
 
 // Save insert or update the given document on the collection using Upsert,
 // trigger BeforeUpdate and AfterUpdate if the document is non-new and
 // BeforeInsert and AfterInset if is new.
-func (s *ResultSetInitFixtureStore) Save(doc *ResultSetInitFixture) (updated bool, err error) {
+func (s *EventsSaveFixtureStore) Save(doc *EventsSaveFixture) (updated bool, err error) {
+	if err := s.BeforeSave(doc); err != nil {
+		return false, err
+	}
+
 	updated, err = s.Store.Save(doc)
 	if err != nil {
 		return false, err
 	}
 
+	if err := s.AfterSave(doc); err != nil {
+		return false, err
+	}
 	return
 }
 
-type ResultSetInitFixtureQuery struct {
+type EventsSaveFixtureQuery struct {
 	storable.BaseQuery
 }
 
 // FindById add a new criteria to the query searching by _id
-func (q *ResultSetInitFixtureQuery) FindById(ids ...bson.ObjectId) *ResultSetInitFixtureQuery {
+func (q *EventsSaveFixtureQuery) FindById(ids ...bson.ObjectId) *EventsSaveFixtureQuery {
 	var vs []interface{}
 	for _, id := range ids {
 		vs = append(vs, id)
@@ -1019,65 +1666,50 @@ func (q *ResultSetInitFixtureQuery) FindById(ids ...bson.ObjectId) *ResultSetIni
 	return q
 }
 
-type ResultSetInitFixtureResultSet struct {
+// Clone returns a copy of the query, see storable.BaseQuery.Clone.
+func (q *EventsSaveFixtureQuery) Clone() *EventsSaveFixtureQuery {
+	return &EventsSaveFixtureQuery{*q.BaseQuery.Clone()}
+}
+
+type EventsSaveFixtureResultSet struct {
 	storable.ResultSet
-	last    *ResultSetInitFixture
+	last    *EventsSaveFixture
 	lastErr error
 }
 
 // All returns all documents on the resultset and close the resultset
-func (r *ResultSetInitFixtureResultSet) All() ([]*ResultSetInitFixture, error) {
-	var result []*ResultSetInitFixture
+func (r *EventsSaveFixtureResultSet) All() ([]*EventsSaveFixture, error) {
+	var result []*EventsSaveFixture
 	err := r.ResultSet.All(&result)
-	if err != nil {
-		return result, err
-	}
-
-	for _, r := range result {
-		if err := r.Init(r); err != nil {
-			return result, err
-		}
-	}
 
 	return result, err
 }
 
 // One returns the first document on the resultset and close the resultset
-func (r *ResultSetInitFixtureResultSet) One() (*ResultSetInitFixture, error) {
-	var result *ResultSetInitFixture
+func (r *EventsSaveFixtureResultSet) One() (*EventsSaveFixture, error) {
+	var result *EventsSaveFixture
 	err := r.ResultSet.One(&result)
-	if err != nil {
-		return result, err
-	}
-
-	err = result.Init(result)
 
 	return result, err
 }
 
 // Next prepares the next result document for reading with the Get method.
-func (r *ResultSetInitFixtureResultSet) Next() (returned bool) {
+func (r *EventsSaveFixtureResultSet) Next() (returned bool) {
 	r.last = nil
 	returned, r.lastErr = r.ResultSet.Next(&r.last)
-	if r.lastErr != nil {
-		return
-	}
-	if returned {
-		r.lastErr = r.last.Init(r.last)
-	}
 
 	return
 }
 
 // Get returns the document retrieved with the Next method.
-func (r *ResultSetInitFixtureResultSet) Get() (*ResultSetInitFixture, error) {
+func (r *EventsSaveFixtureResultSet) Get() (*EventsSaveFixture, error) {
 	return r.last, r.lastErr
 }
 
 // ForEach iterates the resultset calling to the given function.
-func (r *ResultSetInitFixtureResultSet) ForEach(f func(*ResultSetInitFixture) error) error {
+func (r *EventsSaveFixtureResultSet) ForEach(f func(*EventsSaveFixture) error) error {
 	for {
-		var result *ResultSetInitFixture
+		var result *EventsSaveFixture
 		found, err := r.ResultSet.Next(&result)
 		if err != nil {
 			return err
@@ -1087,11 +1719,71 @@ func (r *ResultSetInitFixtureResultSet) ForEach(f func(*ResultSetInitFixture) er
 			break
 		}
 
-		if err := result.Init(result); err != nil {
+		err = f(result)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
 			return err
 		}
+	}
 
-		err = f(result)
+	return nil
+}
+
+// Watch returns a stream with the changes on the documents matching the given
+// query, the current version of the document is retrieved on every update.
+// See storable.Store.Watch.
+func (s *EventsSaveFixtureStore) Watch(query *EventsSaveFixtureQuery, opts storable.WatchOptions) (*EventsSaveFixtureChangeStream, error) {
+	opts.FullDocument = true
+	cs, err := s.Store.Watch(query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventsSaveFixtureChangeStream{ChangeStream: cs}, nil
+}
+
+type EventsSaveFixtureChangeStream struct {
+	*storable.ChangeStream
+	event   storable.ChangeEvent
+	last    *EventsSaveFixture
+	lastErr error
+}
+
+// Next waits for the next event, to be read with the Get method. Returns
+// false when the stream is closed or fails.
+func (cs *EventsSaveFixtureChangeStream) Next() (returned bool) {
+	cs.event, cs.last, cs.lastErr = storable.ChangeEvent{}, nil, nil
+	if !cs.ChangeStream.Next(&cs.event) {
+		cs.lastErr = cs.ChangeStream.Err()
+		return false
+	}
+
+	if cs.event.HasDocument() {
+		cs.lastErr = cs.event.Decode(&cs.last)
+	}
+
+	return true
+}
+
+// Get returns the event and the document retrieved with the Next method, the
+// document is nil on the deletes or if it does not exist anymore.
+func (cs *EventsSaveFixtureChangeStream) Get() (*storable.ChangeEvent, *EventsSaveFixture, error) {
+	return &cs.event, cs.last, cs.lastErr
+}
+
+// ForEach waits for the events calling to the given function, until the
+// stream is closed or storable.ErrStop is returned.
+func (cs *EventsSaveFixtureChangeStream) ForEach(f func(*storable.ChangeEvent, *EventsSaveFixture) error) error {
+	for cs.Next() {
+		event, doc, err := cs.Get()
+		if err != nil {
+			return err
+		}
+
+		err = f(event, doc)
 		if err == storable.ErrStop {
 			break
 		}
@@ -1101,20 +1793,34 @@ func (r *ResultSetInitFixtureResultSet) ForEach(f func(*ResultSetInitFixture) er
 		}
 	}
 
-	return nil
+	return cs.lastErr
 }
 
-type SchemaFixtureStore struct {
+type ExprFixtureStore struct {
 	storable.Store
 }
 
-func NewSchemaFixtureStore(db *mgo.Database) *SchemaFixtureStore {
-	return &SchemaFixtureStore{*storable.NewStore(db, "schema")}
+func NewExprFixtureStore(db *mgo.Database) *ExprFixtureStore {
+	s := &ExprFixtureStore{*storable.NewStore(db, "query")}
+	s.SetDefaultKind("ExprFixture")
+	return s
 }
 
-// New returns a new instance of SchemaFixture.
-func (s *SchemaFixtureStore) New() (doc *SchemaFixture) {
-	doc = &SchemaFixture{}
+// WithContext returns a copy of the store using the given context, see
+// storable.Store.WithContext.
+func (s *ExprFixtureStore) WithContext(ctx context.Context) *ExprFixtureStore {
+	return &ExprFixtureStore{*s.Store.WithContext(ctx)}
+}
+
+// InTx returns a copy of the store running its operations, including the
+// hooks, inside the given transaction. See storable.WithTransaction.
+func (s *ExprFixtureStore) InTx(tx *storable.Tx) *ExprFixtureStore {
+	return &ExprFixtureStore{*s.Store.InTx(tx)}
+}
+
+// New returns a new instance of ExprFixture.
+func (s *ExprFixtureStore) New() (doc *ExprFixture) {
+	doc = &ExprFixture{}
 	if doc != nil {
 		doc.SetIsNew(true)
 		doc.SetId(bson.NewObjectId())
@@ -1122,30 +1828,41 @@ func (s *SchemaFixtureStore) New() (doc *SchemaFixture) {
 	return
 }
 
-// Query return a new instance of SchemaFixtureQuery.
-func (s *SchemaFixtureStore) Query() *SchemaFixtureQuery {
-	return &SchemaFixtureQuery{*storable.NewBaseQuery()}
+// Query return a new instance of ExprFixtureQuery.
+func (s *ExprFixtureStore) Query() *ExprFixtureQuery {
+	return &ExprFixtureQuery{*storable.NewBaseQuery()}
 }
 
 // Find performs a find on the collection using the given query.
-func (s *SchemaFixtureStore) Find(query *SchemaFixtureQuery) (*SchemaFixtureResultSet, error) {
+func (s *ExprFixtureStore) Find(query *ExprFixtureQuery) (*ExprFixtureResultSet, error) {
 	resultSet, err := s.Store.Find(query)
 	if err != nil {
 		return nil, err
 	}
 
-	return &SchemaFixtureResultSet{ResultSet: *resultSet}, nil
+	return &ExprFixtureResultSet{ResultSet: *resultSet}, nil
 }
 
 // MustFind like Find but panics on error
-func (s *SchemaFixtureStore) MustFind(query *SchemaFixtureQuery) *SchemaFixtureResultSet {
+func (s *ExprFixtureStore) MustFind(query *ExprFixtureQuery) *ExprFixtureResultSet {
 	resultSet := s.Store.MustFind(query)
-	return &SchemaFixtureResultSet{ResultSet: *resultSet}
+	return &ExprFixtureResultSet{ResultSet: *resultSet}
+}
+
+// Tail performs a find on the capped collection using the given query,
+// waiting for new documents up to the given timeout. See storable.Store.Tail.
+func (s *ExprFixtureStore) Tail(query *ExprFixtureQuery, timeout time.Duration) (*ExprFixtureResultSet, error) {
+	resultSet, err := s.Store.Tail(query, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExprFixtureResultSet{ResultSet: *resultSet}, nil
 }
 
 // FindOne performs a find on the collection using the given query returning
 // the first document from the resultset.
-func (s *SchemaFixtureStore) FindOne(query *SchemaFixtureQuery) (*SchemaFixture, error) {
+func (s *ExprFixtureStore) FindOne(query *ExprFixtureQuery) (*ExprFixture, error) {
 	resultSet, err := s.Find(query)
 	if err != nil {
 		return nil, err
@@ -1155,7 +1872,7 @@ func (s *SchemaFixtureStore) FindOne(query *SchemaFixtureQuery) (*SchemaFixture,
 }
 
 // MustFindOne like FindOne but panics on error
-func (s *SchemaFixtureStore) MustFindOne(query *SchemaFixtureQuery) *SchemaFixture {
+func (s *ExprFixtureStore) MustFindOne(query *ExprFixtureQuery) *ExprFixture {
 	doc, err := s.FindOne(query)
 	if err != nil {
 		panic(err)
@@ -1164,9 +1881,19 @@ func (s *SchemaFixtureStore) MustFindOne(query *SchemaFixtureQuery) *SchemaFixtu
 	return doc
 }
 
+// FindAndModify atomically modifies the first document matching the given
+// query and returns it, before the change or after it if change.ReturnNew is
+// set. See storable.Store.FindAndModify.
+func (s *ExprFixtureStore) FindAndModify(query *ExprFixtureQuery, change mgo.Change) (*ExprFixture, error) {
+	var result *ExprFixture
+	_, err := s.Store.FindAndModify(query, change, &result)
+
+	return result, err
+}
+
 // Insert insert the given document on the collection, trigger BeforeInsert and
 // AfterInsert if any. Throws ErrNonNewDocument if doc is a non-new document.
-func (s *SchemaFixtureStore) Insert(doc *SchemaFixture) error {
+func (s *ExprFixtureStore) Insert(doc *ExprFixture) error {
 
 	err := s.Store.Insert(doc)
 	if err != nil {
@@ -1178,7 +1905,7 @@ func (s *SchemaFixtureStore) Insert(doc *SchemaFixture) error {
 
 // Update update the given document on the collection, trigger BeforeUpdate and
 // AfterUpdate if any. Throws ErrNewDocument if doc is a new document.
-func (s *SchemaFixtureStore) Update(doc *SchemaFixture) error {
+func (s *ExprFixtureStore) Update(doc *ExprFixture) error {
 
 	err := s.Store.Update(doc)
 	if err != nil {
@@ -1188,10 +1915,23 @@ func (s *SchemaFixtureStore) Update(doc *SchemaFixture) error {
 	return nil
 }
 
+// UpdateDiff update the given document on the collection writing only the
+// changes from old, trigger BeforeUpdate and AfterUpdate if any. See
+// storable.Store.UpdateDiff.
+func (s *ExprFixtureStore) UpdateDiff(old, doc *ExprFixture) (storable.Changes, error) {
+
+	changes, err := s.Store.UpdateDiff(old, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
 // Save insert or update the given document on the collection using Upsert,
 // trigger BeforeUpdate and AfterUpdate if the document is non-new and
 // BeforeInsert and AfterInset if is new.
-func (s *SchemaFixtureStore) Save(doc *SchemaFixture) (updated bool, err error) {
+func (s *ExprFixtureStore) Save(doc *ExprFixture) (updated bool, err error) {
 	updated, err = s.Store.Save(doc)
 	if err != nil {
 		return false, err
@@ -1200,12 +1940,12 @@ func (s *SchemaFixtureStore) Save(doc *SchemaFixture) (updated bool, err error)
 	return
 }
 
-type SchemaFixtureQuery struct {
+type ExprFixtureQuery struct {
 	storable.BaseQuery
 }
 
 // FindById add a new criteria to the query searching by _id
-func (q *SchemaFixtureQuery) FindById(ids ...bson.ObjectId) *SchemaFixtureQuery {
+func (q *ExprFixtureQuery) FindById(ids ...bson.ObjectId) *ExprFixtureQuery {
 	var vs []interface{}
 	for _, id := range ids {
 		vs = append(vs, id)
@@ -1215,30 +1955,35 @@ func (q *SchemaFixtureQuery) FindById(ids ...bson.ObjectId) *SchemaFixtureQuery
 	return q
 }
 
-type SchemaFixtureResultSet struct {
+// Clone returns a copy of the query, see storable.BaseQuery.Clone.
+func (q *ExprFixtureQuery) Clone() *ExprFixtureQuery {
+	return &ExprFixtureQuery{*q.BaseQuery.Clone()}
+}
+
+type ExprFixtureResultSet struct {
 	storable.ResultSet
-	last    *SchemaFixture
+	last    *ExprFixture
 	lastErr error
 }
 
 // All returns all documents on the resultset and close the resultset
-func (r *SchemaFixtureResultSet) All() ([]*SchemaFixture, error) {
-	var result []*SchemaFixture
+func (r *ExprFixtureResultSet) All() ([]*ExprFixture, error) {
+	var result []*ExprFixture
 	err := r.ResultSet.All(&result)
 
 	return result, err
 }
 
 // One returns the first document on the resultset and close the resultset
-func (r *SchemaFixtureResultSet) One() (*SchemaFixture, error) {
-	var result *SchemaFixture
+func (r *ExprFixtureResultSet) One() (*ExprFixture, error) {
+	var result *ExprFixture
 	err := r.ResultSet.One(&result)
 
 	return result, err
 }
 
 // Next prepares the next result document for reading with the Get method.
-func (r *SchemaFixtureResultSet) Next() (returned bool) {
+func (r *ExprFixtureResultSet) Next() (returned bool) {
 	r.last = nil
 	returned, r.lastErr = r.ResultSet.Next(&r.last)
 
@@ -1246,14 +1991,14 @@ func (r *SchemaFixtureResultSet) Next() (returned bool) {
 }
 
 // Get returns the document retrieved with the Next method.
-func (r *SchemaFixtureResultSet) Get() (*SchemaFixture, error) {
+func (r *ExprFixtureResultSet) Get() (*ExprFixture, error) {
 	return r.last, r.lastErr
 }
 
 // ForEach iterates the resultset calling to the given function.
-func (r *SchemaFixtureResultSet) ForEach(f func(*SchemaFixture) error) error {
+func (r *ExprFixtureResultSet) ForEach(f func(*ExprFixture) error) error {
 	for {
-		var result *SchemaFixture
+		var result *ExprFixture
 		found, err := r.ResultSet.Next(&result)
 		if err != nil {
 			return err
@@ -1276,17 +2021,96 @@ func (r *SchemaFixtureResultSet) ForEach(f func(*SchemaFixture) error) error {
 	return nil
 }
 
-type StoreFixtureStore struct {
-	storable.Store
+// Watch returns a stream with the changes on the documents matching the given
+// query, the current version of the document is retrieved on every update.
+// See storable.Store.Watch.
+func (s *ExprFixtureStore) Watch(query *ExprFixtureQuery, opts storable.WatchOptions) (*ExprFixtureChangeStream, error) {
+	opts.FullDocument = true
+	cs, err := s.Store.Watch(query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExprFixtureChangeStream{ChangeStream: cs}, nil
 }
 
-func NewStoreFixtureStore(db *mgo.Database) *StoreFixtureStore {
-	return &StoreFixtureStore{*storable.NewStore(db, "store")}
+type ExprFixtureChangeStream struct {
+	*storable.ChangeStream
+	event   storable.ChangeEvent
+	last    *ExprFixture
+	lastErr error
 }
 
-// New returns a new instance of StoreFixture.
-func (s *StoreFixtureStore) New() (doc *StoreFixture) {
-	doc = &StoreFixture{}
+// Next waits for the next event, to be read with the Get method. Returns
+// false when the stream is closed or fails.
+func (cs *ExprFixtureChangeStream) Next() (returned bool) {
+	cs.event, cs.last, cs.lastErr = storable.ChangeEvent{}, nil, nil
+	if !cs.ChangeStream.Next(&cs.event) {
+		cs.lastErr = cs.ChangeStream.Err()
+		return false
+	}
+
+	if cs.event.HasDocument() {
+		cs.lastErr = cs.event.Decode(&cs.last)
+	}
+
+	return true
+}
+
+// Get returns the event and the document retrieved with the Next method, the
+// document is nil on the deletes or if it does not exist anymore.
+func (cs *ExprFixtureChangeStream) Get() (*storable.ChangeEvent, *ExprFixture, error) {
+	return &cs.event, cs.last, cs.lastErr
+}
+
+// ForEach waits for the events calling to the given function, until the
+// stream is closed or storable.ErrStop is returned.
+func (cs *ExprFixtureChangeStream) ForEach(f func(*storable.ChangeEvent, *ExprFixture) error) error {
+	for cs.Next() {
+		event, doc, err := cs.Get()
+		if err != nil {
+			return err
+		}
+
+		err = f(event, doc)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return cs.lastErr
+}
+
+type FamilyBirdFixtureStore struct {
+	storable.Store
+}
+
+func NewFamilyBirdFixtureStore(db *mgo.Database) *FamilyBirdFixtureStore {
+	s := &FamilyBirdFixtureStore{*storable.NewStore(db, "animals")}
+	s.EnableHistory()
+	s.SetKind("bird")
+	return s
+}
+
+// WithContext returns a copy of the store using the given context, see
+// storable.Store.WithContext.
+func (s *FamilyBirdFixtureStore) WithContext(ctx context.Context) *FamilyBirdFixtureStore {
+	return &FamilyBirdFixtureStore{*s.Store.WithContext(ctx)}
+}
+
+// InTx returns a copy of the store running its operations, including the
+// hooks, inside the given transaction. See storable.WithTransaction.
+func (s *FamilyBirdFixtureStore) InTx(tx *storable.Tx) *FamilyBirdFixtureStore {
+	return &FamilyBirdFixtureStore{*s.Store.InTx(tx)}
+}
+
+// New returns a new instance of FamilyBirdFixture.
+func (s *FamilyBirdFixtureStore) New() (doc *FamilyBirdFixture) {
+	doc = &FamilyBirdFixture{}
 	if doc != nil {
 		doc.SetIsNew(true)
 		doc.SetId(bson.NewObjectId())
@@ -1294,30 +2118,41 @@ func (s *StoreFixtureStore) New() (doc *StoreFixture) {
 	return
 }
 
-// Query return a new instance of StoreFixtureQuery.
-func (s *StoreFixtureStore) Query() *StoreFixtureQuery {
-	return &StoreFixtureQuery{*storable.NewBaseQuery()}
+// Query return a new instance of FamilyBirdFixtureQuery.
+func (s *FamilyBirdFixtureStore) Query() *FamilyBirdFixtureQuery {
+	return &FamilyBirdFixtureQuery{*storable.NewBaseQuery()}
 }
 
 // Find performs a find on the collection using the given query.
-func (s *StoreFixtureStore) Find(query *StoreFixtureQuery) (*StoreFixtureResultSet, error) {
+func (s *FamilyBirdFixtureStore) Find(query *FamilyBirdFixtureQuery) (*FamilyBirdFixtureResultSet, error) {
 	resultSet, err := s.Store.Find(query)
 	if err != nil {
 		return nil, err
 	}
 
-	return &StoreFixtureResultSet{ResultSet: *resultSet}, nil
+	return &FamilyBirdFixtureResultSet{ResultSet: *resultSet}, nil
 }
 
 // MustFind like Find but panics on error
-func (s *StoreFixtureStore) MustFind(query *StoreFixtureQuery) *StoreFixtureResultSet {
+func (s *FamilyBirdFixtureStore) MustFind(query *FamilyBirdFixtureQuery) *FamilyBirdFixtureResultSet {
 	resultSet := s.Store.MustFind(query)
-	return &StoreFixtureResultSet{ResultSet: *resultSet}
+	return &FamilyBirdFixtureResultSet{ResultSet: *resultSet}
+}
+
+// Tail performs a find on the capped collection using the given query,
+// waiting for new documents up to the given timeout. See storable.Store.Tail.
+func (s *FamilyBirdFixtureStore) Tail(query *FamilyBirdFixtureQuery, timeout time.Duration) (*FamilyBirdFixtureResultSet, error) {
+	resultSet, err := s.Store.Tail(query, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FamilyBirdFixtureResultSet{ResultSet: *resultSet}, nil
 }
 
 // FindOne performs a find on the collection using the given query returning
 // the first document from the resultset.
-func (s *StoreFixtureStore) FindOne(query *StoreFixtureQuery) (*StoreFixture, error) {
+func (s *FamilyBirdFixtureStore) FindOne(query *FamilyBirdFixtureQuery) (*FamilyBirdFixture, error) {
 	resultSet, err := s.Find(query)
 	if err != nil {
 		return nil, err
@@ -1327,7 +2162,7 @@ func (s *StoreFixtureStore) FindOne(query *StoreFixtureQuery) (*StoreFixture, er
 }
 
 // MustFindOne like FindOne but panics on error
-func (s *StoreFixtureStore) MustFindOne(query *StoreFixtureQuery) *StoreFixture {
+func (s *FamilyBirdFixtureStore) MustFindOne(query *FamilyBirdFixtureQuery) *FamilyBirdFixture {
 	doc, err := s.FindOne(query)
 	if err != nil {
 		panic(err)
@@ -1336,9 +2171,19 @@ func (s *StoreFixtureStore) MustFindOne(query *StoreFixtureQuery) *StoreFixture
 	return doc
 }
 
+// FindAndModify atomically modifies the first document matching the given
+// query and returns it, before the change or after it if change.ReturnNew is
+// set. See storable.Store.FindAndModify.
+func (s *FamilyBirdFixtureStore) FindAndModify(query *FamilyBirdFixtureQuery, change mgo.Change) (*FamilyBirdFixture, error) {
+	var result *FamilyBirdFixture
+	_, err := s.Store.FindAndModify(query, change, &result)
+
+	return result, err
+}
+
 // Insert insert the given document on the collection, trigger BeforeInsert and
 // AfterInsert if any. Throws ErrNonNewDocument if doc is a non-new document.
-func (s *StoreFixtureStore) Insert(doc *StoreFixture) error {
+func (s *FamilyBirdFixtureStore) Insert(doc *FamilyBirdFixture) error {
 
 	err := s.Store.Insert(doc)
 	if err != nil {
@@ -1350,7 +2195,7 @@ func (s *StoreFixtureStore) Insert(doc *StoreFixture) error {
 
 // Update update the given document on the collection, trigger BeforeUpdate and
 // AfterUpdate if any. Throws ErrNewDocument if doc is a new document.
-func (s *StoreFixtureStore) Update(doc *StoreFixture) error {
+func (s *FamilyBirdFixtureStore) Update(doc *FamilyBirdFixture) error {
 
 	err := s.Store.Update(doc)
 	if err != nil {
@@ -1360,10 +2205,23 @@ func (s *StoreFixtureStore) Update(doc *StoreFixture) error {
 	return nil
 }
 
+// UpdateDiff update the given document on the collection writing only the
+// changes from old, trigger BeforeUpdate and AfterUpdate if any. See
+// storable.Store.UpdateDiff.
+func (s *FamilyBirdFixtureStore) UpdateDiff(old, doc *FamilyBirdFixture) (storable.Changes, error) {
+
+	changes, err := s.Store.UpdateDiff(old, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
 // Save insert or update the given document on the collection using Upsert,
 // trigger BeforeUpdate and AfterUpdate if the document is non-new and
 // BeforeInsert and AfterInset if is new.
-func (s *StoreFixtureStore) Save(doc *StoreFixture) (updated bool, err error) {
+func (s *FamilyBirdFixtureStore) Save(doc *FamilyBirdFixture) (updated bool, err error) {
 	updated, err = s.Store.Save(doc)
 	if err != nil {
 		return false, err
@@ -1372,12 +2230,26 @@ func (s *StoreFixtureStore) Save(doc *StoreFixture) (updated bool, err error) {
 	return
 }
 
-type StoreFixtureQuery struct {
+// History returns the prior versions of the document with the given id.
+func (s *FamilyBirdFixtureStore) History(id bson.ObjectId) ([]*storable.Revision, error) {
+	return s.Store.History(id)
+}
+
+// AsOf returns the version of the document with the given id at the given
+// time, storable.ErrNotFound is returned if it did not exist at that time.
+func (s *FamilyBirdFixtureStore) AsOf(id bson.ObjectId, t time.Time) (*FamilyBirdFixture, error) {
+	var doc *FamilyBirdFixture
+	err := s.Store.AsOf(id, t, &doc)
+
+	return doc, err
+}
+
+type FamilyBirdFixtureQuery struct {
 	storable.BaseQuery
 }
 
 // FindById add a new criteria to the query searching by _id
-func (q *StoreFixtureQuery) FindById(ids ...bson.ObjectId) *StoreFixtureQuery {
+func (q *FamilyBirdFixtureQuery) FindById(ids ...bson.ObjectId) *FamilyBirdFixtureQuery {
 	var vs []interface{}
 	for _, id := range ids {
 		vs = append(vs, id)
@@ -1387,30 +2259,35 @@ func (q *StoreFixtureQuery) FindById(ids ...bson.ObjectId) *StoreFixtureQuery {
 	return q
 }
 
-type StoreFixtureResultSet struct {
+// Clone returns a copy of the query, see storable.BaseQuery.Clone.
+func (q *FamilyBirdFixtureQuery) Clone() *FamilyBirdFixtureQuery {
+	return &FamilyBirdFixtureQuery{*q.BaseQuery.Clone()}
+}
+
+type FamilyBirdFixtureResultSet struct {
 	storable.ResultSet
-	last    *StoreFixture
+	last    *FamilyBirdFixture
 	lastErr error
 }
 
 // All returns all documents on the resultset and close the resultset
-func (r *StoreFixtureResultSet) All() ([]*StoreFixture, error) {
-	var result []*StoreFixture
+func (r *FamilyBirdFixtureResultSet) All() ([]*FamilyBirdFixture, error) {
+	var result []*FamilyBirdFixture
 	err := r.ResultSet.All(&result)
 
 	return result, err
 }
 
 // One returns the first document on the resultset and close the resultset
-func (r *StoreFixtureResultSet) One() (*StoreFixture, error) {
-	var result *StoreFixture
+func (r *FamilyBirdFixtureResultSet) One() (*FamilyBirdFixture, error) {
+	var result *FamilyBirdFixture
 	err := r.ResultSet.One(&result)
 
 	return result, err
 }
 
 // Next prepares the next result document for reading with the Get method.
-func (r *StoreFixtureResultSet) Next() (returned bool) {
+func (r *FamilyBirdFixtureResultSet) Next() (returned bool) {
 	r.last = nil
 	returned, r.lastErr = r.ResultSet.Next(&r.last)
 
@@ -1418,14 +2295,14 @@ func (r *StoreFixtureResultSet) Next() (returned bool) {
 }
 
 // Get returns the document retrieved with the Next method.
-func (r *StoreFixtureResultSet) Get() (*StoreFixture, error) {
+func (r *FamilyBirdFixtureResultSet) Get() (*FamilyBirdFixture, error) {
 	return r.last, r.lastErr
 }
 
 // ForEach iterates the resultset calling to the given function.
-func (r *StoreFixtureResultSet) ForEach(f func(*StoreFixture) error) error {
+func (r *FamilyBirdFixtureResultSet) ForEach(f func(*FamilyBirdFixture) error) error {
 	for {
-		var result *StoreFixture
+		var result *FamilyBirdFixture
 		found, err := r.ResultSet.Next(&result)
 		if err != nil {
 			return err
@@ -1448,17 +2325,95 @@ func (r *StoreFixtureResultSet) ForEach(f func(*StoreFixture) error) error {
 	return nil
 }
 
-type StoreWithConstructFixtureStore struct {
+// Watch returns a stream with the changes on the documents matching the given
+// query, the current version of the document is retrieved on every update.
+// See storable.Store.Watch.
+func (s *FamilyBirdFixtureStore) Watch(query *FamilyBirdFixtureQuery, opts storable.WatchOptions) (*FamilyBirdFixtureChangeStream, error) {
+	opts.FullDocument = true
+	cs, err := s.Store.Watch(query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FamilyBirdFixtureChangeStream{ChangeStream: cs}, nil
+}
+
+type FamilyBirdFixtureChangeStream struct {
+	*storable.ChangeStream
+	event   storable.ChangeEvent
+	last    *FamilyBirdFixture
+	lastErr error
+}
+
+// Next waits for the next event, to be read with the Get method. Returns
+// false when the stream is closed or fails.
+func (cs *FamilyBirdFixtureChangeStream) Next() (returned bool) {
+	cs.event, cs.last, cs.lastErr = storable.ChangeEvent{}, nil, nil
+	if !cs.ChangeStream.Next(&cs.event) {
+		cs.lastErr = cs.ChangeStream.Err()
+		return false
+	}
+
+	if cs.event.HasDocument() {
+		cs.lastErr = cs.event.Decode(&cs.last)
+	}
+
+	return true
+}
+
+// Get returns the event and the document retrieved with the Next method, the
+// document is nil on the deletes or if it does not exist anymore.
+func (cs *FamilyBirdFixtureChangeStream) Get() (*storable.ChangeEvent, *FamilyBirdFixture, error) {
+	return &cs.event, cs.last, cs.lastErr
+}
+
+// ForEach waits for the events calling to the given function, until the
+// stream is closed or storable.ErrStop is returned.
+func (cs *FamilyBirdFixtureChangeStream) ForEach(f func(*storable.ChangeEvent, *FamilyBirdFixture) error) error {
+	for cs.Next() {
+		event, doc, err := cs.Get()
+		if err != nil {
+			return err
+		}
+
+		err = f(event, doc)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return cs.lastErr
+}
+
+type FamilyCatFixtureStore struct {
 	storable.Store
 }
 
-func NewStoreWithConstructFixtureStore(db *mgo.Database) *StoreWithConstructFixtureStore {
-	return &StoreWithConstructFixtureStore{*storable.NewStore(db, "store_construct")}
+func NewFamilyCatFixtureStore(db *mgo.Database) *FamilyCatFixtureStore {
+	s := &FamilyCatFixtureStore{*storable.NewStore(db, "animals")}
+	s.SetKind("cat")
+	return s
 }
 
-// New returns a new instance of StoreWithConstructFixture.
-func (s *StoreWithConstructFixtureStore) New(f string) (doc *StoreWithConstructFixture) {
-	doc = newStoreWithConstructFixture(f)
+// WithContext returns a copy of the store using the given context, see
+// storable.Store.WithContext.
+func (s *FamilyCatFixtureStore) WithContext(ctx context.Context) *FamilyCatFixtureStore {
+	return &FamilyCatFixtureStore{*s.Store.WithContext(ctx)}
+}
+
+// InTx returns a copy of the store running its operations, including the
+// hooks, inside the given transaction. See storable.WithTransaction.
+func (s *FamilyCatFixtureStore) InTx(tx *storable.Tx) *FamilyCatFixtureStore {
+	return &FamilyCatFixtureStore{*s.Store.InTx(tx)}
+}
+
+// New returns a new instance of FamilyCatFixture.
+func (s *FamilyCatFixtureStore) New() (doc *FamilyCatFixture) {
+	doc = &FamilyCatFixture{}
 	if doc != nil {
 		doc.SetIsNew(true)
 		doc.SetId(bson.NewObjectId())
@@ -1466,30 +2421,41 @@ func (s *StoreWithConstructFixtureStore) New(f string) (doc *StoreWithConstructF
 	return
 }
 
-// Query return a new instance of StoreWithConstructFixtureQuery.
-func (s *StoreWithConstructFixtureStore) Query() *StoreWithConstructFixtureQuery {
-	return &StoreWithConstructFixtureQuery{*storable.NewBaseQuery()}
+// Query return a new instance of FamilyCatFixtureQuery.
+func (s *FamilyCatFixtureStore) Query() *FamilyCatFixtureQuery {
+	return &FamilyCatFixtureQuery{*storable.NewBaseQuery()}
 }
 
 // Find performs a find on the collection using the given query.
-func (s *StoreWithConstructFixtureStore) Find(query *StoreWithConstructFixtureQuery) (*StoreWithConstructFixtureResultSet, error) {
+func (s *FamilyCatFixtureStore) Find(query *FamilyCatFixtureQuery) (*FamilyCatFixtureResultSet, error) {
 	resultSet, err := s.Store.Find(query)
 	if err != nil {
 		return nil, err
 	}
 
-	return &StoreWithConstructFixtureResultSet{ResultSet: *resultSet}, nil
+	return &FamilyCatFixtureResultSet{ResultSet: *resultSet}, nil
 }
 
 // MustFind like Find but panics on error
-func (s *StoreWithConstructFixtureStore) MustFind(query *StoreWithConstructFixtureQuery) *StoreWithConstructFixtureResultSet {
+func (s *FamilyCatFixtureStore) MustFind(query *FamilyCatFixtureQuery) *FamilyCatFixtureResultSet {
 	resultSet := s.Store.MustFind(query)
-	return &StoreWithConstructFixtureResultSet{ResultSet: *resultSet}
+	return &FamilyCatFixtureResultSet{ResultSet: *resultSet}
+}
+
+// Tail performs a find on the capped collection using the given query,
+// waiting for new documents up to the given timeout. See storable.Store.Tail.
+func (s *FamilyCatFixtureStore) Tail(query *FamilyCatFixtureQuery, timeout time.Duration) (*FamilyCatFixtureResultSet, error) {
+	resultSet, err := s.Store.Tail(query, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FamilyCatFixtureResultSet{ResultSet: *resultSet}, nil
 }
 
 // FindOne performs a find on the collection using the given query returning
 // the first document from the resultset.
-func (s *StoreWithConstructFixtureStore) FindOne(query *StoreWithConstructFixtureQuery) (*StoreWithConstructFixture, error) {
+func (s *FamilyCatFixtureStore) FindOne(query *FamilyCatFixtureQuery) (*FamilyCatFixture, error) {
 	resultSet, err := s.Find(query)
 	if err != nil {
 		return nil, err
@@ -1499,7 +2465,7 @@ func (s *StoreWithConstructFixtureStore) FindOne(query *StoreWithConstructFixtur
 }
 
 // MustFindOne like FindOne but panics on error
-func (s *StoreWithConstructFixtureStore) MustFindOne(query *StoreWithConstructFixtureQuery) *StoreWithConstructFixture {
+func (s *FamilyCatFixtureStore) MustFindOne(query *FamilyCatFixtureQuery) *FamilyCatFixture {
 	doc, err := s.FindOne(query)
 	if err != nil {
 		panic(err)
@@ -1508,9 +2474,19 @@ func (s *StoreWithConstructFixtureStore) MustFindOne(query *StoreWithConstructFi
 	return doc
 }
 
+// FindAndModify atomically modifies the first document matching the given
+// query and returns it, before the change or after it if change.ReturnNew is
+// set. See storable.Store.FindAndModify.
+func (s *FamilyCatFixtureStore) FindAndModify(query *FamilyCatFixtureQuery, change mgo.Change) (*FamilyCatFixture, error) {
+	var result *FamilyCatFixture
+	_, err := s.Store.FindAndModify(query, change, &result)
+
+	return result, err
+}
+
 // Insert insert the given document on the collection, trigger BeforeInsert and
 // AfterInsert if any. Throws ErrNonNewDocument if doc is a non-new document.
-func (s *StoreWithConstructFixtureStore) Insert(doc *StoreWithConstructFixture) error {
+func (s *FamilyCatFixtureStore) Insert(doc *FamilyCatFixture) error {
 
 	err := s.Store.Insert(doc)
 	if err != nil {
@@ -1522,7 +2498,7 @@ func (s *StoreWithConstructFixtureStore) Insert(doc *StoreWithConstructFixture)
 
 // Update update the given document on the collection, trigger BeforeUpdate and
 // AfterUpdate if any. Throws ErrNewDocument if doc is a new document.
-func (s *StoreWithConstructFixtureStore) Update(doc *StoreWithConstructFixture) error {
+func (s *FamilyCatFixtureStore) Update(doc *FamilyCatFixture) error {
 
 	err := s.Store.Update(doc)
 	if err != nil {
@@ -1532,10 +2508,23 @@ func (s *StoreWithConstructFixtureStore) Update(doc *StoreWithConstructFixture)
 	return nil
 }
 
+// UpdateDiff update the given document on the collection writing only the
+// changes from old, trigger BeforeUpdate and AfterUpdate if any. See
+// storable.Store.UpdateDiff.
+func (s *FamilyCatFixtureStore) UpdateDiff(old, doc *FamilyCatFixture) (storable.Changes, error) {
+
+	changes, err := s.Store.UpdateDiff(old, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
 // Save insert or update the given document on the collection using Upsert,
 // trigger BeforeUpdate and AfterUpdate if the document is non-new and
 // BeforeInsert and AfterInset if is new.
-func (s *StoreWithConstructFixtureStore) Save(doc *StoreWithConstructFixture) (updated bool, err error) {
+func (s *FamilyCatFixtureStore) Save(doc *FamilyCatFixture) (updated bool, err error) {
 	updated, err = s.Store.Save(doc)
 	if err != nil {
 		return false, err
@@ -1544,12 +2533,12 @@ func (s *StoreWithConstructFixtureStore) Save(doc *StoreWithConstructFixture) (u
 	return
 }
 
-type StoreWithConstructFixtureQuery struct {
+type FamilyCatFixtureQuery struct {
 	storable.BaseQuery
 }
 
 // FindById add a new criteria to the query searching by _id
-func (q *StoreWithConstructFixtureQuery) FindById(ids ...bson.ObjectId) *StoreWithConstructFixtureQuery {
+func (q *FamilyCatFixtureQuery) FindById(ids ...bson.ObjectId) *FamilyCatFixtureQuery {
 	var vs []interface{}
 	for _, id := range ids {
 		vs = append(vs, id)
@@ -1559,30 +2548,35 @@ func (q *StoreWithConstructFixtureQuery) FindById(ids ...bson.ObjectId) *StoreWi
 	return q
 }
 
-type StoreWithConstructFixtureResultSet struct {
+// Clone returns a copy of the query, see storable.BaseQuery.Clone.
+func (q *FamilyCatFixtureQuery) Clone() *FamilyCatFixtureQuery {
+	return &FamilyCatFixtureQuery{*q.BaseQuery.Clone()}
+}
+
+type FamilyCatFixtureResultSet struct {
 	storable.ResultSet
-	last    *StoreWithConstructFixture
+	last    *FamilyCatFixture
 	lastErr error
 }
 
 // All returns all documents on the resultset and close the resultset
-func (r *StoreWithConstructFixtureResultSet) All() ([]*StoreWithConstructFixture, error) {
-	var result []*StoreWithConstructFixture
+func (r *FamilyCatFixtureResultSet) All() ([]*FamilyCatFixture, error) {
+	var result []*FamilyCatFixture
 	err := r.ResultSet.All(&result)
 
 	return result, err
 }
 
 // One returns the first document on the resultset and close the resultset
-func (r *StoreWithConstructFixtureResultSet) One() (*StoreWithConstructFixture, error) {
-	var result *StoreWithConstructFixture
+func (r *FamilyCatFixtureResultSet) One() (*FamilyCatFixture, error) {
+	var result *FamilyCatFixture
 	err := r.ResultSet.One(&result)
 
 	return result, err
 }
 
 // Next prepares the next result document for reading with the Get method.
-func (r *StoreWithConstructFixtureResultSet) Next() (returned bool) {
+func (r *FamilyCatFixtureResultSet) Next() (returned bool) {
 	r.last = nil
 	returned, r.lastErr = r.ResultSet.Next(&r.last)
 
@@ -1590,14 +2584,14 @@ func (r *StoreWithConstructFixtureResultSet) Next() (returned bool) {
 }
 
 // Get returns the document retrieved with the Next method.
-func (r *StoreWithConstructFixtureResultSet) Get() (*StoreWithConstructFixture, error) {
+func (r *FamilyCatFixtureResultSet) Get() (*FamilyCatFixture, error) {
 	return r.last, r.lastErr
 }
 
 // ForEach iterates the resultset calling to the given function.
-func (r *StoreWithConstructFixtureResultSet) ForEach(f func(*StoreWithConstructFixture) error) error {
+func (r *FamilyCatFixtureResultSet) ForEach(f func(*FamilyCatFixture) error) error {
 	for {
-		var result *StoreWithConstructFixture
+		var result *FamilyCatFixture
 		found, err := r.ResultSet.Next(&result)
 		if err != nil {
 			return err
@@ -1620,38 +2614,137 @@ func (r *StoreWithConstructFixtureResultSet) ForEach(f func(*StoreWithConstructF
 	return nil
 }
 
-type StoreWithNewFixtureStore struct {
+// Watch returns a stream with the changes on the documents matching the given
+// query, the current version of the document is retrieved on every update.
+// See storable.Store.Watch.
+func (s *FamilyCatFixtureStore) Watch(query *FamilyCatFixtureQuery, opts storable.WatchOptions) (*FamilyCatFixtureChangeStream, error) {
+	opts.FullDocument = true
+	cs, err := s.Store.Watch(query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FamilyCatFixtureChangeStream{ChangeStream: cs}, nil
+}
+
+type FamilyCatFixtureChangeStream struct {
+	*storable.ChangeStream
+	event   storable.ChangeEvent
+	last    *FamilyCatFixture
+	lastErr error
+}
+
+// Next waits for the next event, to be read with the Get method. Returns
+// false when the stream is closed or fails.
+func (cs *FamilyCatFixtureChangeStream) Next() (returned bool) {
+	cs.event, cs.last, cs.lastErr = storable.ChangeEvent{}, nil, nil
+	if !cs.ChangeStream.Next(&cs.event) {
+		cs.lastErr = cs.ChangeStream.Err()
+		return false
+	}
+
+	if cs.event.HasDocument() {
+		cs.lastErr = cs.event.Decode(&cs.last)
+	}
+
+	return true
+}
+
+// Get returns the event and the document retrieved with the Next method, the
+// document is nil on the deletes or if it does not exist anymore.
+func (cs *FamilyCatFixtureChangeStream) Get() (*storable.ChangeEvent, *FamilyCatFixture, error) {
+	return &cs.event, cs.last, cs.lastErr
+}
+
+// ForEach waits for the events calling to the given function, until the
+// stream is closed or storable.ErrStop is returned.
+func (cs *FamilyCatFixtureChangeStream) ForEach(f func(*storable.ChangeEvent, *FamilyCatFixture) error) error {
+	for cs.Next() {
+		event, doc, err := cs.Get()
+		if err != nil {
+			return err
+		}
+
+		err = f(event, doc)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return cs.lastErr
+}
+
+type FamilyDogFixtureStore struct {
 	storable.Store
 }
 
-func NewStoreWithNewFixtureStore(db *mgo.Database) *StoreWithNewFixtureStore {
-	return &StoreWithNewFixtureStore{*storable.NewStore(db, "store_new")}
+func NewFamilyDogFixtureStore(db *mgo.Database) *FamilyDogFixtureStore {
+	s := &FamilyDogFixtureStore{*storable.NewStore(db, "animals")}
+	s.SetKind("dog")
+	return s
 }
 
-// Query return a new instance of StoreWithNewFixtureQuery.
-func (s *StoreWithNewFixtureStore) Query() *StoreWithNewFixtureQuery {
-	return &StoreWithNewFixtureQuery{*storable.NewBaseQuery()}
+// WithContext returns a copy of the store using the given context, see
+// storable.Store.WithContext.
+func (s *FamilyDogFixtureStore) WithContext(ctx context.Context) *FamilyDogFixtureStore {
+	return &FamilyDogFixtureStore{*s.Store.WithContext(ctx)}
+}
+
+// InTx returns a copy of the store running its operations, including the
+// hooks, inside the given transaction. See storable.WithTransaction.
+func (s *FamilyDogFixtureStore) InTx(tx *storable.Tx) *FamilyDogFixtureStore {
+	return &FamilyDogFixtureStore{*s.Store.InTx(tx)}
+}
+
+// New returns a new instance of FamilyDogFixture.
+func (s *FamilyDogFixtureStore) New() (doc *FamilyDogFixture) {
+	doc = &FamilyDogFixture{}
+	if doc != nil {
+		doc.SetIsNew(true)
+		doc.SetId(bson.NewObjectId())
+	}
+	return
+}
+
+// Query return a new instance of FamilyDogFixtureQuery.
+func (s *FamilyDogFixtureStore) Query() *FamilyDogFixtureQuery {
+	return &FamilyDogFixtureQuery{*storable.NewBaseQuery()}
 }
 
 // Find performs a find on the collection using the given query.
-func (s *StoreWithNewFixtureStore) Find(query *StoreWithNewFixtureQuery) (*StoreWithNewFixtureResultSet, error) {
+func (s *FamilyDogFixtureStore) Find(query *FamilyDogFixtureQuery) (*FamilyDogFixtureResultSet, error) {
 	resultSet, err := s.Store.Find(query)
 	if err != nil {
 		return nil, err
 	}
 
-	return &StoreWithNewFixtureResultSet{ResultSet: *resultSet}, nil
+	return &FamilyDogFixtureResultSet{ResultSet: *resultSet}, nil
 }
 
 // MustFind like Find but panics on error
-func (s *StoreWithNewFixtureStore) MustFind(query *StoreWithNewFixtureQuery) *StoreWithNewFixtureResultSet {
+func (s *FamilyDogFixtureStore) MustFind(query *FamilyDogFixtureQuery) *FamilyDogFixtureResultSet {
 	resultSet := s.Store.MustFind(query)
-	return &StoreWithNewFixtureResultSet{ResultSet: *resultSet}
+	return &FamilyDogFixtureResultSet{ResultSet: *resultSet}
+}
+
+// Tail performs a find on the capped collection using the given query,
+// waiting for new documents up to the given timeout. See storable.Store.Tail.
+func (s *FamilyDogFixtureStore) Tail(query *FamilyDogFixtureQuery, timeout time.Duration) (*FamilyDogFixtureResultSet, error) {
+	resultSet, err := s.Store.Tail(query, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FamilyDogFixtureResultSet{ResultSet: *resultSet}, nil
 }
 
 // FindOne performs a find on the collection using the given query returning
 // the first document from the resultset.
-func (s *StoreWithNewFixtureStore) FindOne(query *StoreWithNewFixtureQuery) (*StoreWithNewFixture, error) {
+func (s *FamilyDogFixtureStore) FindOne(query *FamilyDogFixtureQuery) (*FamilyDogFixture, error) {
 	resultSet, err := s.Find(query)
 	if err != nil {
 		return nil, err
@@ -1661,7 +2754,7 @@ func (s *StoreWithNewFixtureStore) FindOne(query *StoreWithNewFixtureQuery) (*St
 }
 
 // MustFindOne like FindOne but panics on error
-func (s *StoreWithNewFixtureStore) MustFindOne(query *StoreWithNewFixtureQuery) *StoreWithNewFixture {
+func (s *FamilyDogFixtureStore) MustFindOne(query *FamilyDogFixtureQuery) *FamilyDogFixture {
 	doc, err := s.FindOne(query)
 	if err != nil {
 		panic(err)
@@ -1670,9 +2763,19 @@ func (s *StoreWithNewFixtureStore) MustFindOne(query *StoreWithNewFixtureQuery)
 	return doc
 }
 
+// FindAndModify atomically modifies the first document matching the given
+// query and returns it, before the change or after it if change.ReturnNew is
+// set. See storable.Store.FindAndModify.
+func (s *FamilyDogFixtureStore) FindAndModify(query *FamilyDogFixtureQuery, change mgo.Change) (*FamilyDogFixture, error) {
+	var result *FamilyDogFixture
+	_, err := s.Store.FindAndModify(query, change, &result)
+
+	return result, err
+}
+
 // Insert insert the given document on the collection, trigger BeforeInsert and
 // AfterInsert if any. Throws ErrNonNewDocument if doc is a non-new document.
-func (s *StoreWithNewFixtureStore) Insert(doc *StoreWithNewFixture) error {
+func (s *FamilyDogFixtureStore) Insert(doc *FamilyDogFixture) error {
 
 	err := s.Store.Insert(doc)
 	if err != nil {
@@ -1684,7 +2787,7 @@ func (s *StoreWithNewFixtureStore) Insert(doc *StoreWithNewFixture) error {
 
 // Update update the given document on the collection, trigger BeforeUpdate and
 // AfterUpdate if any. Throws ErrNewDocument if doc is a new document.
-func (s *StoreWithNewFixtureStore) Update(doc *StoreWithNewFixture) error {
+func (s *FamilyDogFixtureStore) Update(doc *FamilyDogFixture) error {
 
 	err := s.Store.Update(doc)
 	if err != nil {
@@ -1694,10 +2797,23 @@ func (s *StoreWithNewFixtureStore) Update(doc *StoreWithNewFixture) error {
 	return nil
 }
 
+// UpdateDiff update the given document on the collection writing only the
+// changes from old, trigger BeforeUpdate and AfterUpdate if any. See
+// storable.Store.UpdateDiff.
+func (s *FamilyDogFixtureStore) UpdateDiff(old, doc *FamilyDogFixture) (storable.Changes, error) {
+
+	changes, err := s.Store.UpdateDiff(old, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
 // Save insert or update the given document on the collection using Upsert,
 // trigger BeforeUpdate and AfterUpdate if the document is non-new and
 // BeforeInsert and AfterInset if is new.
-func (s *StoreWithNewFixtureStore) Save(doc *StoreWithNewFixture) (updated bool, err error) {
+func (s *FamilyDogFixtureStore) Save(doc *FamilyDogFixture) (updated bool, err error) {
 	updated, err = s.Store.Save(doc)
 	if err != nil {
 		return false, err
@@ -1706,12 +2822,12 @@ func (s *StoreWithNewFixtureStore) Save(doc *StoreWithNewFixture) (updated bool,
 	return
 }
 
-type StoreWithNewFixtureQuery struct {
+type FamilyDogFixtureQuery struct {
 	storable.BaseQuery
 }
 
 // FindById add a new criteria to the query searching by _id
-func (q *StoreWithNewFixtureQuery) FindById(ids ...bson.ObjectId) *StoreWithNewFixtureQuery {
+func (q *FamilyDogFixtureQuery) FindById(ids ...bson.ObjectId) *FamilyDogFixtureQuery {
 	var vs []interface{}
 	for _, id := range ids {
 		vs = append(vs, id)
@@ -1721,30 +2837,4591 @@ func (q *StoreWithNewFixtureQuery) FindById(ids ...bson.ObjectId) *StoreWithNewF
 	return q
 }
 
-type StoreWithNewFixtureResultSet struct {
+// Clone returns a copy of the query, see storable.BaseQuery.Clone.
+func (q *FamilyDogFixtureQuery) Clone() *FamilyDogFixtureQuery {
+	return &FamilyDogFixtureQuery{*q.BaseQuery.Clone()}
+}
+
+type FamilyDogFixtureResultSet struct {
 	storable.ResultSet
-	last    *StoreWithNewFixture
+	last    *FamilyDogFixture
 	lastErr error
 }
 
 // All returns all documents on the resultset and close the resultset
-func (r *StoreWithNewFixtureResultSet) All() ([]*StoreWithNewFixture, error) {
-	var result []*StoreWithNewFixture
+func (r *FamilyDogFixtureResultSet) All() ([]*FamilyDogFixture, error) {
+	var result []*FamilyDogFixture
 	err := r.ResultSet.All(&result)
 
 	return result, err
 }
 
 // One returns the first document on the resultset and close the resultset
-func (r *StoreWithNewFixtureResultSet) One() (*StoreWithNewFixture, error) {
-	var result *StoreWithNewFixture
+func (r *FamilyDogFixtureResultSet) One() (*FamilyDogFixture, error) {
+	var result *FamilyDogFixture
 	err := r.ResultSet.One(&result)
 
 	return result, err
 }
 
 // Next prepares the next result document for reading with the Get method.
-func (r *StoreWithNewFixtureResultSet) Next() (returned bool) {
+func (r *FamilyDogFixtureResultSet) Next() (returned bool) {
+	r.last = nil
+	returned, r.lastErr = r.ResultSet.Next(&r.last)
+
+	return
+}
+
+// Get returns the document retrieved with the Next method.
+func (r *FamilyDogFixtureResultSet) Get() (*FamilyDogFixture, error) {
+	return r.last, r.lastErr
+}
+
+// ForEach iterates the resultset calling to the given function.
+func (r *FamilyDogFixtureResultSet) ForEach(f func(*FamilyDogFixture) error) error {
+	for {
+		var result *FamilyDogFixture
+		found, err := r.ResultSet.Next(&result)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			break
+		}
+
+		err = f(result)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Watch returns a stream with the changes on the documents matching the given
+// query, the current version of the document is retrieved on every update.
+// See storable.Store.Watch.
+func (s *FamilyDogFixtureStore) Watch(query *FamilyDogFixtureQuery, opts storable.WatchOptions) (*FamilyDogFixtureChangeStream, error) {
+	opts.FullDocument = true
+	cs, err := s.Store.Watch(query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FamilyDogFixtureChangeStream{ChangeStream: cs}, nil
+}
+
+type FamilyDogFixtureChangeStream struct {
+	*storable.ChangeStream
+	event   storable.ChangeEvent
+	last    *FamilyDogFixture
+	lastErr error
+}
+
+// Next waits for the next event, to be read with the Get method. Returns
+// false when the stream is closed or fails.
+func (cs *FamilyDogFixtureChangeStream) Next() (returned bool) {
+	cs.event, cs.last, cs.lastErr = storable.ChangeEvent{}, nil, nil
+	if !cs.ChangeStream.Next(&cs.event) {
+		cs.lastErr = cs.ChangeStream.Err()
+		return false
+	}
+
+	if cs.event.HasDocument() {
+		cs.lastErr = cs.event.Decode(&cs.last)
+	}
+
+	return true
+}
+
+// Get returns the event and the document retrieved with the Next method, the
+// document is nil on the deletes or if it does not exist anymore.
+func (cs *FamilyDogFixtureChangeStream) Get() (*storable.ChangeEvent, *FamilyDogFixture, error) {
+	return &cs.event, cs.last, cs.lastErr
+}
+
+// ForEach waits for the events calling to the given function, until the
+// stream is closed or storable.ErrStop is returned.
+func (cs *FamilyDogFixtureChangeStream) ForEach(f func(*storable.ChangeEvent, *FamilyDogFixture) error) error {
+	for cs.Next() {
+		event, doc, err := cs.Get()
+		if err != nil {
+			return err
+		}
+
+		err = f(event, doc)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return cs.lastErr
+}
+
+type FindersFixtureStore struct {
+	storable.Store
+}
+
+func NewFindersFixtureStore(db *mgo.Database) *FindersFixtureStore {
+	return &FindersFixtureStore{*storable.NewStore(db, "finders")}
+}
+
+// WithContext returns a copy of the store using the given context, see
+// storable.Store.WithContext.
+func (s *FindersFixtureStore) WithContext(ctx context.Context) *FindersFixtureStore {
+	return &FindersFixtureStore{*s.Store.WithContext(ctx)}
+}
+
+// InTx returns a copy of the store running its operations, including the
+// hooks, inside the given transaction. See storable.WithTransaction.
+func (s *FindersFixtureStore) InTx(tx *storable.Tx) *FindersFixtureStore {
+	return &FindersFixtureStore{*s.Store.InTx(tx)}
+}
+
+// New returns a new instance of FindersFixture.
+func (s *FindersFixtureStore) New(name string, status int, tags []string) (doc *FindersFixture) {
+	doc = newFindersFixture(name, status, tags)
+	if doc != nil {
+		doc.SetIsNew(true)
+		doc.SetId(bson.NewObjectId())
+	}
+	return
+}
+
+// Query return a new instance of FindersFixtureQuery.
+func (s *FindersFixtureStore) Query() *FindersFixtureQuery {
+	return &FindersFixtureQuery{*storable.NewBaseQuery()}
+}
+
+// Find performs a find on the collection using the given query.
+func (s *FindersFixtureStore) Find(query *FindersFixtureQuery) (*FindersFixtureResultSet, error) {
+	resultSet, err := s.Store.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FindersFixtureResultSet{ResultSet: *resultSet}, nil
+}
+
+// MustFind like Find but panics on error
+func (s *FindersFixtureStore) MustFind(query *FindersFixtureQuery) *FindersFixtureResultSet {
+	resultSet := s.Store.MustFind(query)
+	return &FindersFixtureResultSet{ResultSet: *resultSet}
+}
+
+// Tail performs a find on the capped collection using the given query,
+// waiting for new documents up to the given timeout. See storable.Store.Tail.
+func (s *FindersFixtureStore) Tail(query *FindersFixtureQuery, timeout time.Duration) (*FindersFixtureResultSet, error) {
+	resultSet, err := s.Store.Tail(query, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FindersFixtureResultSet{ResultSet: *resultSet}, nil
+}
+
+// FindOne performs a find on the collection using the given query returning
+// the first document from the resultset.
+func (s *FindersFixtureStore) FindOne(query *FindersFixtureQuery) (*FindersFixture, error) {
+	resultSet, err := s.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultSet.One()
+}
+
+// MustFindOne like FindOne but panics on error
+func (s *FindersFixtureStore) MustFindOne(query *FindersFixtureQuery) *FindersFixture {
+	doc, err := s.FindOne(query)
+	if err != nil {
+		panic(err)
+	}
+
+	return doc
+}
+
+// FindAndModify atomically modifies the first document matching the given
+// query and returns it, before the change or after it if change.ReturnNew is
+// set. See storable.Store.FindAndModify.
+func (s *FindersFixtureStore) FindAndModify(query *FindersFixtureQuery, change mgo.Change) (*FindersFixture, error) {
+	var result *FindersFixture
+	_, err := s.Store.FindAndModify(query, change, &result)
+
+	return result, err
+}
+
+// Insert insert the given document on the collection, trigger BeforeInsert and
+// AfterInsert if any. Throws ErrNonNewDocument if doc is a non-new document.
+func (s *FindersFixtureStore) Insert(doc *FindersFixture) error {
+
+	err := s.Store.Insert(doc)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Update update the given document on the collection, trigger BeforeUpdate and
+// AfterUpdate if any. Throws ErrNewDocument if doc is a new document.
+func (s *FindersFixtureStore) Update(doc *FindersFixture) error {
+
+	err := s.Store.Update(doc)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateDiff update the given document on the collection writing only the
+// changes from old, trigger BeforeUpdate and AfterUpdate if any. See
+// storable.Store.UpdateDiff.
+func (s *FindersFixtureStore) UpdateDiff(old, doc *FindersFixture) (storable.Changes, error) {
+
+	changes, err := s.Store.UpdateDiff(old, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// Save insert or update the given document on the collection using Upsert,
+// trigger BeforeUpdate and AfterUpdate if the document is non-new and
+// BeforeInsert and AfterInset if is new.
+func (s *FindersFixtureStore) Save(doc *FindersFixture) (updated bool, err error) {
+	updated, err = s.Store.Save(doc)
+	if err != nil {
+		return false, err
+	}
+
+	return
+}
+
+// CountByStatusNot implements the finder declared on FindersFixtureFinders.
+func (s *FindersFixtureStore) CountByStatusNot(status int) (int, error) {
+	q := s.Query()
+	q.AddCriteria(operators.Ne(Schema.FindersFixture.Status, status))
+
+	return s.Store.Count(q)
+}
+
+// CountByTagsContains implements the finder declared on FindersFixtureFinders.
+func (s *FindersFixtureStore) CountByTagsContains(tag string) (int, error) {
+	q := s.Query()
+	q.AddCriteria(operators.Eq(Schema.FindersFixture.Tags, tag))
+
+	return s.Store.Count(q)
+}
+
+// FindByIdIn implements the finder declared on FindersFixtureFinders.
+func (s *FindersFixtureStore) FindByIdIn(ids ...bson.ObjectId) ([]*FindersFixture, error) {
+	q := s.Query()
+	var vs0 []interface{}
+	for _, v := range ids {
+		vs0 = append(vs0, v)
+	}
+	q.AddCriteria(operators.In(storable.IdField, vs0...))
+
+	rs, err := s.Find(q)
+	if err != nil {
+		return nil, err
+	}
+
+	return rs.All()
+}
+
+// FindByName implements the finder declared on FindersFixtureFinders.
+func (s *FindersFixtureStore) FindByName(name string) (*FindersFixture, error) {
+	q := s.Query()
+	q.AddCriteria(operators.Eq(Schema.FindersFixture.Name, name))
+
+	return s.FindOne(q)
+}
+
+// FindByNameAndStatus implements the finder declared on FindersFixtureFinders.
+func (s *FindersFixtureStore) FindByNameAndStatus(name string, status int) (*FindersFixture, error) {
+	q := s.Query()
+	q.AddCriteria(operators.Eq(Schema.FindersFixture.Name, name))
+	q.AddCriteria(operators.Eq(Schema.FindersFixture.Status, status))
+
+	return s.FindOne(q)
+}
+
+// FindByNameMatches implements the finder declared on FindersFixtureFinders.
+func (s *FindersFixtureStore) FindByNameMatches(pattern string) ([]*FindersFixture, error) {
+	q := s.Query()
+	q.AddCriteria(operators.RegEx(Schema.FindersFixture.Name, pattern, ""))
+
+	rs, err := s.Find(q)
+	if err != nil {
+		return nil, err
+	}
+
+	return rs.All()
+}
+
+// FindByPriceAmountLessThanEqual implements the finder declared on FindersFixtureFinders.
+func (s *FindersFixtureStore) FindByPriceAmountLessThanEqual(amount float64) ([]*FindersFixture, error) {
+	q := s.Query()
+	q.AddCriteria(operators.Lte(Schema.FindersFixture.Price.Amount, amount))
+
+	rs, err := s.Find(q)
+	if err != nil {
+		return nil, err
+	}
+
+	return rs.All()
+}
+
+// FindByStatusGreaterThan implements the finder declared on FindersFixtureFinders.
+func (s *FindersFixtureStore) FindByStatusGreaterThan(status int) (*FindersFixtureResultSet, error) {
+	q := s.Query()
+	q.AddCriteria(operators.Gt(Schema.FindersFixture.Status, status))
+
+	return s.Find(q)
+}
+
+// FindByStatusIn implements the finder declared on FindersFixtureFinders.
+func (s *FindersFixtureStore) FindByStatusIn(status []int) ([]*FindersFixture, error) {
+	q := s.Query()
+	var vs0 []interface{}
+	for _, v := range status {
+		vs0 = append(vs0, v)
+	}
+	q.AddCriteria(operators.In(Schema.FindersFixture.Status, vs0...))
+
+	rs, err := s.Find(q)
+	if err != nil {
+		return nil, err
+	}
+
+	return rs.All()
+}
+
+var _ FindersFixtureFinders = (*FindersFixtureStore)(nil)
+
+type FindersFixtureQuery struct {
+	storable.BaseQuery
+}
+
+// FindById add a new criteria to the query searching by _id
+func (q *FindersFixtureQuery) FindById(ids ...bson.ObjectId) *FindersFixtureQuery {
+	var vs []interface{}
+	for _, id := range ids {
+		vs = append(vs, id)
+	}
+	q.AddCriteria(operators.In(storable.IdField, vs...))
+
+	return q
+}
+
+// Clone returns a copy of the query, see storable.BaseQuery.Clone.
+func (q *FindersFixtureQuery) Clone() *FindersFixtureQuery {
+	return &FindersFixtureQuery{*q.BaseQuery.Clone()}
+}
+
+type FindersFixtureResultSet struct {
+	storable.ResultSet
+	last    *FindersFixture
+	lastErr error
+}
+
+// All returns all documents on the resultset and close the resultset
+func (r *FindersFixtureResultSet) All() ([]*FindersFixture, error) {
+	var result []*FindersFixture
+	err := r.ResultSet.All(&result)
+
+	return result, err
+}
+
+// One returns the first document on the resultset and close the resultset
+func (r *FindersFixtureResultSet) One() (*FindersFixture, error) {
+	var result *FindersFixture
+	err := r.ResultSet.One(&result)
+
+	return result, err
+}
+
+// Next prepares the next result document for reading with the Get method.
+func (r *FindersFixtureResultSet) Next() (returned bool) {
+	r.last = nil
+	returned, r.lastErr = r.ResultSet.Next(&r.last)
+
+	return
+}
+
+// Get returns the document retrieved with the Next method.
+func (r *FindersFixtureResultSet) Get() (*FindersFixture, error) {
+	return r.last, r.lastErr
+}
+
+// ForEach iterates the resultset calling to the given function.
+func (r *FindersFixtureResultSet) ForEach(f func(*FindersFixture) error) error {
+	for {
+		var result *FindersFixture
+		found, err := r.ResultSet.Next(&result)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			break
+		}
+
+		err = f(result)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Watch returns a stream with the changes on the documents matching the given
+// query, the current version of the document is retrieved on every update.
+// See storable.Store.Watch.
+func (s *FindersFixtureStore) Watch(query *FindersFixtureQuery, opts storable.WatchOptions) (*FindersFixtureChangeStream, error) {
+	opts.FullDocument = true
+	cs, err := s.Store.Watch(query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FindersFixtureChangeStream{ChangeStream: cs}, nil
+}
+
+type FindersFixtureChangeStream struct {
+	*storable.ChangeStream
+	event   storable.ChangeEvent
+	last    *FindersFixture
+	lastErr error
+}
+
+// Next waits for the next event, to be read with the Get method. Returns
+// false when the stream is closed or fails.
+func (cs *FindersFixtureChangeStream) Next() (returned bool) {
+	cs.event, cs.last, cs.lastErr = storable.ChangeEvent{}, nil, nil
+	if !cs.ChangeStream.Next(&cs.event) {
+		cs.lastErr = cs.ChangeStream.Err()
+		return false
+	}
+
+	if cs.event.HasDocument() {
+		cs.lastErr = cs.event.Decode(&cs.last)
+	}
+
+	return true
+}
+
+// Get returns the event and the document retrieved with the Next method, the
+// document is nil on the deletes or if it does not exist anymore.
+func (cs *FindersFixtureChangeStream) Get() (*storable.ChangeEvent, *FindersFixture, error) {
+	return &cs.event, cs.last, cs.lastErr
+}
+
+// ForEach waits for the events calling to the given function, until the
+// stream is closed or storable.ErrStop is returned.
+func (cs *FindersFixtureChangeStream) ForEach(f func(*storable.ChangeEvent, *FindersFixture) error) error {
+	for cs.Next() {
+		event, doc, err := cs.Get()
+		if err != nil {
+			return err
+		}
+
+		err = f(event, doc)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return cs.lastErr
+}
+
+type GeoFixtureStore struct {
+	storable.Store
+}
+
+func NewGeoFixtureStore(db *mgo.Database) *GeoFixtureStore {
+	return &GeoFixtureStore{*storable.NewStore(db, "geo")}
+}
+
+// WithContext returns a copy of the store using the given context, see
+// storable.Store.WithContext.
+func (s *GeoFixtureStore) WithContext(ctx context.Context) *GeoFixtureStore {
+	return &GeoFixtureStore{*s.Store.WithContext(ctx)}
+}
+
+// InTx returns a copy of the store running its operations, including the
+// hooks, inside the given transaction. See storable.WithTransaction.
+func (s *GeoFixtureStore) InTx(tx *storable.Tx) *GeoFixtureStore {
+	return &GeoFixtureStore{*s.Store.InTx(tx)}
+}
+
+// New returns a new instance of GeoFixture.
+func (s *GeoFixtureStore) New() (doc *GeoFixture) {
+	doc = &GeoFixture{}
+	if doc != nil {
+		doc.SetIsNew(true)
+		doc.SetId(bson.NewObjectId())
+	}
+	return
+}
+
+// Query return a new instance of GeoFixtureQuery.
+func (s *GeoFixtureStore) Query() *GeoFixtureQuery {
+	return &GeoFixtureQuery{*storable.NewBaseQuery()}
+}
+
+// Find performs a find on the collection using the given query.
+func (s *GeoFixtureStore) Find(query *GeoFixtureQuery) (*GeoFixtureResultSet, error) {
+	resultSet, err := s.Store.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GeoFixtureResultSet{ResultSet: *resultSet}, nil
+}
+
+// MustFind like Find but panics on error
+func (s *GeoFixtureStore) MustFind(query *GeoFixtureQuery) *GeoFixtureResultSet {
+	resultSet := s.Store.MustFind(query)
+	return &GeoFixtureResultSet{ResultSet: *resultSet}
+}
+
+// Tail performs a find on the capped collection using the given query,
+// waiting for new documents up to the given timeout. See storable.Store.Tail.
+func (s *GeoFixtureStore) Tail(query *GeoFixtureQuery, timeout time.Duration) (*GeoFixtureResultSet, error) {
+	resultSet, err := s.Store.Tail(query, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GeoFixtureResultSet{ResultSet: *resultSet}, nil
+}
+
+// FindOne performs a find on the collection using the given query returning
+// the first document from the resultset.
+func (s *GeoFixtureStore) FindOne(query *GeoFixtureQuery) (*GeoFixture, error) {
+	resultSet, err := s.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultSet.One()
+}
+
+// MustFindOne like FindOne but panics on error
+func (s *GeoFixtureStore) MustFindOne(query *GeoFixtureQuery) *GeoFixture {
+	doc, err := s.FindOne(query)
+	if err != nil {
+		panic(err)
+	}
+
+	return doc
+}
+
+// FindAndModify atomically modifies the first document matching the given
+// query and returns it, before the change or after it if change.ReturnNew is
+// set. See storable.Store.FindAndModify.
+func (s *GeoFixtureStore) FindAndModify(query *GeoFixtureQuery, change mgo.Change) (*GeoFixture, error) {
+	var result *GeoFixture
+	_, err := s.Store.FindAndModify(query, change, &result)
+
+	return result, err
+}
+
+// Insert insert the given document on the collection, trigger BeforeInsert and
+// AfterInsert if any. Throws ErrNonNewDocument if doc is a non-new document.
+func (s *GeoFixtureStore) Insert(doc *GeoFixture) error {
+
+	err := s.Store.Insert(doc)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Update update the given document on the collection, trigger BeforeUpdate and
+// AfterUpdate if any. Throws ErrNewDocument if doc is a new document.
+func (s *GeoFixtureStore) Update(doc *GeoFixture) error {
+
+	err := s.Store.Update(doc)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateDiff update the given document on the collection writing only the
+// changes from old, trigger BeforeUpdate and AfterUpdate if any. See
+// storable.Store.UpdateDiff.
+func (s *GeoFixtureStore) UpdateDiff(old, doc *GeoFixture) (storable.Changes, error) {
+
+	changes, err := s.Store.UpdateDiff(old, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// Save insert or update the given document on the collection using Upsert,
+// trigger BeforeUpdate and AfterUpdate if the document is non-new and
+// BeforeInsert and AfterInset if is new.
+func (s *GeoFixtureStore) Save(doc *GeoFixture) (updated bool, err error) {
+	updated, err = s.Store.Save(doc)
+	if err != nil {
+		return false, err
+	}
+
+	return
+}
+
+type GeoFixtureQuery struct {
+	storable.BaseQuery
+}
+
+// FindById add a new criteria to the query searching by _id
+func (q *GeoFixtureQuery) FindById(ids ...bson.ObjectId) *GeoFixtureQuery {
+	var vs []interface{}
+	for _, id := range ids {
+		vs = append(vs, id)
+	}
+	q.AddCriteria(operators.In(storable.IdField, vs...))
+
+	return q
+}
+
+// Clone returns a copy of the query, see storable.BaseQuery.Clone.
+func (q *GeoFixtureQuery) Clone() *GeoFixtureQuery {
+	return &GeoFixtureQuery{*q.BaseQuery.Clone()}
+}
+
+type GeoFixtureResultSet struct {
+	storable.ResultSet
+	last    *GeoFixture
+	lastErr error
+}
+
+// All returns all documents on the resultset and close the resultset
+func (r *GeoFixtureResultSet) All() ([]*GeoFixture, error) {
+	var result []*GeoFixture
+	err := r.ResultSet.All(&result)
+
+	return result, err
+}
+
+// One returns the first document on the resultset and close the resultset
+func (r *GeoFixtureResultSet) One() (*GeoFixture, error) {
+	var result *GeoFixture
+	err := r.ResultSet.One(&result)
+
+	return result, err
+}
+
+// Next prepares the next result document for reading with the Get method.
+func (r *GeoFixtureResultSet) Next() (returned bool) {
+	r.last = nil
+	returned, r.lastErr = r.ResultSet.Next(&r.last)
+
+	return
+}
+
+// Get returns the document retrieved with the Next method.
+func (r *GeoFixtureResultSet) Get() (*GeoFixture, error) {
+	return r.last, r.lastErr
+}
+
+// ForEach iterates the resultset calling to the given function.
+func (r *GeoFixtureResultSet) ForEach(f func(*GeoFixture) error) error {
+	for {
+		var result *GeoFixture
+		found, err := r.ResultSet.Next(&result)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			break
+		}
+
+		err = f(result)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Watch returns a stream with the changes on the documents matching the given
+// query, the current version of the document is retrieved on every update.
+// See storable.Store.Watch.
+func (s *GeoFixtureStore) Watch(query *GeoFixtureQuery, opts storable.WatchOptions) (*GeoFixtureChangeStream, error) {
+	opts.FullDocument = true
+	cs, err := s.Store.Watch(query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GeoFixtureChangeStream{ChangeStream: cs}, nil
+}
+
+type GeoFixtureChangeStream struct {
+	*storable.ChangeStream
+	event   storable.ChangeEvent
+	last    *GeoFixture
+	lastErr error
+}
+
+// Next waits for the next event, to be read with the Get method. Returns
+// false when the stream is closed or fails.
+func (cs *GeoFixtureChangeStream) Next() (returned bool) {
+	cs.event, cs.last, cs.lastErr = storable.ChangeEvent{}, nil, nil
+	if !cs.ChangeStream.Next(&cs.event) {
+		cs.lastErr = cs.ChangeStream.Err()
+		return false
+	}
+
+	if cs.event.HasDocument() {
+		cs.lastErr = cs.event.Decode(&cs.last)
+	}
+
+	return true
+}
+
+// Get returns the event and the document retrieved with the Next method, the
+// document is nil on the deletes or if it does not exist anymore.
+func (cs *GeoFixtureChangeStream) Get() (*storable.ChangeEvent, *GeoFixture, error) {
+	return &cs.event, cs.last, cs.lastErr
+}
+
+// ForEach waits for the events calling to the given function, until the
+// stream is closed or storable.ErrStop is returned.
+func (cs *GeoFixtureChangeStream) ForEach(f func(*storable.ChangeEvent, *GeoFixture) error) error {
+	for cs.Next() {
+		event, doc, err := cs.Get()
+		if err != nil {
+			return err
+		}
+
+		err = f(event, doc)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return cs.lastErr
+}
+
+type HistoryFixtureStore struct {
+	storable.Store
+}
+
+func NewHistoryFixtureStore(db *mgo.Database) *HistoryFixtureStore {
+	s := &HistoryFixtureStore{*storable.NewStore(db, "history")}
+	s.EnableHistory()
+	return s
+}
+
+// WithContext returns a copy of the store using the given context, see
+// storable.Store.WithContext.
+func (s *HistoryFixtureStore) WithContext(ctx context.Context) *HistoryFixtureStore {
+	return &HistoryFixtureStore{*s.Store.WithContext(ctx)}
+}
+
+// InTx returns a copy of the store running its operations, including the
+// hooks, inside the given transaction. See storable.WithTransaction.
+func (s *HistoryFixtureStore) InTx(tx *storable.Tx) *HistoryFixtureStore {
+	return &HistoryFixtureStore{*s.Store.InTx(tx)}
+}
+
+// New returns a new instance of HistoryFixture.
+func (s *HistoryFixtureStore) New() (doc *HistoryFixture) {
+	doc = &HistoryFixture{}
+	if doc != nil {
+		doc.SetIsNew(true)
+		doc.SetId(bson.NewObjectId())
+	}
+	return
+}
+
+// Query return a new instance of HistoryFixtureQuery.
+func (s *HistoryFixtureStore) Query() *HistoryFixtureQuery {
+	return &HistoryFixtureQuery{*storable.NewBaseQuery()}
+}
+
+// Find performs a find on the collection using the given query.
+func (s *HistoryFixtureStore) Find(query *HistoryFixtureQuery) (*HistoryFixtureResultSet, error) {
+	resultSet, err := s.Store.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HistoryFixtureResultSet{ResultSet: *resultSet}, nil
+}
+
+// MustFind like Find but panics on error
+func (s *HistoryFixtureStore) MustFind(query *HistoryFixtureQuery) *HistoryFixtureResultSet {
+	resultSet := s.Store.MustFind(query)
+	return &HistoryFixtureResultSet{ResultSet: *resultSet}
+}
+
+// Tail performs a find on the capped collection using the given query,
+// waiting for new documents up to the given timeout. See storable.Store.Tail.
+func (s *HistoryFixtureStore) Tail(query *HistoryFixtureQuery, timeout time.Duration) (*HistoryFixtureResultSet, error) {
+	resultSet, err := s.Store.Tail(query, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HistoryFixtureResultSet{ResultSet: *resultSet}, nil
+}
+
+// FindOne performs a find on the collection using the given query returning
+// the first document from the resultset.
+func (s *HistoryFixtureStore) FindOne(query *HistoryFixtureQuery) (*HistoryFixture, error) {
+	resultSet, err := s.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultSet.One()
+}
+
+// MustFindOne like FindOne but panics on error
+func (s *HistoryFixtureStore) MustFindOne(query *HistoryFixtureQuery) *HistoryFixture {
+	doc, err := s.FindOne(query)
+	if err != nil {
+		panic(err)
+	}
+
+	return doc
+}
+
+// FindAndModify atomically modifies the first document matching the given
+// query and returns it, before the change or after it if change.ReturnNew is
+// set. See storable.Store.FindAndModify.
+func (s *HistoryFixtureStore) FindAndModify(query *HistoryFixtureQuery, change mgo.Change) (*HistoryFixture, error) {
+	var result *HistoryFixture
+	_, err := s.Store.FindAndModify(query, change, &result)
+
+	return result, err
+}
+
+// Insert insert the given document on the collection, trigger BeforeInsert and
+// AfterInsert if any. Throws ErrNonNewDocument if doc is a non-new document.
+func (s *HistoryFixtureStore) Insert(doc *HistoryFixture) error {
+
+	err := s.Store.Insert(doc)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Update update the given document on the collection, trigger BeforeUpdate and
+// AfterUpdate if any. Throws ErrNewDocument if doc is a new document.
+func (s *HistoryFixtureStore) Update(doc *HistoryFixture) error {
+
+	err := s.Store.Update(doc)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateDiff update the given document on the collection writing only the
+// changes from old, trigger BeforeUpdate and AfterUpdate if any. See
+// storable.Store.UpdateDiff.
+func (s *HistoryFixtureStore) UpdateDiff(old, doc *HistoryFixture) (storable.Changes, error) {
+
+	changes, err := s.Store.UpdateDiff(old, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// Save insert or update the given document on the collection using Upsert,
+// trigger BeforeUpdate and AfterUpdate if the document is non-new and
+// BeforeInsert and AfterInset if is new.
+func (s *HistoryFixtureStore) Save(doc *HistoryFixture) (updated bool, err error) {
+	updated, err = s.Store.Save(doc)
+	if err != nil {
+		return false, err
+	}
+
+	return
+}
+
+// History returns the prior versions of the document with the given id.
+func (s *HistoryFixtureStore) History(id bson.ObjectId) ([]*storable.Revision, error) {
+	return s.Store.History(id)
+}
+
+// AsOf returns the version of the document with the given id at the given
+// time, storable.ErrNotFound is returned if it did not exist at that time.
+func (s *HistoryFixtureStore) AsOf(id bson.ObjectId, t time.Time) (*HistoryFixture, error) {
+	var doc *HistoryFixture
+	err := s.Store.AsOf(id, t, &doc)
+
+	return doc, err
+}
+
+type HistoryFixtureQuery struct {
+	storable.BaseQuery
+}
+
+// FindById add a new criteria to the query searching by _id
+func (q *HistoryFixtureQuery) FindById(ids ...bson.ObjectId) *HistoryFixtureQuery {
+	var vs []interface{}
+	for _, id := range ids {
+		vs = append(vs, id)
+	}
+	q.AddCriteria(operators.In(storable.IdField, vs...))
+
+	return q
+}
+
+// Clone returns a copy of the query, see storable.BaseQuery.Clone.
+func (q *HistoryFixtureQuery) Clone() *HistoryFixtureQuery {
+	return &HistoryFixtureQuery{*q.BaseQuery.Clone()}
+}
+
+type HistoryFixtureResultSet struct {
+	storable.ResultSet
+	last    *HistoryFixture
+	lastErr error
+}
+
+// All returns all documents on the resultset and close the resultset
+func (r *HistoryFixtureResultSet) All() ([]*HistoryFixture, error) {
+	var result []*HistoryFixture
+	err := r.ResultSet.All(&result)
+
+	return result, err
+}
+
+// One returns the first document on the resultset and close the resultset
+func (r *HistoryFixtureResultSet) One() (*HistoryFixture, error) {
+	var result *HistoryFixture
+	err := r.ResultSet.One(&result)
+
+	return result, err
+}
+
+// Next prepares the next result document for reading with the Get method.
+func (r *HistoryFixtureResultSet) Next() (returned bool) {
+	r.last = nil
+	returned, r.lastErr = r.ResultSet.Next(&r.last)
+
+	return
+}
+
+// Get returns the document retrieved with the Next method.
+func (r *HistoryFixtureResultSet) Get() (*HistoryFixture, error) {
+	return r.last, r.lastErr
+}
+
+// ForEach iterates the resultset calling to the given function.
+func (r *HistoryFixtureResultSet) ForEach(f func(*HistoryFixture) error) error {
+	for {
+		var result *HistoryFixture
+		found, err := r.ResultSet.Next(&result)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			break
+		}
+
+		err = f(result)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Watch returns a stream with the changes on the documents matching the given
+// query, the current version of the document is retrieved on every update.
+// See storable.Store.Watch.
+func (s *HistoryFixtureStore) Watch(query *HistoryFixtureQuery, opts storable.WatchOptions) (*HistoryFixtureChangeStream, error) {
+	opts.FullDocument = true
+	cs, err := s.Store.Watch(query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HistoryFixtureChangeStream{ChangeStream: cs}, nil
+}
+
+type HistoryFixtureChangeStream struct {
+	*storable.ChangeStream
+	event   storable.ChangeEvent
+	last    *HistoryFixture
+	lastErr error
+}
+
+// Next waits for the next event, to be read with the Get method. Returns
+// false when the stream is closed or fails.
+func (cs *HistoryFixtureChangeStream) Next() (returned bool) {
+	cs.event, cs.last, cs.lastErr = storable.ChangeEvent{}, nil, nil
+	if !cs.ChangeStream.Next(&cs.event) {
+		cs.lastErr = cs.ChangeStream.Err()
+		return false
+	}
+
+	if cs.event.HasDocument() {
+		cs.lastErr = cs.event.Decode(&cs.last)
+	}
+
+	return true
+}
+
+// Get returns the event and the document retrieved with the Next method, the
+// document is nil on the deletes or if it does not exist anymore.
+func (cs *HistoryFixtureChangeStream) Get() (*storable.ChangeEvent, *HistoryFixture, error) {
+	return &cs.event, cs.last, cs.lastErr
+}
+
+// ForEach waits for the events calling to the given function, until the
+// stream is closed or storable.ErrStop is returned.
+func (cs *HistoryFixtureChangeStream) ForEach(f func(*storable.ChangeEvent, *HistoryFixture) error) error {
+	for cs.Next() {
+		event, doc, err := cs.Get()
+		if err != nil {
+			return err
+		}
+
+		err = f(event, doc)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return cs.lastErr
+}
+
+type MultiKeySortFixtureStore struct {
+	storable.Store
+}
+
+func NewMultiKeySortFixtureStore(db *mgo.Database) *MultiKeySortFixtureStore {
+	s := &MultiKeySortFixtureStore{*storable.NewStore(db, "query")}
+	s.SetDefaultKind("MultiKeySortFixture")
+	return s
+}
+
+// WithContext returns a copy of the store using the given context, see
+// storable.Store.WithContext.
+func (s *MultiKeySortFixtureStore) WithContext(ctx context.Context) *MultiKeySortFixtureStore {
+	return &MultiKeySortFixtureStore{*s.Store.WithContext(ctx)}
+}
+
+// InTx returns a copy of the store running its operations, including the
+// hooks, inside the given transaction. See storable.WithTransaction.
+func (s *MultiKeySortFixtureStore) InTx(tx *storable.Tx) *MultiKeySortFixtureStore {
+	return &MultiKeySortFixtureStore{*s.Store.InTx(tx)}
+}
+
+// New returns a new instance of MultiKeySortFixture.
+func (s *MultiKeySortFixtureStore) New() (doc *MultiKeySortFixture) {
+	doc = &MultiKeySortFixture{}
+	if doc != nil {
+		doc.SetIsNew(true)
+		doc.SetId(bson.NewObjectId())
+	}
+	return
+}
+
+// Query return a new instance of MultiKeySortFixtureQuery.
+func (s *MultiKeySortFixtureStore) Query() *MultiKeySortFixtureQuery {
+	return &MultiKeySortFixtureQuery{*storable.NewBaseQuery()}
+}
+
+// Find performs a find on the collection using the given query.
+func (s *MultiKeySortFixtureStore) Find(query *MultiKeySortFixtureQuery) (*MultiKeySortFixtureResultSet, error) {
+	resultSet, err := s.Store.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MultiKeySortFixtureResultSet{ResultSet: *resultSet}, nil
+}
+
+// MustFind like Find but panics on error
+func (s *MultiKeySortFixtureStore) MustFind(query *MultiKeySortFixtureQuery) *MultiKeySortFixtureResultSet {
+	resultSet := s.Store.MustFind(query)
+	return &MultiKeySortFixtureResultSet{ResultSet: *resultSet}
+}
+
+// Tail performs a find on the capped collection using the given query,
+// waiting for new documents up to the given timeout. See storable.Store.Tail.
+func (s *MultiKeySortFixtureStore) Tail(query *MultiKeySortFixtureQuery, timeout time.Duration) (*MultiKeySortFixtureResultSet, error) {
+	resultSet, err := s.Store.Tail(query, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MultiKeySortFixtureResultSet{ResultSet: *resultSet}, nil
+}
+
+// FindOne performs a find on the collection using the given query returning
+// the first document from the resultset.
+func (s *MultiKeySortFixtureStore) FindOne(query *MultiKeySortFixtureQuery) (*MultiKeySortFixture, error) {
+	resultSet, err := s.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultSet.One()
+}
+
+// MustFindOne like FindOne but panics on error
+func (s *MultiKeySortFixtureStore) MustFindOne(query *MultiKeySortFixtureQuery) *MultiKeySortFixture {
+	doc, err := s.FindOne(query)
+	if err != nil {
+		panic(err)
+	}
+
+	return doc
+}
+
+// FindAndModify atomically modifies the first document matching the given
+// query and returns it, before the change or after it if change.ReturnNew is
+// set. See storable.Store.FindAndModify.
+func (s *MultiKeySortFixtureStore) FindAndModify(query *MultiKeySortFixtureQuery, change mgo.Change) (*MultiKeySortFixture, error) {
+	var result *MultiKeySortFixture
+	_, err := s.Store.FindAndModify(query, change, &result)
+
+	return result, err
+}
+
+// Insert insert the given document on the collection, trigger BeforeInsert and
+// AfterInsert if any. Throws ErrNonNewDocument if doc is a non-new document.
+func (s *MultiKeySortFixtureStore) Insert(doc *MultiKeySortFixture) error {
+
+	err := s.Store.Insert(doc)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Update update the given document on the collection, trigger BeforeUpdate and
+// AfterUpdate if any. Throws ErrNewDocument if doc is a new document.
+func (s *MultiKeySortFixtureStore) Update(doc *MultiKeySortFixture) error {
+
+	err := s.Store.Update(doc)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateDiff update the given document on the collection writing only the
+// changes from old, trigger BeforeUpdate and AfterUpdate if any. See
+// storable.Store.UpdateDiff.
+func (s *MultiKeySortFixtureStore) UpdateDiff(old, doc *MultiKeySortFixture) (storable.Changes, error) {
+
+	changes, err := s.Store.UpdateDiff(old, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// Save insert or update the given document on the collection using Upsert,
+// trigger BeforeUpdate and AfterUpdate if the document is non-new and
+// BeforeInsert and AfterInset if is new.
+func (s *MultiKeySortFixtureStore) Save(doc *MultiKeySortFixture) (updated bool, err error) {
+	updated, err = s.Store.Save(doc)
+	if err != nil {
+		return false, err
+	}
+
+	return
+}
+
+type MultiKeySortFixtureQuery struct {
+	storable.BaseQuery
+}
+
+// FindById add a new criteria to the query searching by _id
+func (q *MultiKeySortFixtureQuery) FindById(ids ...bson.ObjectId) *MultiKeySortFixtureQuery {
+	var vs []interface{}
+	for _, id := range ids {
+		vs = append(vs, id)
+	}
+	q.AddCriteria(operators.In(storable.IdField, vs...))
+
+	return q
+}
+
+// Clone returns a copy of the query, see storable.BaseQuery.Clone.
+func (q *MultiKeySortFixtureQuery) Clone() *MultiKeySortFixtureQuery {
+	return &MultiKeySortFixtureQuery{*q.BaseQuery.Clone()}
+}
+
+type MultiKeySortFixtureResultSet struct {
+	storable.ResultSet
+	last    *MultiKeySortFixture
+	lastErr error
+}
+
+// All returns all documents on the resultset and close the resultset
+func (r *MultiKeySortFixtureResultSet) All() ([]*MultiKeySortFixture, error) {
+	var result []*MultiKeySortFixture
+	err := r.ResultSet.All(&result)
+
+	return result, err
+}
+
+// One returns the first document on the resultset and close the resultset
+func (r *MultiKeySortFixtureResultSet) One() (*MultiKeySortFixture, error) {
+	var result *MultiKeySortFixture
+	err := r.ResultSet.One(&result)
+
+	return result, err
+}
+
+// Next prepares the next result document for reading with the Get method.
+func (r *MultiKeySortFixtureResultSet) Next() (returned bool) {
+	r.last = nil
+	returned, r.lastErr = r.ResultSet.Next(&r.last)
+
+	return
+}
+
+// Get returns the document retrieved with the Next method.
+func (r *MultiKeySortFixtureResultSet) Get() (*MultiKeySortFixture, error) {
+	return r.last, r.lastErr
+}
+
+// ForEach iterates the resultset calling to the given function.
+func (r *MultiKeySortFixtureResultSet) ForEach(f func(*MultiKeySortFixture) error) error {
+	for {
+		var result *MultiKeySortFixture
+		found, err := r.ResultSet.Next(&result)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			break
+		}
+
+		err = f(result)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Watch returns a stream with the changes on the documents matching the given
+// query, the current version of the document is retrieved on every update.
+// See storable.Store.Watch.
+func (s *MultiKeySortFixtureStore) Watch(query *MultiKeySortFixtureQuery, opts storable.WatchOptions) (*MultiKeySortFixtureChangeStream, error) {
+	opts.FullDocument = true
+	cs, err := s.Store.Watch(query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MultiKeySortFixtureChangeStream{ChangeStream: cs}, nil
+}
+
+type MultiKeySortFixtureChangeStream struct {
+	*storable.ChangeStream
+	event   storable.ChangeEvent
+	last    *MultiKeySortFixture
+	lastErr error
+}
+
+// Next waits for the next event, to be read with the Get method. Returns
+// false when the stream is closed or fails.
+func (cs *MultiKeySortFixtureChangeStream) Next() (returned bool) {
+	cs.event, cs.last, cs.lastErr = storable.ChangeEvent{}, nil, nil
+	if !cs.ChangeStream.Next(&cs.event) {
+		cs.lastErr = cs.ChangeStream.Err()
+		return false
+	}
+
+	if cs.event.HasDocument() {
+		cs.lastErr = cs.event.Decode(&cs.last)
+	}
+
+	return true
+}
+
+// Get returns the event and the document retrieved with the Next method, the
+// document is nil on the deletes or if it does not exist anymore.
+func (cs *MultiKeySortFixtureChangeStream) Get() (*storable.ChangeEvent, *MultiKeySortFixture, error) {
+	return &cs.event, cs.last, cs.lastErr
+}
+
+// ForEach waits for the events calling to the given function, until the
+// stream is closed or storable.ErrStop is returned.
+func (cs *MultiKeySortFixtureChangeStream) ForEach(f func(*storable.ChangeEvent, *MultiKeySortFixture) error) error {
+	for cs.Next() {
+		event, doc, err := cs.Get()
+		if err != nil {
+			return err
+		}
+
+		err = f(event, doc)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return cs.lastErr
+}
+
+type OutboxFixtureStore struct {
+	storable.Store
+}
+
+func NewOutboxFixtureStore(db *mgo.Database) *OutboxFixtureStore {
+	return &OutboxFixtureStore{*storable.NewStore(db, "outbox")}
+}
+
+// WithContext returns a copy of the store using the given context, see
+// storable.Store.WithContext.
+func (s *OutboxFixtureStore) WithContext(ctx context.Context) *OutboxFixtureStore {
+	return &OutboxFixtureStore{*s.Store.WithContext(ctx)}
+}
+
+// InTx returns a copy of the store running its operations, including the
+// hooks, inside the given transaction. See storable.WithTransaction.
+func (s *OutboxFixtureStore) InTx(tx *storable.Tx) *OutboxFixtureStore {
+	return &OutboxFixtureStore{*s.Store.InTx(tx)}
+}
+
+// New returns a new instance of OutboxFixture.
+func (s *OutboxFixtureStore) New() (doc *OutboxFixture) {
+	doc = &OutboxFixture{}
+	if doc != nil {
+		doc.SetIsNew(true)
+		doc.SetId(bson.NewObjectId())
+	}
+	return
+}
+
+// Query return a new instance of OutboxFixtureQuery.
+func (s *OutboxFixtureStore) Query() *OutboxFixtureQuery {
+	return &OutboxFixtureQuery{*storable.NewBaseQuery()}
+}
+
+// Find performs a find on the collection using the given query.
+func (s *OutboxFixtureStore) Find(query *OutboxFixtureQuery) (*OutboxFixtureResultSet, error) {
+	resultSet, err := s.Store.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OutboxFixtureResultSet{ResultSet: *resultSet}, nil
+}
+
+// MustFind like Find but panics on error
+func (s *OutboxFixtureStore) MustFind(query *OutboxFixtureQuery) *OutboxFixtureResultSet {
+	resultSet := s.Store.MustFind(query)
+	return &OutboxFixtureResultSet{ResultSet: *resultSet}
+}
+
+// Tail performs a find on the capped collection using the given query,
+// waiting for new documents up to the given timeout. See storable.Store.Tail.
+func (s *OutboxFixtureStore) Tail(query *OutboxFixtureQuery, timeout time.Duration) (*OutboxFixtureResultSet, error) {
+	resultSet, err := s.Store.Tail(query, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OutboxFixtureResultSet{ResultSet: *resultSet}, nil
+}
+
+// FindOne performs a find on the collection using the given query returning
+// the first document from the resultset.
+func (s *OutboxFixtureStore) FindOne(query *OutboxFixtureQuery) (*OutboxFixture, error) {
+	resultSet, err := s.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultSet.One()
+}
+
+// MustFindOne like FindOne but panics on error
+func (s *OutboxFixtureStore) MustFindOne(query *OutboxFixtureQuery) *OutboxFixture {
+	doc, err := s.FindOne(query)
+	if err != nil {
+		panic(err)
+	}
+
+	return doc
+}
+
+// FindAndModify atomically modifies the first document matching the given
+// query and returns it, before the change or after it if change.ReturnNew is
+// set. See storable.Store.FindAndModify.
+func (s *OutboxFixtureStore) FindAndModify(query *OutboxFixtureQuery, change mgo.Change) (*OutboxFixture, error) {
+	var result *OutboxFixture
+	_, err := s.Store.FindAndModify(query, change, &result)
+
+	return result, err
+}
+
+// Insert insert the given document on the collection, trigger BeforeInsert and
+// AfterInsert if any. Throws ErrNonNewDocument if doc is a non-new document.
+func (s *OutboxFixtureStore) Insert(doc *OutboxFixture) error {
+
+	err := s.Store.Insert(doc)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Update update the given document on the collection, trigger BeforeUpdate and
+// AfterUpdate if any. Throws ErrNewDocument if doc is a new document.
+func (s *OutboxFixtureStore) Update(doc *OutboxFixture) error {
+
+	err := s.Store.Update(doc)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateDiff update the given document on the collection writing only the
+// changes from old, trigger BeforeUpdate and AfterUpdate if any. See
+// storable.Store.UpdateDiff.
+func (s *OutboxFixtureStore) UpdateDiff(old, doc *OutboxFixture) (storable.Changes, error) {
+
+	changes, err := s.Store.UpdateDiff(old, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// Save insert or update the given document on the collection using Upsert,
+// trigger BeforeUpdate and AfterUpdate if the document is non-new and
+// BeforeInsert and AfterInset if is new.
+func (s *OutboxFixtureStore) Save(doc *OutboxFixture) (updated bool, err error) {
+	updated, err = s.Store.Save(doc)
+	if err != nil {
+		return false, err
+	}
+
+	return
+}
+
+type OutboxFixtureQuery struct {
+	storable.BaseQuery
+}
+
+// FindById add a new criteria to the query searching by _id
+func (q *OutboxFixtureQuery) FindById(ids ...bson.ObjectId) *OutboxFixtureQuery {
+	var vs []interface{}
+	for _, id := range ids {
+		vs = append(vs, id)
+	}
+	q.AddCriteria(operators.In(storable.IdField, vs...))
+
+	return q
+}
+
+// Clone returns a copy of the query, see storable.BaseQuery.Clone.
+func (q *OutboxFixtureQuery) Clone() *OutboxFixtureQuery {
+	return &OutboxFixtureQuery{*q.BaseQuery.Clone()}
+}
+
+type OutboxFixtureResultSet struct {
+	storable.ResultSet
+	last    *OutboxFixture
+	lastErr error
+}
+
+// All returns all documents on the resultset and close the resultset
+func (r *OutboxFixtureResultSet) All() ([]*OutboxFixture, error) {
+	var result []*OutboxFixture
+	err := r.ResultSet.All(&result)
+
+	return result, err
+}
+
+// One returns the first document on the resultset and close the resultset
+func (r *OutboxFixtureResultSet) One() (*OutboxFixture, error) {
+	var result *OutboxFixture
+	err := r.ResultSet.One(&result)
+
+	return result, err
+}
+
+// Next prepares the next result document for reading with the Get method.
+func (r *OutboxFixtureResultSet) Next() (returned bool) {
+	r.last = nil
+	returned, r.lastErr = r.ResultSet.Next(&r.last)
+
+	return
+}
+
+// Get returns the document retrieved with the Next method.
+func (r *OutboxFixtureResultSet) Get() (*OutboxFixture, error) {
+	return r.last, r.lastErr
+}
+
+// ForEach iterates the resultset calling to the given function.
+func (r *OutboxFixtureResultSet) ForEach(f func(*OutboxFixture) error) error {
+	for {
+		var result *OutboxFixture
+		found, err := r.ResultSet.Next(&result)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			break
+		}
+
+		err = f(result)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Watch returns a stream with the changes on the documents matching the given
+// query, the current version of the document is retrieved on every update.
+// See storable.Store.Watch.
+func (s *OutboxFixtureStore) Watch(query *OutboxFixtureQuery, opts storable.WatchOptions) (*OutboxFixtureChangeStream, error) {
+	opts.FullDocument = true
+	cs, err := s.Store.Watch(query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OutboxFixtureChangeStream{ChangeStream: cs}, nil
+}
+
+type OutboxFixtureChangeStream struct {
+	*storable.ChangeStream
+	event   storable.ChangeEvent
+	last    *OutboxFixture
+	lastErr error
+}
+
+// Next waits for the next event, to be read with the Get method. Returns
+// false when the stream is closed or fails.
+func (cs *OutboxFixtureChangeStream) Next() (returned bool) {
+	cs.event, cs.last, cs.lastErr = storable.ChangeEvent{}, nil, nil
+	if !cs.ChangeStream.Next(&cs.event) {
+		cs.lastErr = cs.ChangeStream.Err()
+		return false
+	}
+
+	if cs.event.HasDocument() {
+		cs.lastErr = cs.event.Decode(&cs.last)
+	}
+
+	return true
+}
+
+// Get returns the event and the document retrieved with the Next method, the
+// document is nil on the deletes or if it does not exist anymore.
+func (cs *OutboxFixtureChangeStream) Get() (*storable.ChangeEvent, *OutboxFixture, error) {
+	return &cs.event, cs.last, cs.lastErr
+}
+
+// ForEach waits for the events calling to the given function, until the
+// stream is closed or storable.ErrStop is returned.
+func (cs *OutboxFixtureChangeStream) ForEach(f func(*storable.ChangeEvent, *OutboxFixture) error) error {
+	for cs.Next() {
+		event, doc, err := cs.Get()
+		if err != nil {
+			return err
+		}
+
+		err = f(event, doc)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return cs.lastErr
+}
+
+type QueryFixtureStore struct {
+	storable.Store
+}
+
+func NewQueryFixtureStore(db *mgo.Database) *QueryFixtureStore {
+	s := &QueryFixtureStore{*storable.NewStore(db, "query")}
+	s.SetDefaultKind("QueryFixture")
+	return s
+}
+
+// WithContext returns a copy of the store using the given context, see
+// storable.Store.WithContext.
+func (s *QueryFixtureStore) WithContext(ctx context.Context) *QueryFixtureStore {
+	return &QueryFixtureStore{*s.Store.WithContext(ctx)}
+}
+
+// InTx returns a copy of the store running its operations, including the
+// hooks, inside the given transaction. See storable.WithTransaction.
+func (s *QueryFixtureStore) InTx(tx *storable.Tx) *QueryFixtureStore {
+	return &QueryFixtureStore{*s.Store.InTx(tx)}
+}
+
+// New returns a new instance of QueryFixture.
+func (s *QueryFixtureStore) New(f string) (doc *QueryFixture) {
+	doc = newQueryFixture(f)
+	if doc != nil {
+		doc.SetIsNew(true)
+		doc.SetId(bson.NewObjectId())
+	}
+	return
+}
+
+// Query return a new instance of QueryFixtureQuery.
+func (s *QueryFixtureStore) Query() *QueryFixtureQuery {
+	return &QueryFixtureQuery{*storable.NewBaseQuery()}
+}
+
+// Find performs a find on the collection using the given query.
+func (s *QueryFixtureStore) Find(query *QueryFixtureQuery) (*QueryFixtureResultSet, error) {
+	resultSet, err := s.Store.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QueryFixtureResultSet{ResultSet: *resultSet}, nil
+}
+
+// MustFind like Find but panics on error
+func (s *QueryFixtureStore) MustFind(query *QueryFixtureQuery) *QueryFixtureResultSet {
+	resultSet := s.Store.MustFind(query)
+	return &QueryFixtureResultSet{ResultSet: *resultSet}
+}
+
+// Tail performs a find on the capped collection using the given query,
+// waiting for new documents up to the given timeout. See storable.Store.Tail.
+func (s *QueryFixtureStore) Tail(query *QueryFixtureQuery, timeout time.Duration) (*QueryFixtureResultSet, error) {
+	resultSet, err := s.Store.Tail(query, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QueryFixtureResultSet{ResultSet: *resultSet}, nil
+}
+
+// FindOne performs a find on the collection using the given query returning
+// the first document from the resultset.
+func (s *QueryFixtureStore) FindOne(query *QueryFixtureQuery) (*QueryFixture, error) {
+	resultSet, err := s.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultSet.One()
+}
+
+// MustFindOne like FindOne but panics on error
+func (s *QueryFixtureStore) MustFindOne(query *QueryFixtureQuery) *QueryFixture {
+	doc, err := s.FindOne(query)
+	if err != nil {
+		panic(err)
+	}
+
+	return doc
+}
+
+// FindAndModify atomically modifies the first document matching the given
+// query and returns it, before the change or after it if change.ReturnNew is
+// set. See storable.Store.FindAndModify.
+func (s *QueryFixtureStore) FindAndModify(query *QueryFixtureQuery, change mgo.Change) (*QueryFixture, error) {
+	var result *QueryFixture
+	_, err := s.Store.FindAndModify(query, change, &result)
+
+	return result, err
+}
+
+// Insert insert the given document on the collection, trigger BeforeInsert and
+// AfterInsert if any. Throws ErrNonNewDocument if doc is a non-new document.
+func (s *QueryFixtureStore) Insert(doc *QueryFixture) error {
+
+	err := s.Store.Insert(doc)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Update update the given document on the collection, trigger BeforeUpdate and
+// AfterUpdate if any. Throws ErrNewDocument if doc is a new document.
+func (s *QueryFixtureStore) Update(doc *QueryFixture) error {
+
+	err := s.Store.Update(doc)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateDiff update the given document on the collection writing only the
+// changes from old, trigger BeforeUpdate and AfterUpdate if any. See
+// storable.Store.UpdateDiff.
+func (s *QueryFixtureStore) UpdateDiff(old, doc *QueryFixture) (storable.Changes, error) {
+
+	changes, err := s.Store.UpdateDiff(old, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// Save insert or update the given document on the collection using Upsert,
+// trigger BeforeUpdate and AfterUpdate if the document is non-new and
+// BeforeInsert and AfterInset if is new.
+func (s *QueryFixtureStore) Save(doc *QueryFixture) (updated bool, err error) {
+	updated, err = s.Store.Save(doc)
+	if err != nil {
+		return false, err
+	}
+
+	return
+}
+
+type QueryFixtureQuery struct {
+	storable.BaseQuery
+}
+
+// FindById add a new criteria to the query searching by _id
+func (q *QueryFixtureQuery) FindById(ids ...bson.ObjectId) *QueryFixtureQuery {
+	var vs []interface{}
+	for _, id := range ids {
+		vs = append(vs, id)
+	}
+	q.AddCriteria(operators.In(storable.IdField, vs...))
+
+	return q
+}
+
+// Clone returns a copy of the query, see storable.BaseQuery.Clone.
+func (q *QueryFixtureQuery) Clone() *QueryFixtureQuery {
+	return &QueryFixtureQuery{*q.BaseQuery.Clone()}
+}
+
+type QueryFixtureResultSet struct {
+	storable.ResultSet
+	last    *QueryFixture
+	lastErr error
+}
+
+// All returns all documents on the resultset and close the resultset
+func (r *QueryFixtureResultSet) All() ([]*QueryFixture, error) {
+	var result []*QueryFixture
+	err := r.ResultSet.All(&result)
+
+	return result, err
+}
+
+// One returns the first document on the resultset and close the resultset
+func (r *QueryFixtureResultSet) One() (*QueryFixture, error) {
+	var result *QueryFixture
+	err := r.ResultSet.One(&result)
+
+	return result, err
+}
+
+// Next prepares the next result document for reading with the Get method.
+func (r *QueryFixtureResultSet) Next() (returned bool) {
+	r.last = nil
+	returned, r.lastErr = r.ResultSet.Next(&r.last)
+
+	return
+}
+
+// Get returns the document retrieved with the Next method.
+func (r *QueryFixtureResultSet) Get() (*QueryFixture, error) {
+	return r.last, r.lastErr
+}
+
+// ForEach iterates the resultset calling to the given function.
+func (r *QueryFixtureResultSet) ForEach(f func(*QueryFixture) error) error {
+	for {
+		var result *QueryFixture
+		found, err := r.ResultSet.Next(&result)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			break
+		}
+
+		err = f(result)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Watch returns a stream with the changes on the documents matching the given
+// query, the current version of the document is retrieved on every update.
+// See storable.Store.Watch.
+func (s *QueryFixtureStore) Watch(query *QueryFixtureQuery, opts storable.WatchOptions) (*QueryFixtureChangeStream, error) {
+	opts.FullDocument = true
+	cs, err := s.Store.Watch(query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QueryFixtureChangeStream{ChangeStream: cs}, nil
+}
+
+type QueryFixtureChangeStream struct {
+	*storable.ChangeStream
+	event   storable.ChangeEvent
+	last    *QueryFixture
+	lastErr error
+}
+
+// Next waits for the next event, to be read with the Get method. Returns
+// false when the stream is closed or fails.
+func (cs *QueryFixtureChangeStream) Next() (returned bool) {
+	cs.event, cs.last, cs.lastErr = storable.ChangeEvent{}, nil, nil
+	if !cs.ChangeStream.Next(&cs.event) {
+		cs.lastErr = cs.ChangeStream.Err()
+		return false
+	}
+
+	if cs.event.HasDocument() {
+		cs.lastErr = cs.event.Decode(&cs.last)
+	}
+
+	return true
+}
+
+// Get returns the event and the document retrieved with the Next method, the
+// document is nil on the deletes or if it does not exist anymore.
+func (cs *QueryFixtureChangeStream) Get() (*storable.ChangeEvent, *QueryFixture, error) {
+	return &cs.event, cs.last, cs.lastErr
+}
+
+// ForEach waits for the events calling to the given function, until the
+// stream is closed or storable.ErrStop is returned.
+func (cs *QueryFixtureChangeStream) ForEach(f func(*storable.ChangeEvent, *QueryFixture) error) error {
+	for cs.Next() {
+		event, doc, err := cs.Get()
+		if err != nil {
+			return err
+		}
+
+		err = f(event, doc)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return cs.lastErr
+}
+
+type RefCustomerFixtureStore struct {
+	storable.Store
+}
+
+func NewRefCustomerFixtureStore(db *mgo.Database) *RefCustomerFixtureStore {
+	return &RefCustomerFixtureStore{*storable.NewStore(db, "customers")}
+}
+
+// WithContext returns a copy of the store using the given context, see
+// storable.Store.WithContext.
+func (s *RefCustomerFixtureStore) WithContext(ctx context.Context) *RefCustomerFixtureStore {
+	return &RefCustomerFixtureStore{*s.Store.WithContext(ctx)}
+}
+
+// InTx returns a copy of the store running its operations, including the
+// hooks, inside the given transaction. See storable.WithTransaction.
+func (s *RefCustomerFixtureStore) InTx(tx *storable.Tx) *RefCustomerFixtureStore {
+	return &RefCustomerFixtureStore{*s.Store.InTx(tx)}
+}
+
+// New returns a new instance of RefCustomerFixture.
+func (s *RefCustomerFixtureStore) New() (doc *RefCustomerFixture) {
+	doc = &RefCustomerFixture{}
+	if doc != nil {
+		doc.SetIsNew(true)
+		doc.SetId(bson.NewObjectId())
+	}
+	return
+}
+
+// Query return a new instance of RefCustomerFixtureQuery.
+func (s *RefCustomerFixtureStore) Query() *RefCustomerFixtureQuery {
+	return &RefCustomerFixtureQuery{*storable.NewBaseQuery()}
+}
+
+// Find performs a find on the collection using the given query.
+func (s *RefCustomerFixtureStore) Find(query *RefCustomerFixtureQuery) (*RefCustomerFixtureResultSet, error) {
+	resultSet, err := s.Store.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RefCustomerFixtureResultSet{ResultSet: *resultSet}, nil
+}
+
+// MustFind like Find but panics on error
+func (s *RefCustomerFixtureStore) MustFind(query *RefCustomerFixtureQuery) *RefCustomerFixtureResultSet {
+	resultSet := s.Store.MustFind(query)
+	return &RefCustomerFixtureResultSet{ResultSet: *resultSet}
+}
+
+// Tail performs a find on the capped collection using the given query,
+// waiting for new documents up to the given timeout. See storable.Store.Tail.
+func (s *RefCustomerFixtureStore) Tail(query *RefCustomerFixtureQuery, timeout time.Duration) (*RefCustomerFixtureResultSet, error) {
+	resultSet, err := s.Store.Tail(query, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RefCustomerFixtureResultSet{ResultSet: *resultSet}, nil
+}
+
+// FindOne performs a find on the collection using the given query returning
+// the first document from the resultset.
+func (s *RefCustomerFixtureStore) FindOne(query *RefCustomerFixtureQuery) (*RefCustomerFixture, error) {
+	resultSet, err := s.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultSet.One()
+}
+
+// MustFindOne like FindOne but panics on error
+func (s *RefCustomerFixtureStore) MustFindOne(query *RefCustomerFixtureQuery) *RefCustomerFixture {
+	doc, err := s.FindOne(query)
+	if err != nil {
+		panic(err)
+	}
+
+	return doc
+}
+
+// FindAndModify atomically modifies the first document matching the given
+// query and returns it, before the change or after it if change.ReturnNew is
+// set. See storable.Store.FindAndModify.
+func (s *RefCustomerFixtureStore) FindAndModify(query *RefCustomerFixtureQuery, change mgo.Change) (*RefCustomerFixture, error) {
+	var result *RefCustomerFixture
+	_, err := s.Store.FindAndModify(query, change, &result)
+
+	return result, err
+}
+
+// Insert insert the given document on the collection, trigger BeforeInsert and
+// AfterInsert if any. Throws ErrNonNewDocument if doc is a non-new document.
+func (s *RefCustomerFixtureStore) Insert(doc *RefCustomerFixture) error {
+
+	err := s.Store.Insert(doc)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Update update the given document on the collection, trigger BeforeUpdate and
+// AfterUpdate if any. Throws ErrNewDocument if doc is a new document.
+func (s *RefCustomerFixtureStore) Update(doc *RefCustomerFixture) error {
+
+	err := s.Store.Update(doc)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateDiff update the given document on the collection writing only the
+// changes from old, trigger BeforeUpdate and AfterUpdate if any. See
+// storable.Store.UpdateDiff.
+func (s *RefCustomerFixtureStore) UpdateDiff(old, doc *RefCustomerFixture) (storable.Changes, error) {
+
+	changes, err := s.Store.UpdateDiff(old, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// Save insert or update the given document on the collection using Upsert,
+// trigger BeforeUpdate and AfterUpdate if the document is non-new and
+// BeforeInsert and AfterInset if is new.
+func (s *RefCustomerFixtureStore) Save(doc *RefCustomerFixture) (updated bool, err error) {
+	updated, err = s.Store.Save(doc)
+	if err != nil {
+		return false, err
+	}
+
+	return
+}
+
+type RefCustomerFixtureQuery struct {
+	storable.BaseQuery
+}
+
+// FindById add a new criteria to the query searching by _id
+func (q *RefCustomerFixtureQuery) FindById(ids ...bson.ObjectId) *RefCustomerFixtureQuery {
+	var vs []interface{}
+	for _, id := range ids {
+		vs = append(vs, id)
+	}
+	q.AddCriteria(operators.In(storable.IdField, vs...))
+
+	return q
+}
+
+// Clone returns a copy of the query, see storable.BaseQuery.Clone.
+func (q *RefCustomerFixtureQuery) Clone() *RefCustomerFixtureQuery {
+	return &RefCustomerFixtureQuery{*q.BaseQuery.Clone()}
+}
+
+type RefCustomerFixtureResultSet struct {
+	storable.ResultSet
+	last    *RefCustomerFixture
+	lastErr error
+}
+
+// All returns all documents on the resultset and close the resultset
+func (r *RefCustomerFixtureResultSet) All() ([]*RefCustomerFixture, error) {
+	var result []*RefCustomerFixture
+	err := r.ResultSet.All(&result)
+
+	return result, err
+}
+
+// One returns the first document on the resultset and close the resultset
+func (r *RefCustomerFixtureResultSet) One() (*RefCustomerFixture, error) {
+	var result *RefCustomerFixture
+	err := r.ResultSet.One(&result)
+
+	return result, err
+}
+
+// Next prepares the next result document for reading with the Get method.
+func (r *RefCustomerFixtureResultSet) Next() (returned bool) {
+	r.last = nil
+	returned, r.lastErr = r.ResultSet.Next(&r.last)
+
+	return
+}
+
+// Get returns the document retrieved with the Next method.
+func (r *RefCustomerFixtureResultSet) Get() (*RefCustomerFixture, error) {
+	return r.last, r.lastErr
+}
+
+// ForEach iterates the resultset calling to the given function.
+func (r *RefCustomerFixtureResultSet) ForEach(f func(*RefCustomerFixture) error) error {
+	for {
+		var result *RefCustomerFixture
+		found, err := r.ResultSet.Next(&result)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			break
+		}
+
+		err = f(result)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Watch returns a stream with the changes on the documents matching the given
+// query, the current version of the document is retrieved on every update.
+// See storable.Store.Watch.
+func (s *RefCustomerFixtureStore) Watch(query *RefCustomerFixtureQuery, opts storable.WatchOptions) (*RefCustomerFixtureChangeStream, error) {
+	opts.FullDocument = true
+	cs, err := s.Store.Watch(query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RefCustomerFixtureChangeStream{ChangeStream: cs}, nil
+}
+
+type RefCustomerFixtureChangeStream struct {
+	*storable.ChangeStream
+	event   storable.ChangeEvent
+	last    *RefCustomerFixture
+	lastErr error
+}
+
+// Next waits for the next event, to be read with the Get method. Returns
+// false when the stream is closed or fails.
+func (cs *RefCustomerFixtureChangeStream) Next() (returned bool) {
+	cs.event, cs.last, cs.lastErr = storable.ChangeEvent{}, nil, nil
+	if !cs.ChangeStream.Next(&cs.event) {
+		cs.lastErr = cs.ChangeStream.Err()
+		return false
+	}
+
+	if cs.event.HasDocument() {
+		cs.lastErr = cs.event.Decode(&cs.last)
+	}
+
+	return true
+}
+
+// Get returns the event and the document retrieved with the Next method, the
+// document is nil on the deletes or if it does not exist anymore.
+func (cs *RefCustomerFixtureChangeStream) Get() (*storable.ChangeEvent, *RefCustomerFixture, error) {
+	return &cs.event, cs.last, cs.lastErr
+}
+
+// ForEach waits for the events calling to the given function, until the
+// stream is closed or storable.ErrStop is returned.
+func (cs *RefCustomerFixtureChangeStream) ForEach(f func(*storable.ChangeEvent, *RefCustomerFixture) error) error {
+	for cs.Next() {
+		event, doc, err := cs.Get()
+		if err != nil {
+			return err
+		}
+
+		err = f(event, doc)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return cs.lastErr
+}
+
+type RefOrderFixtureStore struct {
+	storable.Store
+}
+
+func NewRefOrderFixtureStore(db *mgo.Database) *RefOrderFixtureStore {
+	return &RefOrderFixtureStore{*storable.NewStore(db, "orders")}
+}
+
+// WithContext returns a copy of the store using the given context, see
+// storable.Store.WithContext.
+func (s *RefOrderFixtureStore) WithContext(ctx context.Context) *RefOrderFixtureStore {
+	return &RefOrderFixtureStore{*s.Store.WithContext(ctx)}
+}
+
+// InTx returns a copy of the store running its operations, including the
+// hooks, inside the given transaction. See storable.WithTransaction.
+func (s *RefOrderFixtureStore) InTx(tx *storable.Tx) *RefOrderFixtureStore {
+	return &RefOrderFixtureStore{*s.Store.InTx(tx)}
+}
+
+// New returns a new instance of RefOrderFixture.
+func (s *RefOrderFixtureStore) New() (doc *RefOrderFixture) {
+	doc = &RefOrderFixture{}
+	if doc != nil {
+		doc.SetIsNew(true)
+		doc.SetId(bson.NewObjectId())
+	}
+	return
+}
+
+// Query return a new instance of RefOrderFixtureQuery.
+func (s *RefOrderFixtureStore) Query() *RefOrderFixtureQuery {
+	return &RefOrderFixtureQuery{*storable.NewBaseQuery()}
+}
+
+// Find performs a find on the collection using the given query.
+func (s *RefOrderFixtureStore) Find(query *RefOrderFixtureQuery) (*RefOrderFixtureResultSet, error) {
+	resultSet, err := s.Store.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RefOrderFixtureResultSet{ResultSet: *resultSet}, nil
+}
+
+// MustFind like Find but panics on error
+func (s *RefOrderFixtureStore) MustFind(query *RefOrderFixtureQuery) *RefOrderFixtureResultSet {
+	resultSet := s.Store.MustFind(query)
+	return &RefOrderFixtureResultSet{ResultSet: *resultSet}
+}
+
+// Tail performs a find on the capped collection using the given query,
+// waiting for new documents up to the given timeout. See storable.Store.Tail.
+func (s *RefOrderFixtureStore) Tail(query *RefOrderFixtureQuery, timeout time.Duration) (*RefOrderFixtureResultSet, error) {
+	resultSet, err := s.Store.Tail(query, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RefOrderFixtureResultSet{ResultSet: *resultSet}, nil
+}
+
+// FindOne performs a find on the collection using the given query returning
+// the first document from the resultset.
+func (s *RefOrderFixtureStore) FindOne(query *RefOrderFixtureQuery) (*RefOrderFixture, error) {
+	resultSet, err := s.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultSet.One()
+}
+
+// MustFindOne like FindOne but panics on error
+func (s *RefOrderFixtureStore) MustFindOne(query *RefOrderFixtureQuery) *RefOrderFixture {
+	doc, err := s.FindOne(query)
+	if err != nil {
+		panic(err)
+	}
+
+	return doc
+}
+
+// FindAndModify atomically modifies the first document matching the given
+// query and returns it, before the change or after it if change.ReturnNew is
+// set. See storable.Store.FindAndModify.
+func (s *RefOrderFixtureStore) FindAndModify(query *RefOrderFixtureQuery, change mgo.Change) (*RefOrderFixture, error) {
+	var result *RefOrderFixture
+	_, err := s.Store.FindAndModify(query, change, &result)
+
+	return result, err
+}
+
+// Insert insert the given document on the collection, trigger BeforeInsert and
+// AfterInsert if any. Throws ErrNonNewDocument if doc is a non-new document.
+func (s *RefOrderFixtureStore) Insert(doc *RefOrderFixture) error {
+
+	err := s.Store.Insert(doc)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Update update the given document on the collection, trigger BeforeUpdate and
+// AfterUpdate if any. Throws ErrNewDocument if doc is a new document.
+func (s *RefOrderFixtureStore) Update(doc *RefOrderFixture) error {
+
+	err := s.Store.Update(doc)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateDiff update the given document on the collection writing only the
+// changes from old, trigger BeforeUpdate and AfterUpdate if any. See
+// storable.Store.UpdateDiff.
+func (s *RefOrderFixtureStore) UpdateDiff(old, doc *RefOrderFixture) (storable.Changes, error) {
+
+	changes, err := s.Store.UpdateDiff(old, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// Save insert or update the given document on the collection using Upsert,
+// trigger BeforeUpdate and AfterUpdate if the document is non-new and
+// BeforeInsert and AfterInset if is new.
+func (s *RefOrderFixtureStore) Save(doc *RefOrderFixture) (updated bool, err error) {
+	updated, err = s.Store.Save(doc)
+	if err != nil {
+		return false, err
+	}
+
+	return
+}
+
+type RefOrderFixtureQuery struct {
+	storable.BaseQuery
+}
+
+// FindById add a new criteria to the query searching by _id
+func (q *RefOrderFixtureQuery) FindById(ids ...bson.ObjectId) *RefOrderFixtureQuery {
+	var vs []interface{}
+	for _, id := range ids {
+		vs = append(vs, id)
+	}
+	q.AddCriteria(operators.In(storable.IdField, vs...))
+
+	return q
+}
+
+// Clone returns a copy of the query, see storable.BaseQuery.Clone.
+func (q *RefOrderFixtureQuery) Clone() *RefOrderFixtureQuery {
+	return &RefOrderFixtureQuery{*q.BaseQuery.Clone()}
+}
+
+type RefOrderFixtureResultSet struct {
+	storable.ResultSet
+	last    *RefOrderFixture
+	lastErr error
+}
+
+// All returns all documents on the resultset and close the resultset
+func (r *RefOrderFixtureResultSet) All() ([]*RefOrderFixture, error) {
+	var result []*RefOrderFixture
+	err := r.ResultSet.All(&result)
+
+	return result, err
+}
+
+// One returns the first document on the resultset and close the resultset
+func (r *RefOrderFixtureResultSet) One() (*RefOrderFixture, error) {
+	var result *RefOrderFixture
+	err := r.ResultSet.One(&result)
+
+	return result, err
+}
+
+// Next prepares the next result document for reading with the Get method.
+func (r *RefOrderFixtureResultSet) Next() (returned bool) {
+	r.last = nil
+	returned, r.lastErr = r.ResultSet.Next(&r.last)
+
+	return
+}
+
+// Get returns the document retrieved with the Next method.
+func (r *RefOrderFixtureResultSet) Get() (*RefOrderFixture, error) {
+	return r.last, r.lastErr
+}
+
+// ForEach iterates the resultset calling to the given function.
+func (r *RefOrderFixtureResultSet) ForEach(f func(*RefOrderFixture) error) error {
+	for {
+		var result *RefOrderFixture
+		found, err := r.ResultSet.Next(&result)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			break
+		}
+
+		err = f(result)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Watch returns a stream with the changes on the documents matching the given
+// query, the current version of the document is retrieved on every update.
+// See storable.Store.Watch.
+func (s *RefOrderFixtureStore) Watch(query *RefOrderFixtureQuery, opts storable.WatchOptions) (*RefOrderFixtureChangeStream, error) {
+	opts.FullDocument = true
+	cs, err := s.Store.Watch(query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RefOrderFixtureChangeStream{ChangeStream: cs}, nil
+}
+
+type RefOrderFixtureChangeStream struct {
+	*storable.ChangeStream
+	event   storable.ChangeEvent
+	last    *RefOrderFixture
+	lastErr error
+}
+
+// Next waits for the next event, to be read with the Get method. Returns
+// false when the stream is closed or fails.
+func (cs *RefOrderFixtureChangeStream) Next() (returned bool) {
+	cs.event, cs.last, cs.lastErr = storable.ChangeEvent{}, nil, nil
+	if !cs.ChangeStream.Next(&cs.event) {
+		cs.lastErr = cs.ChangeStream.Err()
+		return false
+	}
+
+	if cs.event.HasDocument() {
+		cs.lastErr = cs.event.Decode(&cs.last)
+	}
+
+	return true
+}
+
+// Get returns the event and the document retrieved with the Next method, the
+// document is nil on the deletes or if it does not exist anymore.
+func (cs *RefOrderFixtureChangeStream) Get() (*storable.ChangeEvent, *RefOrderFixture, error) {
+	return &cs.event, cs.last, cs.lastErr
+}
+
+// ForEach waits for the events calling to the given function, until the
+// stream is closed or storable.ErrStop is returned.
+func (cs *RefOrderFixtureChangeStream) ForEach(f func(*storable.ChangeEvent, *RefOrderFixture) error) error {
+	for cs.Next() {
+		event, doc, err := cs.Get()
+		if err != nil {
+			return err
+		}
+
+		err = f(event, doc)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return cs.lastErr
+}
+
+// PopulateCustomerId loads with a single query the RefCustomerFixture documents
+// referenced by the CustomerId field of the given documents, indexed by id.
+// A *storable.RefError is returned along the found documents if any reference
+// is dangling.
+func (s *RefOrderFixtureStore) PopulateCustomerId(docs []*RefOrderFixture) (map[bson.ObjectId]*RefCustomerFixture, error) {
+	refs := storable.NewRefs("customerid", "customers")
+	for _, doc := range docs {
+		refs.Add(doc.CustomerId)
+	}
+
+	result := make(map[bson.ObjectId]*RefCustomerFixture, refs.Len())
+	if refs.Len() == 0 {
+		return result, nil
+	}
+
+	store := &RefCustomerFixtureStore{Store: *s.Store.Related("customers")}
+	query := store.Query()
+	query.FindById(refs.Ids()...)
+
+	resultSet, err := store.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	err = resultSet.ForEach(func(doc *RefCustomerFixture) error {
+		result[doc.GetId()] = doc
+		refs.Found(doc.GetId())
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, refs.Err()
+}
+
+// PopulateWatcherIds loads with a single query the RefCustomerFixture documents
+// referenced by the WatcherIds field of the given documents, indexed by id.
+// A *storable.RefError is returned along the found documents if any reference
+// is dangling.
+func (s *RefOrderFixtureStore) PopulateWatcherIds(docs []*RefOrderFixture) (map[bson.ObjectId]*RefCustomerFixture, error) {
+	refs := storable.NewRefs("watcherids", "customers")
+	for _, doc := range docs {
+		refs.Add(doc.WatcherIds...)
+	}
+
+	result := make(map[bson.ObjectId]*RefCustomerFixture, refs.Len())
+	if refs.Len() == 0 {
+		return result, nil
+	}
+
+	store := &RefCustomerFixtureStore{Store: *s.Store.Related("customers")}
+	query := store.Query()
+	query.FindById(refs.Ids()...)
+
+	resultSet, err := store.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	err = resultSet.ForEach(func(doc *RefCustomerFixture) error {
+		result[doc.GetId()] = doc
+		refs.Found(doc.GetId())
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, refs.Err()
+}
+
+type ResultSetFixtureStore struct {
+	storable.Store
+}
+
+func NewResultSetFixtureStore(db *mgo.Database) *ResultSetFixtureStore {
+	s := &ResultSetFixtureStore{*storable.NewStore(db, "resultset")}
+	s.SetDefaultKind("ResultSetFixture")
+	return s
+}
+
+// WithContext returns a copy of the store using the given context, see
+// storable.Store.WithContext.
+func (s *ResultSetFixtureStore) WithContext(ctx context.Context) *ResultSetFixtureStore {
+	return &ResultSetFixtureStore{*s.Store.WithContext(ctx)}
+}
+
+// InTx returns a copy of the store running its operations, including the
+// hooks, inside the given transaction. See storable.WithTransaction.
+func (s *ResultSetFixtureStore) InTx(tx *storable.Tx) *ResultSetFixtureStore {
+	return &ResultSetFixtureStore{*s.Store.InTx(tx)}
+}
+
+// New returns a new instance of ResultSetFixture.
+func (s *ResultSetFixtureStore) New(f string) (doc *ResultSetFixture) {
+	doc = newResultSetFixture(f)
+	if doc != nil {
+		doc.SetIsNew(true)
+		doc.SetId(bson.NewObjectId())
+	}
+	return
+}
+
+// Query return a new instance of ResultSetFixtureQuery.
+func (s *ResultSetFixtureStore) Query() *ResultSetFixtureQuery {
+	return &ResultSetFixtureQuery{*storable.NewBaseQuery()}
+}
+
+// Find performs a find on the collection using the given query.
+func (s *ResultSetFixtureStore) Find(query *ResultSetFixtureQuery) (*ResultSetFixtureResultSet, error) {
+	resultSet, err := s.Store.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResultSetFixtureResultSet{ResultSet: *resultSet}, nil
+}
+
+// MustFind like Find but panics on error
+func (s *ResultSetFixtureStore) MustFind(query *ResultSetFixtureQuery) *ResultSetFixtureResultSet {
+	resultSet := s.Store.MustFind(query)
+	return &ResultSetFixtureResultSet{ResultSet: *resultSet}
+}
+
+// Tail performs a find on the capped collection using the given query,
+// waiting for new documents up to the given timeout. See storable.Store.Tail.
+func (s *ResultSetFixtureStore) Tail(query *ResultSetFixtureQuery, timeout time.Duration) (*ResultSetFixtureResultSet, error) {
+	resultSet, err := s.Store.Tail(query, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResultSetFixtureResultSet{ResultSet: *resultSet}, nil
+}
+
+// FindOne performs a find on the collection using the given query returning
+// the first document from the resultset.
+func (s *ResultSetFixtureStore) FindOne(query *ResultSetFixtureQuery) (*ResultSetFixture, error) {
+	resultSet, err := s.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultSet.One()
+}
+
+// MustFindOne like FindOne but panics on error
+func (s *ResultSetFixtureStore) MustFindOne(query *ResultSetFixtureQuery) *ResultSetFixture {
+	doc, err := s.FindOne(query)
+	if err != nil {
+		panic(err)
+	}
+
+	return doc
+}
+
+// FindAndModify atomically modifies the first document matching the given
+// query and returns it, before the change or after it if change.ReturnNew is
+// set. See storable.Store.FindAndModify.
+func (s *ResultSetFixtureStore) FindAndModify(query *ResultSetFixtureQuery, change mgo.Change) (*ResultSetFixture, error) {
+	var result *ResultSetFixture
+	_, err := s.Store.FindAndModify(query, change, &result)
+
+	return result, err
+}
+
+// Insert insert the given document on the collection, trigger BeforeInsert and
+// AfterInsert if any. Throws ErrNonNewDocument if doc is a non-new document.
+func (s *ResultSetFixtureStore) Insert(doc *ResultSetFixture) error {
+
+	err := s.Store.Insert(doc)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Update update the given document on the collection, trigger BeforeUpdate and
+// AfterUpdate if any. Throws ErrNewDocument if doc is a new document.
+func (s *ResultSetFixtureStore) Update(doc *ResultSetFixture) error {
+
+	err := s.Store.Update(doc)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateDiff update the given document on the collection writing only the
+// changes from old, trigger BeforeUpdate and AfterUpdate if any. See
+// storable.Store.UpdateDiff.
+func (s *ResultSetFixtureStore) UpdateDiff(old, doc *ResultSetFixture) (storable.Changes, error) {
+
+	changes, err := s.Store.UpdateDiff(old, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// Save insert or update the given document on the collection using Upsert,
+// trigger BeforeUpdate and AfterUpdate if the document is non-new and
+// BeforeInsert and AfterInset if is new.
+func (s *ResultSetFixtureStore) Save(doc *ResultSetFixture) (updated bool, err error) {
+	updated, err = s.Store.Save(doc)
+	if err != nil {
+		return false, err
+	}
+
+	return
+}
+
+type ResultSetFixtureQuery struct {
+	storable.BaseQuery
+}
+
+// FindById add a new criteria to the query searching by _id
+func (q *ResultSetFixtureQuery) FindById(ids ...bson.ObjectId) *ResultSetFixtureQuery {
+	var vs []interface{}
+	for _, id := range ids {
+		vs = append(vs, id)
+	}
+	q.AddCriteria(operators.In(storable.IdField, vs...))
+
+	return q
+}
+
+// Clone returns a copy of the query, see storable.BaseQuery.Clone.
+func (q *ResultSetFixtureQuery) Clone() *ResultSetFixtureQuery {
+	return &ResultSetFixtureQuery{*q.BaseQuery.Clone()}
+}
+
+type ResultSetFixtureResultSet struct {
+	storable.ResultSet
+	last    *ResultSetFixture
+	lastErr error
+}
+
+// All returns all documents on the resultset and close the resultset
+func (r *ResultSetFixtureResultSet) All() ([]*ResultSetFixture, error) {
+	var result []*ResultSetFixture
+	err := r.ResultSet.All(&result)
+
+	return result, err
+}
+
+// One returns the first document on the resultset and close the resultset
+func (r *ResultSetFixtureResultSet) One() (*ResultSetFixture, error) {
+	var result *ResultSetFixture
+	err := r.ResultSet.One(&result)
+
+	return result, err
+}
+
+// Next prepares the next result document for reading with the Get method.
+func (r *ResultSetFixtureResultSet) Next() (returned bool) {
+	r.last = nil
+	returned, r.lastErr = r.ResultSet.Next(&r.last)
+
+	return
+}
+
+// Get returns the document retrieved with the Next method.
+func (r *ResultSetFixtureResultSet) Get() (*ResultSetFixture, error) {
+	return r.last, r.lastErr
+}
+
+// ForEach iterates the resultset calling to the given function.
+func (r *ResultSetFixtureResultSet) ForEach(f func(*ResultSetFixture) error) error {
+	for {
+		var result *ResultSetFixture
+		found, err := r.ResultSet.Next(&result)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			break
+		}
+
+		err = f(result)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Watch returns a stream with the changes on the documents matching the given
+// query, the current version of the document is retrieved on every update.
+// See storable.Store.Watch.
+func (s *ResultSetFixtureStore) Watch(query *ResultSetFixtureQuery, opts storable.WatchOptions) (*ResultSetFixtureChangeStream, error) {
+	opts.FullDocument = true
+	cs, err := s.Store.Watch(query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResultSetFixtureChangeStream{ChangeStream: cs}, nil
+}
+
+type ResultSetFixtureChangeStream struct {
+	*storable.ChangeStream
+	event   storable.ChangeEvent
+	last    *ResultSetFixture
+	lastErr error
+}
+
+// Next waits for the next event, to be read with the Get method. Returns
+// false when the stream is closed or fails.
+func (cs *ResultSetFixtureChangeStream) Next() (returned bool) {
+	cs.event, cs.last, cs.lastErr = storable.ChangeEvent{}, nil, nil
+	if !cs.ChangeStream.Next(&cs.event) {
+		cs.lastErr = cs.ChangeStream.Err()
+		return false
+	}
+
+	if cs.event.HasDocument() {
+		cs.lastErr = cs.event.Decode(&cs.last)
+	}
+
+	return true
+}
+
+// Get returns the event and the document retrieved with the Next method, the
+// document is nil on the deletes or if it does not exist anymore.
+func (cs *ResultSetFixtureChangeStream) Get() (*storable.ChangeEvent, *ResultSetFixture, error) {
+	return &cs.event, cs.last, cs.lastErr
+}
+
+// ForEach waits for the events calling to the given function, until the
+// stream is closed or storable.ErrStop is returned.
+func (cs *ResultSetFixtureChangeStream) ForEach(f func(*storable.ChangeEvent, *ResultSetFixture) error) error {
+	for cs.Next() {
+		event, doc, err := cs.Get()
+		if err != nil {
+			return err
+		}
+
+		err = f(event, doc)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return cs.lastErr
+}
+
+type ResultSetInitFixtureStore struct {
+	storable.Store
+}
+
+func NewResultSetInitFixtureStore(db *mgo.Database) *ResultSetInitFixtureStore {
+	s := &ResultSetInitFixtureStore{*storable.NewStore(db, "resultset")}
+	s.SetDefaultKind("ResultSetInitFixture")
+	return s
+}
+
+// WithContext returns a copy of the store using the given context, see
+// storable.Store.WithContext.
+func (s *ResultSetInitFixtureStore) WithContext(ctx context.Context) *ResultSetInitFixtureStore {
+	return &ResultSetInitFixtureStore{*s.Store.WithContext(ctx)}
+}
+
+// InTx returns a copy of the store running its operations, including the
+// hooks, inside the given transaction. See storable.WithTransaction.
+func (s *ResultSetInitFixtureStore) InTx(tx *storable.Tx) *ResultSetInitFixtureStore {
+	return &ResultSetInitFixtureStore{*s.Store.InTx(tx)}
+}
+
+// New returns a new instance of ResultSetInitFixture.
+func (s *ResultSetInitFixtureStore) New() (doc *ResultSetInitFixture) {
+	doc = &ResultSetInitFixture{}
+	if doc != nil {
+		doc.SetIsNew(true)
+		doc.SetId(bson.NewObjectId())
+	}
+	return
+}
+
+// Query return a new instance of ResultSetInitFixtureQuery.
+func (s *ResultSetInitFixtureStore) Query() *ResultSetInitFixtureQuery {
+	return &ResultSetInitFixtureQuery{*storable.NewBaseQuery()}
+}
+
+// Find performs a find on the collection using the given query.
+func (s *ResultSetInitFixtureStore) Find(query *ResultSetInitFixtureQuery) (*ResultSetInitFixtureResultSet, error) {
+	resultSet, err := s.Store.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResultSetInitFixtureResultSet{ResultSet: *resultSet}, nil
+}
+
+// MustFind like Find but panics on error
+func (s *ResultSetInitFixtureStore) MustFind(query *ResultSetInitFixtureQuery) *ResultSetInitFixtureResultSet {
+	resultSet := s.Store.MustFind(query)
+	return &ResultSetInitFixtureResultSet{ResultSet: *resultSet}
+}
+
+// Tail performs a find on the capped collection using the given query,
+// waiting for new documents up to the given timeout. See storable.Store.Tail.
+func (s *ResultSetInitFixtureStore) Tail(query *ResultSetInitFixtureQuery, timeout time.Duration) (*ResultSetInitFixtureResultSet, error) {
+	resultSet, err := s.Store.Tail(query, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResultSetInitFixtureResultSet{ResultSet: *resultSet}, nil
+}
+
+// FindOne performs a find on the collection using the given query returning
+// the first document from the resultset.
+func (s *ResultSetInitFixtureStore) FindOne(query *ResultSetInitFixtureQuery) (*ResultSetInitFixture, error) {
+	resultSet, err := s.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultSet.One()
+}
+
+// MustFindOne like FindOne but panics on error
+func (s *ResultSetInitFixtureStore) MustFindOne(query *ResultSetInitFixtureQuery) *ResultSetInitFixture {
+	doc, err := s.FindOne(query)
+	if err != nil {
+		panic(err)
+	}
+
+	return doc
+}
+
+// FindAndModify atomically modifies the first document matching the given
+// query and returns it, before the change or after it if change.ReturnNew is
+// set. See storable.Store.FindAndModify.
+func (s *ResultSetInitFixtureStore) FindAndModify(query *ResultSetInitFixtureQuery, change mgo.Change) (*ResultSetInitFixture, error) {
+	var result *ResultSetInitFixture
+	_, err := s.Store.FindAndModify(query, change, &result)
+	if err != nil || result == nil {
+		return result, err
+	}
+
+	err = result.Init(result)
+
+	return result, err
+}
+
+// Insert insert the given document on the collection, trigger BeforeInsert and
+// AfterInsert if any. Throws ErrNonNewDocument if doc is a non-new document.
+func (s *ResultSetInitFixtureStore) Insert(doc *ResultSetInitFixture) error {
+
+	err := s.Store.Insert(doc)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Update update the given document on the collection, trigger BeforeUpdate and
+// AfterUpdate if any. Throws ErrNewDocument if doc is a new document.
+func (s *ResultSetInitFixtureStore) Update(doc *ResultSetInitFixture) error {
+
+	err := s.Store.Update(doc)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateDiff update the given document on the collection writing only the
+// changes from old, trigger BeforeUpdate and AfterUpdate if any. See
+// storable.Store.UpdateDiff.
+func (s *ResultSetInitFixtureStore) UpdateDiff(old, doc *ResultSetInitFixture) (storable.Changes, error) {
+
+	changes, err := s.Store.UpdateDiff(old, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// Save insert or update the given document on the collection using Upsert,
+// trigger BeforeUpdate and AfterUpdate if the document is non-new and
+// BeforeInsert and AfterInset if is new.
+func (s *ResultSetInitFixtureStore) Save(doc *ResultSetInitFixture) (updated bool, err error) {
+	updated, err = s.Store.Save(doc)
+	if err != nil {
+		return false, err
+	}
+
+	return
+}
+
+type ResultSetInitFixtureQuery struct {
+	storable.BaseQuery
+}
+
+// FindById add a new criteria to the query searching by _id
+func (q *ResultSetInitFixtureQuery) FindById(ids ...bson.ObjectId) *ResultSetInitFixtureQuery {
+	var vs []interface{}
+	for _, id := range ids {
+		vs = append(vs, id)
+	}
+	q.AddCriteria(operators.In(storable.IdField, vs...))
+
+	return q
+}
+
+// Clone returns a copy of the query, see storable.BaseQuery.Clone.
+func (q *ResultSetInitFixtureQuery) Clone() *ResultSetInitFixtureQuery {
+	return &ResultSetInitFixtureQuery{*q.BaseQuery.Clone()}
+}
+
+type ResultSetInitFixtureResultSet struct {
+	storable.ResultSet
+	last    *ResultSetInitFixture
+	lastErr error
+}
+
+// All returns all documents on the resultset and close the resultset
+func (r *ResultSetInitFixtureResultSet) All() ([]*ResultSetInitFixture, error) {
+	var result []*ResultSetInitFixture
+	err := r.ResultSet.All(&result)
+	if err != nil {
+		return result, err
+	}
+
+	for _, r := range result {
+		if err := r.Init(r); err != nil {
+			return result, err
+		}
+	}
+
+	return result, err
+}
+
+// One returns the first document on the resultset and close the resultset
+func (r *ResultSetInitFixtureResultSet) One() (*ResultSetInitFixture, error) {
+	var result *ResultSetInitFixture
+	err := r.ResultSet.One(&result)
+	if err != nil {
+		return result, err
+	}
+
+	err = result.Init(result)
+
+	return result, err
+}
+
+// Next prepares the next result document for reading with the Get method.
+func (r *ResultSetInitFixtureResultSet) Next() (returned bool) {
+	r.last = nil
+	returned, r.lastErr = r.ResultSet.Next(&r.last)
+	if r.lastErr != nil {
+		return
+	}
+	if returned {
+		r.lastErr = r.last.Init(r.last)
+	}
+
+	return
+}
+
+// Get returns the document retrieved with the Next method.
+func (r *ResultSetInitFixtureResultSet) Get() (*ResultSetInitFixture, error) {
+	return r.last, r.lastErr
+}
+
+// ForEach iterates the resultset calling to the given function.
+func (r *ResultSetInitFixtureResultSet) ForEach(f func(*ResultSetInitFixture) error) error {
+	for {
+		var result *ResultSetInitFixture
+		found, err := r.ResultSet.Next(&result)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			break
+		}
+
+		if err := result.Init(result); err != nil {
+			return err
+		}
+
+		err = f(result)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Watch returns a stream with the changes on the documents matching the given
+// query, the current version of the document is retrieved on every update.
+// See storable.Store.Watch.
+func (s *ResultSetInitFixtureStore) Watch(query *ResultSetInitFixtureQuery, opts storable.WatchOptions) (*ResultSetInitFixtureChangeStream, error) {
+	opts.FullDocument = true
+	cs, err := s.Store.Watch(query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResultSetInitFixtureChangeStream{ChangeStream: cs}, nil
+}
+
+type ResultSetInitFixtureChangeStream struct {
+	*storable.ChangeStream
+	event   storable.ChangeEvent
+	last    *ResultSetInitFixture
+	lastErr error
+}
+
+// Next waits for the next event, to be read with the Get method. Returns
+// false when the stream is closed or fails.
+func (cs *ResultSetInitFixtureChangeStream) Next() (returned bool) {
+	cs.event, cs.last, cs.lastErr = storable.ChangeEvent{}, nil, nil
+	if !cs.ChangeStream.Next(&cs.event) {
+		cs.lastErr = cs.ChangeStream.Err()
+		return false
+	}
+
+	if cs.event.HasDocument() {
+		cs.lastErr = cs.event.Decode(&cs.last)
+		if cs.lastErr == nil {
+			cs.lastErr = cs.last.Init(cs.last)
+		}
+	}
+
+	return true
+}
+
+// Get returns the event and the document retrieved with the Next method, the
+// document is nil on the deletes or if it does not exist anymore.
+func (cs *ResultSetInitFixtureChangeStream) Get() (*storable.ChangeEvent, *ResultSetInitFixture, error) {
+	return &cs.event, cs.last, cs.lastErr
+}
+
+// ForEach waits for the events calling to the given function, until the
+// stream is closed or storable.ErrStop is returned.
+func (cs *ResultSetInitFixtureChangeStream) ForEach(f func(*storable.ChangeEvent, *ResultSetInitFixture) error) error {
+	for cs.Next() {
+		event, doc, err := cs.Get()
+		if err != nil {
+			return err
+		}
+
+		err = f(event, doc)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return cs.lastErr
+}
+
+type SchemaFixtureStore struct {
+	storable.Store
+}
+
+func NewSchemaFixtureStore(db *mgo.Database) *SchemaFixtureStore {
+	return &SchemaFixtureStore{*storable.NewStore(db, "schema")}
+}
+
+// WithContext returns a copy of the store using the given context, see
+// storable.Store.WithContext.
+func (s *SchemaFixtureStore) WithContext(ctx context.Context) *SchemaFixtureStore {
+	return &SchemaFixtureStore{*s.Store.WithContext(ctx)}
+}
+
+// InTx returns a copy of the store running its operations, including the
+// hooks, inside the given transaction. See storable.WithTransaction.
+func (s *SchemaFixtureStore) InTx(tx *storable.Tx) *SchemaFixtureStore {
+	return &SchemaFixtureStore{*s.Store.InTx(tx)}
+}
+
+// New returns a new instance of SchemaFixture.
+func (s *SchemaFixtureStore) New() (doc *SchemaFixture) {
+	doc = &SchemaFixture{}
+	if doc != nil {
+		doc.SetIsNew(true)
+		doc.SetId(bson.NewObjectId())
+	}
+	return
+}
+
+// Query return a new instance of SchemaFixtureQuery.
+func (s *SchemaFixtureStore) Query() *SchemaFixtureQuery {
+	return &SchemaFixtureQuery{*storable.NewBaseQuery()}
+}
+
+// Find performs a find on the collection using the given query.
+func (s *SchemaFixtureStore) Find(query *SchemaFixtureQuery) (*SchemaFixtureResultSet, error) {
+	resultSet, err := s.Store.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SchemaFixtureResultSet{ResultSet: *resultSet}, nil
+}
+
+// MustFind like Find but panics on error
+func (s *SchemaFixtureStore) MustFind(query *SchemaFixtureQuery) *SchemaFixtureResultSet {
+	resultSet := s.Store.MustFind(query)
+	return &SchemaFixtureResultSet{ResultSet: *resultSet}
+}
+
+// Tail performs a find on the capped collection using the given query,
+// waiting for new documents up to the given timeout. See storable.Store.Tail.
+func (s *SchemaFixtureStore) Tail(query *SchemaFixtureQuery, timeout time.Duration) (*SchemaFixtureResultSet, error) {
+	resultSet, err := s.Store.Tail(query, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SchemaFixtureResultSet{ResultSet: *resultSet}, nil
+}
+
+// FindOne performs a find on the collection using the given query returning
+// the first document from the resultset.
+func (s *SchemaFixtureStore) FindOne(query *SchemaFixtureQuery) (*SchemaFixture, error) {
+	resultSet, err := s.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultSet.One()
+}
+
+// MustFindOne like FindOne but panics on error
+func (s *SchemaFixtureStore) MustFindOne(query *SchemaFixtureQuery) *SchemaFixture {
+	doc, err := s.FindOne(query)
+	if err != nil {
+		panic(err)
+	}
+
+	return doc
+}
+
+// FindAndModify atomically modifies the first document matching the given
+// query and returns it, before the change or after it if change.ReturnNew is
+// set. See storable.Store.FindAndModify.
+func (s *SchemaFixtureStore) FindAndModify(query *SchemaFixtureQuery, change mgo.Change) (*SchemaFixture, error) {
+	var result *SchemaFixture
+	_, err := s.Store.FindAndModify(query, change, &result)
+
+	return result, err
+}
+
+// Insert insert the given document on the collection, trigger BeforeInsert and
+// AfterInsert if any. Throws ErrNonNewDocument if doc is a non-new document.
+func (s *SchemaFixtureStore) Insert(doc *SchemaFixture) error {
+
+	err := s.Store.Insert(doc)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Update update the given document on the collection, trigger BeforeUpdate and
+// AfterUpdate if any. Throws ErrNewDocument if doc is a new document.
+func (s *SchemaFixtureStore) Update(doc *SchemaFixture) error {
+
+	err := s.Store.Update(doc)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateDiff update the given document on the collection writing only the
+// changes from old, trigger BeforeUpdate and AfterUpdate if any. See
+// storable.Store.UpdateDiff.
+func (s *SchemaFixtureStore) UpdateDiff(old, doc *SchemaFixture) (storable.Changes, error) {
+
+	changes, err := s.Store.UpdateDiff(old, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// Save insert or update the given document on the collection using Upsert,
+// trigger BeforeUpdate and AfterUpdate if the document is non-new and
+// BeforeInsert and AfterInset if is new.
+func (s *SchemaFixtureStore) Save(doc *SchemaFixture) (updated bool, err error) {
+	updated, err = s.Store.Save(doc)
+	if err != nil {
+		return false, err
+	}
+
+	return
+}
+
+type SchemaFixtureQuery struct {
+	storable.BaseQuery
+}
+
+// FindById add a new criteria to the query searching by _id
+func (q *SchemaFixtureQuery) FindById(ids ...bson.ObjectId) *SchemaFixtureQuery {
+	var vs []interface{}
+	for _, id := range ids {
+		vs = append(vs, id)
+	}
+	q.AddCriteria(operators.In(storable.IdField, vs...))
+
+	return q
+}
+
+// Clone returns a copy of the query, see storable.BaseQuery.Clone.
+func (q *SchemaFixtureQuery) Clone() *SchemaFixtureQuery {
+	return &SchemaFixtureQuery{*q.BaseQuery.Clone()}
+}
+
+type SchemaFixtureResultSet struct {
+	storable.ResultSet
+	last    *SchemaFixture
+	lastErr error
+}
+
+// All returns all documents on the resultset and close the resultset
+func (r *SchemaFixtureResultSet) All() ([]*SchemaFixture, error) {
+	var result []*SchemaFixture
+	err := r.ResultSet.All(&result)
+
+	return result, err
+}
+
+// One returns the first document on the resultset and close the resultset
+func (r *SchemaFixtureResultSet) One() (*SchemaFixture, error) {
+	var result *SchemaFixture
+	err := r.ResultSet.One(&result)
+
+	return result, err
+}
+
+// Next prepares the next result document for reading with the Get method.
+func (r *SchemaFixtureResultSet) Next() (returned bool) {
+	r.last = nil
+	returned, r.lastErr = r.ResultSet.Next(&r.last)
+
+	return
+}
+
+// Get returns the document retrieved with the Next method.
+func (r *SchemaFixtureResultSet) Get() (*SchemaFixture, error) {
+	return r.last, r.lastErr
+}
+
+// ForEach iterates the resultset calling to the given function.
+func (r *SchemaFixtureResultSet) ForEach(f func(*SchemaFixture) error) error {
+	for {
+		var result *SchemaFixture
+		found, err := r.ResultSet.Next(&result)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			break
+		}
+
+		err = f(result)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Watch returns a stream with the changes on the documents matching the given
+// query, the current version of the document is retrieved on every update.
+// See storable.Store.Watch.
+func (s *SchemaFixtureStore) Watch(query *SchemaFixtureQuery, opts storable.WatchOptions) (*SchemaFixtureChangeStream, error) {
+	opts.FullDocument = true
+	cs, err := s.Store.Watch(query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SchemaFixtureChangeStream{ChangeStream: cs}, nil
+}
+
+type SchemaFixtureChangeStream struct {
+	*storable.ChangeStream
+	event   storable.ChangeEvent
+	last    *SchemaFixture
+	lastErr error
+}
+
+// Next waits for the next event, to be read with the Get method. Returns
+// false when the stream is closed or fails.
+func (cs *SchemaFixtureChangeStream) Next() (returned bool) {
+	cs.event, cs.last, cs.lastErr = storable.ChangeEvent{}, nil, nil
+	if !cs.ChangeStream.Next(&cs.event) {
+		cs.lastErr = cs.ChangeStream.Err()
+		return false
+	}
+
+	if cs.event.HasDocument() {
+		cs.lastErr = cs.event.Decode(&cs.last)
+	}
+
+	return true
+}
+
+// Get returns the event and the document retrieved with the Next method, the
+// document is nil on the deletes or if it does not exist anymore.
+func (cs *SchemaFixtureChangeStream) Get() (*storable.ChangeEvent, *SchemaFixture, error) {
+	return &cs.event, cs.last, cs.lastErr
+}
+
+// ForEach waits for the events calling to the given function, until the
+// stream is closed or storable.ErrStop is returned.
+func (cs *SchemaFixtureChangeStream) ForEach(f func(*storable.ChangeEvent, *SchemaFixture) error) error {
+	for cs.Next() {
+		event, doc, err := cs.Get()
+		if err != nil {
+			return err
+		}
+
+		err = f(event, doc)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return cs.lastErr
+}
+
+type ScopesFixtureStore struct {
+	storable.Store
+}
+
+func NewScopesFixtureStore(db *mgo.Database) *ScopesFixtureStore {
+	return &ScopesFixtureStore{*storable.NewStore(db, "scopes")}
+}
+
+// WithContext returns a copy of the store using the given context, see
+// storable.Store.WithContext.
+func (s *ScopesFixtureStore) WithContext(ctx context.Context) *ScopesFixtureStore {
+	return &ScopesFixtureStore{*s.Store.WithContext(ctx)}
+}
+
+// InTx returns a copy of the store running its operations, including the
+// hooks, inside the given transaction. See storable.WithTransaction.
+func (s *ScopesFixtureStore) InTx(tx *storable.Tx) *ScopesFixtureStore {
+	return &ScopesFixtureStore{*s.Store.InTx(tx)}
+}
+
+// New returns a new instance of ScopesFixture.
+func (s *ScopesFixtureStore) New() (doc *ScopesFixture) {
+	doc = &ScopesFixture{}
+	if doc != nil {
+		doc.SetIsNew(true)
+		doc.SetId(bson.NewObjectId())
+	}
+	return
+}
+
+// Query return a new instance of ScopesFixtureQuery.
+func (s *ScopesFixtureStore) Query() *ScopesFixtureQuery {
+	q := &ScopesFixtureQuery{*storable.NewBaseQuery()}
+	defaults := &ScopesFixtureQuery{*storable.NewBaseQuery()}
+	defaults.scopeNotDeleted()
+	q.SetDefaultScope(&defaults.BaseQuery)
+	return q
+}
+
+// Find performs a find on the collection using the given query.
+func (s *ScopesFixtureStore) Find(query *ScopesFixtureQuery) (*ScopesFixtureResultSet, error) {
+	resultSet, err := s.Store.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ScopesFixtureResultSet{ResultSet: *resultSet}, nil
+}
+
+// MustFind like Find but panics on error
+func (s *ScopesFixtureStore) MustFind(query *ScopesFixtureQuery) *ScopesFixtureResultSet {
+	resultSet := s.Store.MustFind(query)
+	return &ScopesFixtureResultSet{ResultSet: *resultSet}
+}
+
+// Tail performs a find on the capped collection using the given query,
+// waiting for new documents up to the given timeout. See storable.Store.Tail.
+func (s *ScopesFixtureStore) Tail(query *ScopesFixtureQuery, timeout time.Duration) (*ScopesFixtureResultSet, error) {
+	resultSet, err := s.Store.Tail(query, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ScopesFixtureResultSet{ResultSet: *resultSet}, nil
+}
+
+// FindOne performs a find on the collection using the given query returning
+// the first document from the resultset.
+func (s *ScopesFixtureStore) FindOne(query *ScopesFixtureQuery) (*ScopesFixture, error) {
+	resultSet, err := s.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultSet.One()
+}
+
+// MustFindOne like FindOne but panics on error
+func (s *ScopesFixtureStore) MustFindOne(query *ScopesFixtureQuery) *ScopesFixture {
+	doc, err := s.FindOne(query)
+	if err != nil {
+		panic(err)
+	}
+
+	return doc
+}
+
+// FindAndModify atomically modifies the first document matching the given
+// query and returns it, before the change or after it if change.ReturnNew is
+// set. See storable.Store.FindAndModify.
+func (s *ScopesFixtureStore) FindAndModify(query *ScopesFixtureQuery, change mgo.Change) (*ScopesFixture, error) {
+	var result *ScopesFixture
+	_, err := s.Store.FindAndModify(query, change, &result)
+
+	return result, err
+}
+
+// Insert insert the given document on the collection, trigger BeforeInsert and
+// AfterInsert if any. Throws ErrNonNewDocument if doc is a non-new document.
+func (s *ScopesFixtureStore) Insert(doc *ScopesFixture) error {
+
+	err := s.Store.Insert(doc)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Update update the given document on the collection, trigger BeforeUpdate and
+// AfterUpdate if any. Throws ErrNewDocument if doc is a new document.
+func (s *ScopesFixtureStore) Update(doc *ScopesFixture) error {
+
+	err := s.Store.Update(doc)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateDiff update the given document on the collection writing only the
+// changes from old, trigger BeforeUpdate and AfterUpdate if any. See
+// storable.Store.UpdateDiff.
+func (s *ScopesFixtureStore) UpdateDiff(old, doc *ScopesFixture) (storable.Changes, error) {
+
+	changes, err := s.Store.UpdateDiff(old, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// Save insert or update the given document on the collection using Upsert,
+// trigger BeforeUpdate and AfterUpdate if the document is non-new and
+// BeforeInsert and AfterInset if is new.
+func (s *ScopesFixtureStore) Save(doc *ScopesFixture) (updated bool, err error) {
+	updated, err = s.Store.Save(doc)
+	if err != nil {
+		return false, err
+	}
+
+	return
+}
+
+type ScopesFixtureQuery struct {
+	storable.BaseQuery
+}
+
+// FindById add a new criteria to the query searching by _id
+func (q *ScopesFixtureQuery) FindById(ids ...bson.ObjectId) *ScopesFixtureQuery {
+	var vs []interface{}
+	for _, id := range ids {
+		vs = append(vs, id)
+	}
+	q.AddCriteria(operators.In(storable.IdField, vs...))
+
+	return q
+}
+
+// Clone returns a copy of the query, see storable.BaseQuery.Clone.
+func (q *ScopesFixtureQuery) Clone() *ScopesFixtureQuery {
+	return &ScopesFixtureQuery{*q.BaseQuery.Clone()}
+}
+
+// NotDeleted applies the scope scopeNotDeleted to the query.
+func (q *ScopesFixtureQuery) NotDeleted() *ScopesFixtureQuery {
+	q.scopeNotDeleted()
+	return q
+}
+
+// Published applies the scope scopePublished to the query.
+func (q *ScopesFixtureQuery) Published() *ScopesFixtureQuery {
+	q.scopePublished()
+	return q
+}
+
+// CheaperThan applies the scope cheaperThan to the query.
+func (q *ScopesFixtureQuery) CheaperThan(price float64) *ScopesFixtureQuery {
+	q.cheaperThan(price)
+	return q
+}
+
+// Unscoped disables the default scopes of the query.
+func (q *ScopesFixtureQuery) Unscoped() *ScopesFixtureQuery {
+	q.BaseQuery.Unscoped()
+	return q
+}
+
+type ScopesFixtureResultSet struct {
+	storable.ResultSet
+	last    *ScopesFixture
+	lastErr error
+}
+
+// All returns all documents on the resultset and close the resultset
+func (r *ScopesFixtureResultSet) All() ([]*ScopesFixture, error) {
+	var result []*ScopesFixture
+	err := r.ResultSet.All(&result)
+
+	return result, err
+}
+
+// One returns the first document on the resultset and close the resultset
+func (r *ScopesFixtureResultSet) One() (*ScopesFixture, error) {
+	var result *ScopesFixture
+	err := r.ResultSet.One(&result)
+
+	return result, err
+}
+
+// Next prepares the next result document for reading with the Get method.
+func (r *ScopesFixtureResultSet) Next() (returned bool) {
+	r.last = nil
+	returned, r.lastErr = r.ResultSet.Next(&r.last)
+
+	return
+}
+
+// Get returns the document retrieved with the Next method.
+func (r *ScopesFixtureResultSet) Get() (*ScopesFixture, error) {
+	return r.last, r.lastErr
+}
+
+// ForEach iterates the resultset calling to the given function.
+func (r *ScopesFixtureResultSet) ForEach(f func(*ScopesFixture) error) error {
+	for {
+		var result *ScopesFixture
+		found, err := r.ResultSet.Next(&result)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			break
+		}
+
+		err = f(result)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Watch returns a stream with the changes on the documents matching the given
+// query, the current version of the document is retrieved on every update.
+// See storable.Store.Watch.
+func (s *ScopesFixtureStore) Watch(query *ScopesFixtureQuery, opts storable.WatchOptions) (*ScopesFixtureChangeStream, error) {
+	opts.FullDocument = true
+	cs, err := s.Store.Watch(query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ScopesFixtureChangeStream{ChangeStream: cs}, nil
+}
+
+type ScopesFixtureChangeStream struct {
+	*storable.ChangeStream
+	event   storable.ChangeEvent
+	last    *ScopesFixture
+	lastErr error
+}
+
+// Next waits for the next event, to be read with the Get method. Returns
+// false when the stream is closed or fails.
+func (cs *ScopesFixtureChangeStream) Next() (returned bool) {
+	cs.event, cs.last, cs.lastErr = storable.ChangeEvent{}, nil, nil
+	if !cs.ChangeStream.Next(&cs.event) {
+		cs.lastErr = cs.ChangeStream.Err()
+		return false
+	}
+
+	if cs.event.HasDocument() {
+		cs.lastErr = cs.event.Decode(&cs.last)
+	}
+
+	return true
+}
+
+// Get returns the event and the document retrieved with the Next method, the
+// document is nil on the deletes or if it does not exist anymore.
+func (cs *ScopesFixtureChangeStream) Get() (*storable.ChangeEvent, *ScopesFixture, error) {
+	return &cs.event, cs.last, cs.lastErr
+}
+
+// ForEach waits for the events calling to the given function, until the
+// stream is closed or storable.ErrStop is returned.
+func (cs *ScopesFixtureChangeStream) ForEach(f func(*storable.ChangeEvent, *ScopesFixture) error) error {
+	for cs.Next() {
+		event, doc, err := cs.Get()
+		if err != nil {
+			return err
+		}
+
+		err = f(event, doc)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return cs.lastErr
+}
+
+type StoreFixtureStore struct {
+	storable.Store
+}
+
+func NewStoreFixtureStore(db *mgo.Database) *StoreFixtureStore {
+	return &StoreFixtureStore{*storable.NewStore(db, "store")}
+}
+
+// WithContext returns a copy of the store using the given context, see
+// storable.Store.WithContext.
+func (s *StoreFixtureStore) WithContext(ctx context.Context) *StoreFixtureStore {
+	return &StoreFixtureStore{*s.Store.WithContext(ctx)}
+}
+
+// InTx returns a copy of the store running its operations, including the
+// hooks, inside the given transaction. See storable.WithTransaction.
+func (s *StoreFixtureStore) InTx(tx *storable.Tx) *StoreFixtureStore {
+	return &StoreFixtureStore{*s.Store.InTx(tx)}
+}
+
+// New returns a new instance of StoreFixture.
+func (s *StoreFixtureStore) New() (doc *StoreFixture) {
+	doc = &StoreFixture{}
+	if doc != nil {
+		doc.SetIsNew(true)
+		doc.SetId(bson.NewObjectId())
+	}
+	return
+}
+
+// Query return a new instance of StoreFixtureQuery.
+func (s *StoreFixtureStore) Query() *StoreFixtureQuery {
+	return &StoreFixtureQuery{*storable.NewBaseQuery()}
+}
+
+// Find performs a find on the collection using the given query.
+func (s *StoreFixtureStore) Find(query *StoreFixtureQuery) (*StoreFixtureResultSet, error) {
+	resultSet, err := s.Store.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StoreFixtureResultSet{ResultSet: *resultSet}, nil
+}
+
+// MustFind like Find but panics on error
+func (s *StoreFixtureStore) MustFind(query *StoreFixtureQuery) *StoreFixtureResultSet {
+	resultSet := s.Store.MustFind(query)
+	return &StoreFixtureResultSet{ResultSet: *resultSet}
+}
+
+// Tail performs a find on the capped collection using the given query,
+// waiting for new documents up to the given timeout. See storable.Store.Tail.
+func (s *StoreFixtureStore) Tail(query *StoreFixtureQuery, timeout time.Duration) (*StoreFixtureResultSet, error) {
+	resultSet, err := s.Store.Tail(query, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StoreFixtureResultSet{ResultSet: *resultSet}, nil
+}
+
+// FindOne performs a find on the collection using the given query returning
+// the first document from the resultset.
+func (s *StoreFixtureStore) FindOne(query *StoreFixtureQuery) (*StoreFixture, error) {
+	resultSet, err := s.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultSet.One()
+}
+
+// MustFindOne like FindOne but panics on error
+func (s *StoreFixtureStore) MustFindOne(query *StoreFixtureQuery) *StoreFixture {
+	doc, err := s.FindOne(query)
+	if err != nil {
+		panic(err)
+	}
+
+	return doc
+}
+
+// FindAndModify atomically modifies the first document matching the given
+// query and returns it, before the change or after it if change.ReturnNew is
+// set. See storable.Store.FindAndModify.
+func (s *StoreFixtureStore) FindAndModify(query *StoreFixtureQuery, change mgo.Change) (*StoreFixture, error) {
+	var result *StoreFixture
+	_, err := s.Store.FindAndModify(query, change, &result)
+
+	return result, err
+}
+
+// Insert insert the given document on the collection, trigger BeforeInsert and
+// AfterInsert if any. Throws ErrNonNewDocument if doc is a non-new document.
+func (s *StoreFixtureStore) Insert(doc *StoreFixture) error {
+
+	err := s.Store.Insert(doc)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Update update the given document on the collection, trigger BeforeUpdate and
+// AfterUpdate if any. Throws ErrNewDocument if doc is a new document.
+func (s *StoreFixtureStore) Update(doc *StoreFixture) error {
+
+	err := s.Store.Update(doc)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateDiff update the given document on the collection writing only the
+// changes from old, trigger BeforeUpdate and AfterUpdate if any. See
+// storable.Store.UpdateDiff.
+func (s *StoreFixtureStore) UpdateDiff(old, doc *StoreFixture) (storable.Changes, error) {
+
+	changes, err := s.Store.UpdateDiff(old, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// Save insert or update the given document on the collection using Upsert,
+// trigger BeforeUpdate and AfterUpdate if the document is non-new and
+// BeforeInsert and AfterInset if is new.
+func (s *StoreFixtureStore) Save(doc *StoreFixture) (updated bool, err error) {
+	updated, err = s.Store.Save(doc)
+	if err != nil {
+		return false, err
+	}
+
+	return
+}
+
+type StoreFixtureQuery struct {
+	storable.BaseQuery
+}
+
+// FindById add a new criteria to the query searching by _id
+func (q *StoreFixtureQuery) FindById(ids ...bson.ObjectId) *StoreFixtureQuery {
+	var vs []interface{}
+	for _, id := range ids {
+		vs = append(vs, id)
+	}
+	q.AddCriteria(operators.In(storable.IdField, vs...))
+
+	return q
+}
+
+// Clone returns a copy of the query, see storable.BaseQuery.Clone.
+func (q *StoreFixtureQuery) Clone() *StoreFixtureQuery {
+	return &StoreFixtureQuery{*q.BaseQuery.Clone()}
+}
+
+type StoreFixtureResultSet struct {
+	storable.ResultSet
+	last    *StoreFixture
+	lastErr error
+}
+
+// All returns all documents on the resultset and close the resultset
+func (r *StoreFixtureResultSet) All() ([]*StoreFixture, error) {
+	var result []*StoreFixture
+	err := r.ResultSet.All(&result)
+
+	return result, err
+}
+
+// One returns the first document on the resultset and close the resultset
+func (r *StoreFixtureResultSet) One() (*StoreFixture, error) {
+	var result *StoreFixture
+	err := r.ResultSet.One(&result)
+
+	return result, err
+}
+
+// Next prepares the next result document for reading with the Get method.
+func (r *StoreFixtureResultSet) Next() (returned bool) {
+	r.last = nil
+	returned, r.lastErr = r.ResultSet.Next(&r.last)
+
+	return
+}
+
+// Get returns the document retrieved with the Next method.
+func (r *StoreFixtureResultSet) Get() (*StoreFixture, error) {
+	return r.last, r.lastErr
+}
+
+// ForEach iterates the resultset calling to the given function.
+func (r *StoreFixtureResultSet) ForEach(f func(*StoreFixture) error) error {
+	for {
+		var result *StoreFixture
+		found, err := r.ResultSet.Next(&result)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			break
+		}
+
+		err = f(result)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Watch returns a stream with the changes on the documents matching the given
+// query, the current version of the document is retrieved on every update.
+// See storable.Store.Watch.
+func (s *StoreFixtureStore) Watch(query *StoreFixtureQuery, opts storable.WatchOptions) (*StoreFixtureChangeStream, error) {
+	opts.FullDocument = true
+	cs, err := s.Store.Watch(query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StoreFixtureChangeStream{ChangeStream: cs}, nil
+}
+
+type StoreFixtureChangeStream struct {
+	*storable.ChangeStream
+	event   storable.ChangeEvent
+	last    *StoreFixture
+	lastErr error
+}
+
+// Next waits for the next event, to be read with the Get method. Returns
+// false when the stream is closed or fails.
+func (cs *StoreFixtureChangeStream) Next() (returned bool) {
+	cs.event, cs.last, cs.lastErr = storable.ChangeEvent{}, nil, nil
+	if !cs.ChangeStream.Next(&cs.event) {
+		cs.lastErr = cs.ChangeStream.Err()
+		return false
+	}
+
+	if cs.event.HasDocument() {
+		cs.lastErr = cs.event.Decode(&cs.last)
+	}
+
+	return true
+}
+
+// Get returns the event and the document retrieved with the Next method, the
+// document is nil on the deletes or if it does not exist anymore.
+func (cs *StoreFixtureChangeStream) Get() (*storable.ChangeEvent, *StoreFixture, error) {
+	return &cs.event, cs.last, cs.lastErr
+}
+
+// ForEach waits for the events calling to the given function, until the
+// stream is closed or storable.ErrStop is returned.
+func (cs *StoreFixtureChangeStream) ForEach(f func(*storable.ChangeEvent, *StoreFixture) error) error {
+	for cs.Next() {
+		event, doc, err := cs.Get()
+		if err != nil {
+			return err
+		}
+
+		err = f(event, doc)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return cs.lastErr
+}
+
+type StoreWithConstructFixtureStore struct {
+	storable.Store
+}
+
+func NewStoreWithConstructFixtureStore(db *mgo.Database) *StoreWithConstructFixtureStore {
+	return &StoreWithConstructFixtureStore{*storable.NewStore(db, "store_construct")}
+}
+
+// WithContext returns a copy of the store using the given context, see
+// storable.Store.WithContext.
+func (s *StoreWithConstructFixtureStore) WithContext(ctx context.Context) *StoreWithConstructFixtureStore {
+	return &StoreWithConstructFixtureStore{*s.Store.WithContext(ctx)}
+}
+
+// InTx returns a copy of the store running its operations, including the
+// hooks, inside the given transaction. See storable.WithTransaction.
+func (s *StoreWithConstructFixtureStore) InTx(tx *storable.Tx) *StoreWithConstructFixtureStore {
+	return &StoreWithConstructFixtureStore{*s.Store.InTx(tx)}
+}
+
+// New returns a new instance of StoreWithConstructFixture.
+func (s *StoreWithConstructFixtureStore) New(f string) (doc *StoreWithConstructFixture) {
+	doc = newStoreWithConstructFixture(f)
+	if doc != nil {
+		doc.SetIsNew(true)
+		doc.SetId(bson.NewObjectId())
+	}
+	return
+}
+
+// Query return a new instance of StoreWithConstructFixtureQuery.
+func (s *StoreWithConstructFixtureStore) Query() *StoreWithConstructFixtureQuery {
+	return &StoreWithConstructFixtureQuery{*storable.NewBaseQuery()}
+}
+
+// Find performs a find on the collection using the given query.
+func (s *StoreWithConstructFixtureStore) Find(query *StoreWithConstructFixtureQuery) (*StoreWithConstructFixtureResultSet, error) {
+	resultSet, err := s.Store.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StoreWithConstructFixtureResultSet{ResultSet: *resultSet}, nil
+}
+
+// MustFind like Find but panics on error
+func (s *StoreWithConstructFixtureStore) MustFind(query *StoreWithConstructFixtureQuery) *StoreWithConstructFixtureResultSet {
+	resultSet := s.Store.MustFind(query)
+	return &StoreWithConstructFixtureResultSet{ResultSet: *resultSet}
+}
+
+// Tail performs a find on the capped collection using the given query,
+// waiting for new documents up to the given timeout. See storable.Store.Tail.
+func (s *StoreWithConstructFixtureStore) Tail(query *StoreWithConstructFixtureQuery, timeout time.Duration) (*StoreWithConstructFixtureResultSet, error) {
+	resultSet, err := s.Store.Tail(query, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StoreWithConstructFixtureResultSet{ResultSet: *resultSet}, nil
+}
+
+// FindOne performs a find on the collection using the given query returning
+// the first document from the resultset.
+func (s *StoreWithConstructFixtureStore) FindOne(query *StoreWithConstructFixtureQuery) (*StoreWithConstructFixture, error) {
+	resultSet, err := s.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultSet.One()
+}
+
+// MustFindOne like FindOne but panics on error
+func (s *StoreWithConstructFixtureStore) MustFindOne(query *StoreWithConstructFixtureQuery) *StoreWithConstructFixture {
+	doc, err := s.FindOne(query)
+	if err != nil {
+		panic(err)
+	}
+
+	return doc
+}
+
+// FindAndModify atomically modifies the first document matching the given
+// query and returns it, before the change or after it if change.ReturnNew is
+// set. See storable.Store.FindAndModify.
+func (s *StoreWithConstructFixtureStore) FindAndModify(query *StoreWithConstructFixtureQuery, change mgo.Change) (*StoreWithConstructFixture, error) {
+	var result *StoreWithConstructFixture
+	_, err := s.Store.FindAndModify(query, change, &result)
+
+	return result, err
+}
+
+// Insert insert the given document on the collection, trigger BeforeInsert and
+// AfterInsert if any. Throws ErrNonNewDocument if doc is a non-new document.
+func (s *StoreWithConstructFixtureStore) Insert(doc *StoreWithConstructFixture) error {
+
+	err := s.Store.Insert(doc)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Update update the given document on the collection, trigger BeforeUpdate and
+// AfterUpdate if any. Throws ErrNewDocument if doc is a new document.
+func (s *StoreWithConstructFixtureStore) Update(doc *StoreWithConstructFixture) error {
+
+	err := s.Store.Update(doc)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateDiff update the given document on the collection writing only the
+// changes from old, trigger BeforeUpdate and AfterUpdate if any. See
+// storable.Store.UpdateDiff.
+func (s *StoreWithConstructFixtureStore) UpdateDiff(old, doc *StoreWithConstructFixture) (storable.Changes, error) {
+
+	changes, err := s.Store.UpdateDiff(old, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// Save insert or update the given document on the collection using Upsert,
+// trigger BeforeUpdate and AfterUpdate if the document is non-new and
+// BeforeInsert and AfterInset if is new.
+func (s *StoreWithConstructFixtureStore) Save(doc *StoreWithConstructFixture) (updated bool, err error) {
+	updated, err = s.Store.Save(doc)
+	if err != nil {
+		return false, err
+	}
+
+	return
+}
+
+type StoreWithConstructFixtureQuery struct {
+	storable.BaseQuery
+}
+
+// FindById add a new criteria to the query searching by _id
+func (q *StoreWithConstructFixtureQuery) FindById(ids ...bson.ObjectId) *StoreWithConstructFixtureQuery {
+	var vs []interface{}
+	for _, id := range ids {
+		vs = append(vs, id)
+	}
+	q.AddCriteria(operators.In(storable.IdField, vs...))
+
+	return q
+}
+
+// Clone returns a copy of the query, see storable.BaseQuery.Clone.
+func (q *StoreWithConstructFixtureQuery) Clone() *StoreWithConstructFixtureQuery {
+	return &StoreWithConstructFixtureQuery{*q.BaseQuery.Clone()}
+}
+
+type StoreWithConstructFixtureResultSet struct {
+	storable.ResultSet
+	last    *StoreWithConstructFixture
+	lastErr error
+}
+
+// All returns all documents on the resultset and close the resultset
+func (r *StoreWithConstructFixtureResultSet) All() ([]*StoreWithConstructFixture, error) {
+	var result []*StoreWithConstructFixture
+	err := r.ResultSet.All(&result)
+
+	return result, err
+}
+
+// One returns the first document on the resultset and close the resultset
+func (r *StoreWithConstructFixtureResultSet) One() (*StoreWithConstructFixture, error) {
+	var result *StoreWithConstructFixture
+	err := r.ResultSet.One(&result)
+
+	return result, err
+}
+
+// Next prepares the next result document for reading with the Get method.
+func (r *StoreWithConstructFixtureResultSet) Next() (returned bool) {
+	r.last = nil
+	returned, r.lastErr = r.ResultSet.Next(&r.last)
+
+	return
+}
+
+// Get returns the document retrieved with the Next method.
+func (r *StoreWithConstructFixtureResultSet) Get() (*StoreWithConstructFixture, error) {
+	return r.last, r.lastErr
+}
+
+// ForEach iterates the resultset calling to the given function.
+func (r *StoreWithConstructFixtureResultSet) ForEach(f func(*StoreWithConstructFixture) error) error {
+	for {
+		var result *StoreWithConstructFixture
+		found, err := r.ResultSet.Next(&result)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			break
+		}
+
+		err = f(result)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Watch returns a stream with the changes on the documents matching the given
+// query, the current version of the document is retrieved on every update.
+// See storable.Store.Watch.
+func (s *StoreWithConstructFixtureStore) Watch(query *StoreWithConstructFixtureQuery, opts storable.WatchOptions) (*StoreWithConstructFixtureChangeStream, error) {
+	opts.FullDocument = true
+	cs, err := s.Store.Watch(query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StoreWithConstructFixtureChangeStream{ChangeStream: cs}, nil
+}
+
+type StoreWithConstructFixtureChangeStream struct {
+	*storable.ChangeStream
+	event   storable.ChangeEvent
+	last    *StoreWithConstructFixture
+	lastErr error
+}
+
+// Next waits for the next event, to be read with the Get method. Returns
+// false when the stream is closed or fails.
+func (cs *StoreWithConstructFixtureChangeStream) Next() (returned bool) {
+	cs.event, cs.last, cs.lastErr = storable.ChangeEvent{}, nil, nil
+	if !cs.ChangeStream.Next(&cs.event) {
+		cs.lastErr = cs.ChangeStream.Err()
+		return false
+	}
+
+	if cs.event.HasDocument() {
+		cs.lastErr = cs.event.Decode(&cs.last)
+	}
+
+	return true
+}
+
+// Get returns the event and the document retrieved with the Next method, the
+// document is nil on the deletes or if it does not exist anymore.
+func (cs *StoreWithConstructFixtureChangeStream) Get() (*storable.ChangeEvent, *StoreWithConstructFixture, error) {
+	return &cs.event, cs.last, cs.lastErr
+}
+
+// ForEach waits for the events calling to the given function, until the
+// stream is closed or storable.ErrStop is returned.
+func (cs *StoreWithConstructFixtureChangeStream) ForEach(f func(*storable.ChangeEvent, *StoreWithConstructFixture) error) error {
+	for cs.Next() {
+		event, doc, err := cs.Get()
+		if err != nil {
+			return err
+		}
+
+		err = f(event, doc)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return cs.lastErr
+}
+
+type StoreWithNewFixtureStore struct {
+	storable.Store
+}
+
+func NewStoreWithNewFixtureStore(db *mgo.Database) *StoreWithNewFixtureStore {
+	return &StoreWithNewFixtureStore{*storable.NewStore(db, "store_new")}
+}
+
+// WithContext returns a copy of the store using the given context, see
+// storable.Store.WithContext.
+func (s *StoreWithNewFixtureStore) WithContext(ctx context.Context) *StoreWithNewFixtureStore {
+	return &StoreWithNewFixtureStore{*s.Store.WithContext(ctx)}
+}
+
+// InTx returns a copy of the store running its operations, including the
+// hooks, inside the given transaction. See storable.WithTransaction.
+func (s *StoreWithNewFixtureStore) InTx(tx *storable.Tx) *StoreWithNewFixtureStore {
+	return &StoreWithNewFixtureStore{*s.Store.InTx(tx)}
+}
+
+// Query return a new instance of StoreWithNewFixtureQuery.
+func (s *StoreWithNewFixtureStore) Query() *StoreWithNewFixtureQuery {
+	return &StoreWithNewFixtureQuery{*storable.NewBaseQuery()}
+}
+
+// Find performs a find on the collection using the given query.
+func (s *StoreWithNewFixtureStore) Find(query *StoreWithNewFixtureQuery) (*StoreWithNewFixtureResultSet, error) {
+	resultSet, err := s.Store.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StoreWithNewFixtureResultSet{ResultSet: *resultSet}, nil
+}
+
+// MustFind like Find but panics on error
+func (s *StoreWithNewFixtureStore) MustFind(query *StoreWithNewFixtureQuery) *StoreWithNewFixtureResultSet {
+	resultSet := s.Store.MustFind(query)
+	return &StoreWithNewFixtureResultSet{ResultSet: *resultSet}
+}
+
+// Tail performs a find on the capped collection using the given query,
+// waiting for new documents up to the given timeout. See storable.Store.Tail.
+func (s *StoreWithNewFixtureStore) Tail(query *StoreWithNewFixtureQuery, timeout time.Duration) (*StoreWithNewFixtureResultSet, error) {
+	resultSet, err := s.Store.Tail(query, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StoreWithNewFixtureResultSet{ResultSet: *resultSet}, nil
+}
+
+// FindOne performs a find on the collection using the given query returning
+// the first document from the resultset.
+func (s *StoreWithNewFixtureStore) FindOne(query *StoreWithNewFixtureQuery) (*StoreWithNewFixture, error) {
+	resultSet, err := s.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultSet.One()
+}
+
+// MustFindOne like FindOne but panics on error
+func (s *StoreWithNewFixtureStore) MustFindOne(query *StoreWithNewFixtureQuery) *StoreWithNewFixture {
+	doc, err := s.FindOne(query)
+	if err != nil {
+		panic(err)
+	}
+
+	return doc
+}
+
+// FindAndModify atomically modifies the first document matching the given
+// query and returns it, before the change or after it if change.ReturnNew is
+// set. See storable.Store.FindAndModify.
+func (s *StoreWithNewFixtureStore) FindAndModify(query *StoreWithNewFixtureQuery, change mgo.Change) (*StoreWithNewFixture, error) {
+	var result *StoreWithNewFixture
+	_, err := s.Store.FindAndModify(query, change, &result)
+
+	return result, err
+}
+
+// Insert insert the given document on the collection, trigger BeforeInsert and
+// AfterInsert if any. Throws ErrNonNewDocument if doc is a non-new document.
+func (s *StoreWithNewFixtureStore) Insert(doc *StoreWithNewFixture) error {
+
+	err := s.Store.Insert(doc)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Update update the given document on the collection, trigger BeforeUpdate and
+// AfterUpdate if any. Throws ErrNewDocument if doc is a new document.
+func (s *StoreWithNewFixtureStore) Update(doc *StoreWithNewFixture) error {
+
+	err := s.Store.Update(doc)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateDiff update the given document on the collection writing only the
+// changes from old, trigger BeforeUpdate and AfterUpdate if any. See
+// storable.Store.UpdateDiff.
+func (s *StoreWithNewFixtureStore) UpdateDiff(old, doc *StoreWithNewFixture) (storable.Changes, error) {
+
+	changes, err := s.Store.UpdateDiff(old, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// Save insert or update the given document on the collection using Upsert,
+// trigger BeforeUpdate and AfterUpdate if the document is non-new and
+// BeforeInsert and AfterInset if is new.
+func (s *StoreWithNewFixtureStore) Save(doc *StoreWithNewFixture) (updated bool, err error) {
+	updated, err = s.Store.Save(doc)
+	if err != nil {
+		return false, err
+	}
+
+	return
+}
+
+type StoreWithNewFixtureQuery struct {
+	storable.BaseQuery
+}
+
+// FindById add a new criteria to the query searching by _id
+func (q *StoreWithNewFixtureQuery) FindById(ids ...bson.ObjectId) *StoreWithNewFixtureQuery {
+	var vs []interface{}
+	for _, id := range ids {
+		vs = append(vs, id)
+	}
+	q.AddCriteria(operators.In(storable.IdField, vs...))
+
+	return q
+}
+
+// Clone returns a copy of the query, see storable.BaseQuery.Clone.
+func (q *StoreWithNewFixtureQuery) Clone() *StoreWithNewFixtureQuery {
+	return &StoreWithNewFixtureQuery{*q.BaseQuery.Clone()}
+}
+
+type StoreWithNewFixtureResultSet struct {
+	storable.ResultSet
+	last    *StoreWithNewFixture
+	lastErr error
+}
+
+// All returns all documents on the resultset and close the resultset
+func (r *StoreWithNewFixtureResultSet) All() ([]*StoreWithNewFixture, error) {
+	var result []*StoreWithNewFixture
+	err := r.ResultSet.All(&result)
+
+	return result, err
+}
+
+// One returns the first document on the resultset and close the resultset
+func (r *StoreWithNewFixtureResultSet) One() (*StoreWithNewFixture, error) {
+	var result *StoreWithNewFixture
+	err := r.ResultSet.One(&result)
+
+	return result, err
+}
+
+// Next prepares the next result document for reading with the Get method.
+func (r *StoreWithNewFixtureResultSet) Next() (returned bool) {
 	r.last = nil
 	returned, r.lastErr = r.ResultSet.Next(&r.last)
 
@@ -1757,9 +7434,583 @@ func (r *StoreWithNewFixtureResultSet) Get() (*StoreWithNewFixture, error) {
 }
 
 // ForEach iterates the resultset calling to the given function.
-func (r *StoreWithNewFixtureResultSet) ForEach(f func(*StoreWithNewFixture) error) error {
+func (r *StoreWithNewFixtureResultSet) ForEach(f func(*StoreWithNewFixture) error) error {
+	for {
+		var result *StoreWithNewFixture
+		found, err := r.ResultSet.Next(&result)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			break
+		}
+
+		err = f(result)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Watch returns a stream with the changes on the documents matching the given
+// query, the current version of the document is retrieved on every update.
+// See storable.Store.Watch.
+func (s *StoreWithNewFixtureStore) Watch(query *StoreWithNewFixtureQuery, opts storable.WatchOptions) (*StoreWithNewFixtureChangeStream, error) {
+	opts.FullDocument = true
+	cs, err := s.Store.Watch(query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StoreWithNewFixtureChangeStream{ChangeStream: cs}, nil
+}
+
+type StoreWithNewFixtureChangeStream struct {
+	*storable.ChangeStream
+	event   storable.ChangeEvent
+	last    *StoreWithNewFixture
+	lastErr error
+}
+
+// Next waits for the next event, to be read with the Get method. Returns
+// false when the stream is closed or fails.
+func (cs *StoreWithNewFixtureChangeStream) Next() (returned bool) {
+	cs.event, cs.last, cs.lastErr = storable.ChangeEvent{}, nil, nil
+	if !cs.ChangeStream.Next(&cs.event) {
+		cs.lastErr = cs.ChangeStream.Err()
+		return false
+	}
+
+	if cs.event.HasDocument() {
+		cs.lastErr = cs.event.Decode(&cs.last)
+	}
+
+	return true
+}
+
+// Get returns the event and the document retrieved with the Next method, the
+// document is nil on the deletes or if it does not exist anymore.
+func (cs *StoreWithNewFixtureChangeStream) Get() (*storable.ChangeEvent, *StoreWithNewFixture, error) {
+	return &cs.event, cs.last, cs.lastErr
+}
+
+// ForEach waits for the events calling to the given function, until the
+// stream is closed or storable.ErrStop is returned.
+func (cs *StoreWithNewFixtureChangeStream) ForEach(f func(*storable.ChangeEvent, *StoreWithNewFixture) error) error {
+	for cs.Next() {
+		event, doc, err := cs.Get()
+		if err != nil {
+			return err
+		}
+
+		err = f(event, doc)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return cs.lastErr
+}
+
+type TextFixtureStore struct {
+	storable.Store
+}
+
+func NewTextFixtureStore(db *mgo.Database) *TextFixtureStore {
+	return &TextFixtureStore{*storable.NewStore(db, "text")}
+}
+
+// WithContext returns a copy of the store using the given context, see
+// storable.Store.WithContext.
+func (s *TextFixtureStore) WithContext(ctx context.Context) *TextFixtureStore {
+	return &TextFixtureStore{*s.Store.WithContext(ctx)}
+}
+
+// InTx returns a copy of the store running its operations, including the
+// hooks, inside the given transaction. See storable.WithTransaction.
+func (s *TextFixtureStore) InTx(tx *storable.Tx) *TextFixtureStore {
+	return &TextFixtureStore{*s.Store.InTx(tx)}
+}
+
+// New returns a new instance of TextFixture.
+func (s *TextFixtureStore) New() (doc *TextFixture) {
+	doc = &TextFixture{}
+	if doc != nil {
+		doc.SetIsNew(true)
+		doc.SetId(bson.NewObjectId())
+	}
+	return
+}
+
+// Query return a new instance of TextFixtureQuery.
+func (s *TextFixtureStore) Query() *TextFixtureQuery {
+	return &TextFixtureQuery{*storable.NewBaseQuery()}
+}
+
+// Find performs a find on the collection using the given query.
+func (s *TextFixtureStore) Find(query *TextFixtureQuery) (*TextFixtureResultSet, error) {
+	resultSet, err := s.Store.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TextFixtureResultSet{ResultSet: *resultSet}, nil
+}
+
+// MustFind like Find but panics on error
+func (s *TextFixtureStore) MustFind(query *TextFixtureQuery) *TextFixtureResultSet {
+	resultSet := s.Store.MustFind(query)
+	return &TextFixtureResultSet{ResultSet: *resultSet}
+}
+
+// Tail performs a find on the capped collection using the given query,
+// waiting for new documents up to the given timeout. See storable.Store.Tail.
+func (s *TextFixtureStore) Tail(query *TextFixtureQuery, timeout time.Duration) (*TextFixtureResultSet, error) {
+	resultSet, err := s.Store.Tail(query, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TextFixtureResultSet{ResultSet: *resultSet}, nil
+}
+
+// FindOne performs a find on the collection using the given query returning
+// the first document from the resultset.
+func (s *TextFixtureStore) FindOne(query *TextFixtureQuery) (*TextFixture, error) {
+	resultSet, err := s.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultSet.One()
+}
+
+// MustFindOne like FindOne but panics on error
+func (s *TextFixtureStore) MustFindOne(query *TextFixtureQuery) *TextFixture {
+	doc, err := s.FindOne(query)
+	if err != nil {
+		panic(err)
+	}
+
+	return doc
+}
+
+// FindAndModify atomically modifies the first document matching the given
+// query and returns it, before the change or after it if change.ReturnNew is
+// set. See storable.Store.FindAndModify.
+func (s *TextFixtureStore) FindAndModify(query *TextFixtureQuery, change mgo.Change) (*TextFixture, error) {
+	var result *TextFixture
+	_, err := s.Store.FindAndModify(query, change, &result)
+
+	return result, err
+}
+
+// Insert insert the given document on the collection, trigger BeforeInsert and
+// AfterInsert if any. Throws ErrNonNewDocument if doc is a non-new document.
+func (s *TextFixtureStore) Insert(doc *TextFixture) error {
+
+	err := s.Store.Insert(doc)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Update update the given document on the collection, trigger BeforeUpdate and
+// AfterUpdate if any. Throws ErrNewDocument if doc is a new document.
+func (s *TextFixtureStore) Update(doc *TextFixture) error {
+
+	err := s.Store.Update(doc)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateDiff update the given document on the collection writing only the
+// changes from old, trigger BeforeUpdate and AfterUpdate if any. See
+// storable.Store.UpdateDiff.
+func (s *TextFixtureStore) UpdateDiff(old, doc *TextFixture) (storable.Changes, error) {
+
+	changes, err := s.Store.UpdateDiff(old, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// Save insert or update the given document on the collection using Upsert,
+// trigger BeforeUpdate and AfterUpdate if the document is non-new and
+// BeforeInsert and AfterInset if is new.
+func (s *TextFixtureStore) Save(doc *TextFixture) (updated bool, err error) {
+	updated, err = s.Store.Save(doc)
+	if err != nil {
+		return false, err
+	}
+
+	return
+}
+
+type TextFixtureQuery struct {
+	storable.BaseQuery
+}
+
+// FindById add a new criteria to the query searching by _id
+func (q *TextFixtureQuery) FindById(ids ...bson.ObjectId) *TextFixtureQuery {
+	var vs []interface{}
+	for _, id := range ids {
+		vs = append(vs, id)
+	}
+	q.AddCriteria(operators.In(storable.IdField, vs...))
+
+	return q
+}
+
+// Clone returns a copy of the query, see storable.BaseQuery.Clone.
+func (q *TextFixtureQuery) Clone() *TextFixtureQuery {
+	return &TextFixtureQuery{*q.BaseQuery.Clone()}
+}
+
+type TextFixtureResultSet struct {
+	storable.ResultSet
+	last    *TextFixture
+	lastErr error
+}
+
+// All returns all documents on the resultset and close the resultset
+func (r *TextFixtureResultSet) All() ([]*TextFixture, error) {
+	var result []*TextFixture
+	err := r.ResultSet.All(&result)
+
+	return result, err
+}
+
+// One returns the first document on the resultset and close the resultset
+func (r *TextFixtureResultSet) One() (*TextFixture, error) {
+	var result *TextFixture
+	err := r.ResultSet.One(&result)
+
+	return result, err
+}
+
+// Next prepares the next result document for reading with the Get method.
+func (r *TextFixtureResultSet) Next() (returned bool) {
+	r.last = nil
+	returned, r.lastErr = r.ResultSet.Next(&r.last)
+
+	return
+}
+
+// Get returns the document retrieved with the Next method.
+func (r *TextFixtureResultSet) Get() (*TextFixture, error) {
+	return r.last, r.lastErr
+}
+
+// ForEach iterates the resultset calling to the given function.
+func (r *TextFixtureResultSet) ForEach(f func(*TextFixture) error) error {
+	for {
+		var result *TextFixture
+		found, err := r.ResultSet.Next(&result)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			break
+		}
+
+		err = f(result)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Watch returns a stream with the changes on the documents matching the given
+// query, the current version of the document is retrieved on every update.
+// See storable.Store.Watch.
+func (s *TextFixtureStore) Watch(query *TextFixtureQuery, opts storable.WatchOptions) (*TextFixtureChangeStream, error) {
+	opts.FullDocument = true
+	cs, err := s.Store.Watch(query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TextFixtureChangeStream{ChangeStream: cs}, nil
+}
+
+type TextFixtureChangeStream struct {
+	*storable.ChangeStream
+	event   storable.ChangeEvent
+	last    *TextFixture
+	lastErr error
+}
+
+// Next waits for the next event, to be read with the Get method. Returns
+// false when the stream is closed or fails.
+func (cs *TextFixtureChangeStream) Next() (returned bool) {
+	cs.event, cs.last, cs.lastErr = storable.ChangeEvent{}, nil, nil
+	if !cs.ChangeStream.Next(&cs.event) {
+		cs.lastErr = cs.ChangeStream.Err()
+		return false
+	}
+
+	if cs.event.HasDocument() {
+		cs.lastErr = cs.event.Decode(&cs.last)
+	}
+
+	return true
+}
+
+// Get returns the event and the document retrieved with the Next method, the
+// document is nil on the deletes or if it does not exist anymore.
+func (cs *TextFixtureChangeStream) Get() (*storable.ChangeEvent, *TextFixture, error) {
+	return &cs.event, cs.last, cs.lastErr
+}
+
+// ForEach waits for the events calling to the given function, until the
+// stream is closed or storable.ErrStop is returned.
+func (cs *TextFixtureChangeStream) ForEach(f func(*storable.ChangeEvent, *TextFixture) error) error {
+	for cs.Next() {
+		event, doc, err := cs.Get()
+		if err != nil {
+			return err
+		}
+
+		err = f(event, doc)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return cs.lastErr
+}
+
+type WatchFixtureStore struct {
+	storable.Store
+}
+
+func NewWatchFixtureStore(db *mgo.Database) *WatchFixtureStore {
+	return &WatchFixtureStore{*storable.NewStore(db, "watch")}
+}
+
+// WithContext returns a copy of the store using the given context, see
+// storable.Store.WithContext.
+func (s *WatchFixtureStore) WithContext(ctx context.Context) *WatchFixtureStore {
+	return &WatchFixtureStore{*s.Store.WithContext(ctx)}
+}
+
+// InTx returns a copy of the store running its operations, including the
+// hooks, inside the given transaction. See storable.WithTransaction.
+func (s *WatchFixtureStore) InTx(tx *storable.Tx) *WatchFixtureStore {
+	return &WatchFixtureStore{*s.Store.InTx(tx)}
+}
+
+// New returns a new instance of WatchFixture.
+func (s *WatchFixtureStore) New() (doc *WatchFixture) {
+	doc = &WatchFixture{}
+	if doc != nil {
+		doc.SetIsNew(true)
+		doc.SetId(bson.NewObjectId())
+	}
+	return
+}
+
+// Query return a new instance of WatchFixtureQuery.
+func (s *WatchFixtureStore) Query() *WatchFixtureQuery {
+	return &WatchFixtureQuery{*storable.NewBaseQuery()}
+}
+
+// Find performs a find on the collection using the given query.
+func (s *WatchFixtureStore) Find(query *WatchFixtureQuery) (*WatchFixtureResultSet, error) {
+	resultSet, err := s.Store.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WatchFixtureResultSet{ResultSet: *resultSet}, nil
+}
+
+// MustFind like Find but panics on error
+func (s *WatchFixtureStore) MustFind(query *WatchFixtureQuery) *WatchFixtureResultSet {
+	resultSet := s.Store.MustFind(query)
+	return &WatchFixtureResultSet{ResultSet: *resultSet}
+}
+
+// Tail performs a find on the capped collection using the given query,
+// waiting for new documents up to the given timeout. See storable.Store.Tail.
+func (s *WatchFixtureStore) Tail(query *WatchFixtureQuery, timeout time.Duration) (*WatchFixtureResultSet, error) {
+	resultSet, err := s.Store.Tail(query, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WatchFixtureResultSet{ResultSet: *resultSet}, nil
+}
+
+// FindOne performs a find on the collection using the given query returning
+// the first document from the resultset.
+func (s *WatchFixtureStore) FindOne(query *WatchFixtureQuery) (*WatchFixture, error) {
+	resultSet, err := s.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultSet.One()
+}
+
+// MustFindOne like FindOne but panics on error
+func (s *WatchFixtureStore) MustFindOne(query *WatchFixtureQuery) *WatchFixture {
+	doc, err := s.FindOne(query)
+	if err != nil {
+		panic(err)
+	}
+
+	return doc
+}
+
+// FindAndModify atomically modifies the first document matching the given
+// query and returns it, before the change or after it if change.ReturnNew is
+// set. See storable.Store.FindAndModify.
+func (s *WatchFixtureStore) FindAndModify(query *WatchFixtureQuery, change mgo.Change) (*WatchFixture, error) {
+	var result *WatchFixture
+	_, err := s.Store.FindAndModify(query, change, &result)
+
+	return result, err
+}
+
+// Insert insert the given document on the collection, trigger BeforeInsert and
+// AfterInsert if any. Throws ErrNonNewDocument if doc is a non-new document.
+func (s *WatchFixtureStore) Insert(doc *WatchFixture) error {
+
+	err := s.Store.Insert(doc)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Update update the given document on the collection, trigger BeforeUpdate and
+// AfterUpdate if any. Throws ErrNewDocument if doc is a new document.
+func (s *WatchFixtureStore) Update(doc *WatchFixture) error {
+
+	err := s.Store.Update(doc)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateDiff update the given document on the collection writing only the
+// changes from old, trigger BeforeUpdate and AfterUpdate if any. See
+// storable.Store.UpdateDiff.
+func (s *WatchFixtureStore) UpdateDiff(old, doc *WatchFixture) (storable.Changes, error) {
+
+	changes, err := s.Store.UpdateDiff(old, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// Save insert or update the given document on the collection using Upsert,
+// trigger BeforeUpdate and AfterUpdate if the document is non-new and
+// BeforeInsert and AfterInset if is new.
+func (s *WatchFixtureStore) Save(doc *WatchFixture) (updated bool, err error) {
+	updated, err = s.Store.Save(doc)
+	if err != nil {
+		return false, err
+	}
+
+	return
+}
+
+type WatchFixtureQuery struct {
+	storable.BaseQuery
+}
+
+// FindById add a new criteria to the query searching by _id
+func (q *WatchFixtureQuery) FindById(ids ...bson.ObjectId) *WatchFixtureQuery {
+	var vs []interface{}
+	for _, id := range ids {
+		vs = append(vs, id)
+	}
+	q.AddCriteria(operators.In(storable.IdField, vs...))
+
+	return q
+}
+
+// Clone returns a copy of the query, see storable.BaseQuery.Clone.
+func (q *WatchFixtureQuery) Clone() *WatchFixtureQuery {
+	return &WatchFixtureQuery{*q.BaseQuery.Clone()}
+}
+
+type WatchFixtureResultSet struct {
+	storable.ResultSet
+	last    *WatchFixture
+	lastErr error
+}
+
+// All returns all documents on the resultset and close the resultset
+func (r *WatchFixtureResultSet) All() ([]*WatchFixture, error) {
+	var result []*WatchFixture
+	err := r.ResultSet.All(&result)
+
+	return result, err
+}
+
+// One returns the first document on the resultset and close the resultset
+func (r *WatchFixtureResultSet) One() (*WatchFixture, error) {
+	var result *WatchFixture
+	err := r.ResultSet.One(&result)
+
+	return result, err
+}
+
+// Next prepares the next result document for reading with the Get method.
+func (r *WatchFixtureResultSet) Next() (returned bool) {
+	r.last = nil
+	returned, r.lastErr = r.ResultSet.Next(&r.last)
+
+	return
+}
+
+// Get returns the document retrieved with the Next method.
+func (r *WatchFixtureResultSet) Get() (*WatchFixture, error) {
+	return r.last, r.lastErr
+}
+
+// ForEach iterates the resultset calling to the given function.
+func (r *WatchFixtureResultSet) ForEach(f func(*WatchFixture) error) error {
 	for {
-		var result *StoreWithNewFixture
+		var result *WatchFixture
 		found, err := r.ResultSet.Next(&result)
 		if err != nil {
 			return err
@@ -1782,17 +8033,119 @@ func (r *StoreWithNewFixtureResultSet) ForEach(f func(*StoreWithNewFixture) erro
 	return nil
 }
 
+// Watch returns a stream with the changes on the documents matching the given
+// query, the current version of the document is retrieved on every update.
+// See storable.Store.Watch.
+func (s *WatchFixtureStore) Watch(query *WatchFixtureQuery, opts storable.WatchOptions) (*WatchFixtureChangeStream, error) {
+	opts.FullDocument = true
+	cs, err := s.Store.Watch(query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WatchFixtureChangeStream{ChangeStream: cs}, nil
+}
+
+type WatchFixtureChangeStream struct {
+	*storable.ChangeStream
+	event   storable.ChangeEvent
+	last    *WatchFixture
+	lastErr error
+}
+
+// Next waits for the next event, to be read with the Get method. Returns
+// false when the stream is closed or fails.
+func (cs *WatchFixtureChangeStream) Next() (returned bool) {
+	cs.event, cs.last, cs.lastErr = storable.ChangeEvent{}, nil, nil
+	if !cs.ChangeStream.Next(&cs.event) {
+		cs.lastErr = cs.ChangeStream.Err()
+		return false
+	}
+
+	if cs.event.HasDocument() {
+		cs.lastErr = cs.event.Decode(&cs.last)
+	}
+
+	return true
+}
+
+// Get returns the event and the document retrieved with the Next method, the
+// document is nil on the deletes or if it does not exist anymore.
+func (cs *WatchFixtureChangeStream) Get() (*storable.ChangeEvent, *WatchFixture, error) {
+	return &cs.event, cs.last, cs.lastErr
+}
+
+// ForEach waits for the events calling to the given function, until the
+// stream is closed or storable.ErrStop is returned.
+func (cs *WatchFixtureChangeStream) ForEach(f func(*storable.ChangeEvent, *WatchFixture) error) error {
+	for cs.Next() {
+		event, doc, err := cs.Get()
+		if err != nil {
+			return err
+		}
+
+		err = f(event, doc)
+		if err == storable.ErrStop {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return cs.lastErr
+}
+
 type schema struct {
+	BitsFixture               *schemaBitsFixture
+	CappedFixture             *schemaCappedFixture
+	DiffFixture               *schemaDiffFixture
+	ElemMatchFixture          *schemaElemMatchFixture
 	EventsFixture             *schemaEventsFixture
 	EventsSaveFixture         *schemaEventsSaveFixture
+	ExprFixture               *schemaExprFixture
+	FamilyBirdFixture         *schemaFamilyBirdFixture
+	FamilyCatFixture          *schemaFamilyCatFixture
+	FamilyDogFixture          *schemaFamilyDogFixture
+	FindersFixture            *schemaFindersFixture
+	GeoFixture                *schemaGeoFixture
+	HistoryFixture            *schemaHistoryFixture
 	MultiKeySortFixture       *schemaMultiKeySortFixture
+	OutboxFixture             *schemaOutboxFixture
 	QueryFixture              *schemaQueryFixture
+	RefCustomerFixture        *schemaRefCustomerFixture
+	RefOrderFixture           *schemaRefOrderFixture
 	ResultSetFixture          *schemaResultSetFixture
 	ResultSetInitFixture      *schemaResultSetInitFixture
 	SchemaFixture             *schemaSchemaFixture
+	ScopesFixture             *schemaScopesFixture
 	StoreFixture              *schemaStoreFixture
 	StoreWithConstructFixture *schemaStoreWithConstructFixture
 	StoreWithNewFixture       *schemaStoreWithNewFixture
+	TextFixture               *schemaTextFixture
+	WatchFixture              *schemaWatchFixture
+}
+
+type schemaBitsFixture struct {
+	Flags storable.Field
+}
+
+type schemaCappedFixture struct {
+	Message storable.Field
+}
+
+type schemaDiffFixture struct {
+	Name      storable.Field
+	Address   *schemaDiffFixtureAddress
+	DiffAudit *schemaDiffFixtureDiffAudit
+	Tags      storable.Field
+	Labels    storable.Map
+}
+
+type schemaElemMatchFixture struct {
+	Items  *schemaElemMatchFixtureItems
+	Scores storable.Field
 }
 
 type schemaEventsFixture struct {
@@ -1803,16 +8156,70 @@ type schemaEventsSaveFixture struct {
 	Checks storable.Map
 }
 
+type schemaExprFixture struct {
+	Price     *schemaExprFixturePrice
+	Discount  storable.Field
+	CreatedAt storable.Field
+}
+
+type schemaFamilyBirdFixture struct {
+	Name  storable.Field
+	Wings storable.Field
+}
+
+type schemaFamilyCatFixture struct {
+	Name  storable.Field
+	Lives storable.Field
+}
+
+type schemaFamilyDogFixture struct {
+	Name  storable.Field
+	Breed storable.Field
+}
+
+type schemaFindersFixture struct {
+	Name      storable.Field
+	Status    storable.Field
+	Tags      storable.Field
+	Price     *schemaFindersFixturePrice
+	CreatedAt storable.Field
+}
+
+type schemaGeoFixture struct {
+	Name     storable.Field
+	Location storable.Field
+	Area     storable.Field
+}
+
+type schemaHistoryFixture struct {
+	Name  storable.Field
+	Count storable.Field
+	Note  storable.Field
+}
+
 type schemaMultiKeySortFixture struct {
 	Name  storable.Field
 	Start storable.Field
 	End   storable.Field
 }
 
+type schemaOutboxFixture struct {
+	Total storable.Field
+}
+
 type schemaQueryFixture struct {
 	Foo storable.Field
 }
 
+type schemaRefCustomerFixture struct {
+	Name storable.Field
+}
+
+type schemaRefOrderFixture struct {
+	CustomerId storable.Field
+	WatcherIds storable.Field
+}
+
 type schemaResultSetFixture struct {
 	Foo storable.Field
 }
@@ -1831,6 +8238,13 @@ type schemaSchemaFixture struct {
 	MapOfSomeType  *schemaSchemaFixtureMapOfSomeType
 }
 
+type schemaScopesFixture struct {
+	Name      storable.Field
+	Price     storable.Field
+	Published storable.Field
+	Deleted   storable.Field
+}
+
 type schemaStoreFixture struct {
 	Foo storable.Field
 }
@@ -1844,6 +8258,39 @@ type schemaStoreWithNewFixture struct {
 	Bar storable.Field
 }
 
+type schemaTextFixture struct {
+	Title storable.Field
+	Score storable.Field
+}
+
+type schemaWatchFixture struct {
+	Name  storable.Field
+	Count storable.Field
+}
+
+type schemaDiffFixtureAddress struct {
+	Street storable.Field
+	City   storable.Field
+}
+
+type schemaDiffFixtureDiffAudit struct {
+	Revision storable.Field
+}
+
+type schemaElemMatchFixtureItems struct {
+	storable.Field
+	Name     storable.Field
+	Quantity storable.Field
+}
+
+type schemaExprFixturePrice struct {
+	Amount storable.Field
+}
+
+type schemaFindersFixturePrice struct {
+	Amount storable.Field
+}
+
 type schemaSchemaFixtureNested struct {
 	String         storable.Field
 	Int            storable.Field
@@ -1874,20 +8321,94 @@ type schemaSchemaFixtureNestedMapOfSomeType struct {
 }
 
 var Schema = schema{
+	BitsFixture: &schemaBitsFixture{
+		Flags: storable.NewField("flags", "int"),
+	},
+	CappedFixture: &schemaCappedFixture{
+		Message: storable.NewField("message", "string"),
+	},
+	DiffFixture: &schemaDiffFixture{
+		Name: storable.NewField("name", "string"),
+		Address: &schemaDiffFixtureAddress{
+			Street: storable.NewField("address.street", "string"),
+			City:   storable.NewField("address.city", "string"),
+		},
+		DiffAudit: &schemaDiffFixtureDiffAudit{
+			Revision: storable.NewField("revision", "int"),
+		},
+		Tags:   storable.NewField("tags", "string"),
+		Labels: storable.NewMap("labels.[map]", "string"),
+	},
+	ElemMatchFixture: &schemaElemMatchFixture{
+		Items: &schemaElemMatchFixtureItems{
+			Field:    storable.NewField("items", "struct"),
+			Name:     storable.NewField("items.name", "string"),
+			Quantity: storable.NewField("items.quantity", "int"),
+		},
+		Scores: storable.NewField("scores", "int"),
+	},
 	EventsFixture: &schemaEventsFixture{
 		Checks: storable.NewMap("checks.[map]", "bool"),
 	},
 	EventsSaveFixture: &schemaEventsSaveFixture{
 		Checks: storable.NewMap("checks.[map]", "bool"),
 	},
+	ExprFixture: &schemaExprFixture{
+		Price: &schemaExprFixturePrice{
+			Amount: storable.NewField("price.amount", "float64"),
+		},
+		Discount:  storable.NewField("discount", "float64"),
+		CreatedAt: storable.NewField("createdat", "time.Time"),
+	},
+	FamilyBirdFixture: &schemaFamilyBirdFixture{
+		Name:  storable.NewField("name", "string"),
+		Wings: storable.NewField("wings", "int"),
+	},
+	FamilyCatFixture: &schemaFamilyCatFixture{
+		Name:  storable.NewField("name", "string"),
+		Lives: storable.NewField("lives", "int"),
+	},
+	FamilyDogFixture: &schemaFamilyDogFixture{
+		Name:  storable.NewField("name", "string"),
+		Breed: storable.NewField("breed", "string"),
+	},
+	FindersFixture: &schemaFindersFixture{
+		Name:   storable.NewField("name", "string"),
+		Status: storable.NewField("status", "int"),
+		Tags:   storable.NewField("tags", "string"),
+		Price: &schemaFindersFixturePrice{
+			Amount: storable.NewField("price.amount", "float64"),
+		},
+		CreatedAt: storable.NewField("createdat", "time.Time"),
+	},
+	GeoFixture: &schemaGeoFixture{
+		Name:     storable.NewField("name", "string"),
+		Location: storable.NewField("location", "storable.Point"),
+		Area:     storable.NewField("area", "storable.Polygon"),
+	},
+	HistoryFixture: &schemaHistoryFixture{
+		Name:  storable.NewField("name", "string"),
+		Count: storable.NewField("count", "int"),
+		Note:  storable.NewField("note", "string"),
+	},
 	MultiKeySortFixture: &schemaMultiKeySortFixture{
 		Name:  storable.NewField("name", "string"),
 		Start: storable.NewField("start", "time.Time"),
 		End:   storable.NewField("end", "time.Time"),
 	},
+	OutboxFixture: &schemaOutboxFixture{
+		Total: storable.NewField("total", "float64"),
+	},
 	QueryFixture: &schemaQueryFixture{
 		Foo: storable.NewField("foo", "string"),
 	},
+	RefCustomerFixture: &schemaRefCustomerFixture{
+		Name: storable.NewField("name", "string"),
+	},
+	RefOrderFixture: &schemaRefOrderFixture{
+		CustomerId: storable.NewField("customerid", "string"),
+		WatcherIds: storable.NewField("watcherids", "gopkg.in/mgo.v2/bson.ObjectId"),
+	},
 	ResultSetFixture: &schemaResultSetFixture{
 		Foo: storable.NewField("foo", "string"),
 	},
@@ -1919,6 +8440,12 @@ var Schema = schema{
 			Foo: storable.NewMap("mapofsometype.[map].foo", "string"),
 		},
 	},
+	ScopesFixture: &schemaScopesFixture{
+		Name:      storable.NewField("name", "string"),
+		Price:     storable.NewField("price", "float64"),
+		Published: storable.NewField("published", "bool"),
+		Deleted:   storable.NewField("deleted", "bool"),
+	},
 	StoreFixture: &schemaStoreFixture{
 		Foo: storable.NewField("foo", "string"),
 	},
@@ -1929,4 +8456,525 @@ var Schema = schema{
 		Foo: storable.NewField("foo", "string"),
 		Bar: storable.NewField("bar", "string"),
 	},
+	TextFixture: &schemaTextFixture{
+		Title: storable.NewField("title", "string"),
+		Score: storable.NewField("score", "float64"),
+	},
+	WatchFixture: &schemaWatchFixture{
+		Name:  storable.NewField("name", "string"),
+		Count: storable.NewField("count", "int"),
+	},
+}
+
+// QueryKinds are the kinds of the models stored on the "query"
+// collection.
+var QueryKinds = storable.Kinds{
+	"BitsFixture":         reflect.TypeOf(BitsFixture{}),
+	"ElemMatchFixture":    reflect.TypeOf(ElemMatchFixture{}),
+	"ExprFixture":         reflect.TypeOf(ExprFixture{}),
+	"MultiKeySortFixture": reflect.TypeOf(MultiKeySortFixture{}),
+	"QueryFixture":        reflect.TypeOf(QueryFixture{}),
+}
+
+// QueryFamilyStore is the store of all the models sharing the "query"
+// collection, the documents are decoded into the model of its kind.
+type QueryFamilyStore struct {
+	storable.Store
+}
+
+func NewQueryFamilyStore(db *mgo.Database) *QueryFamilyStore {
+	return &QueryFamilyStore{*storable.NewStore(db, "query")}
+}
+
+// Query return a new query matching the documents of any kind.
+func (s *QueryFamilyStore) Query() *storable.BaseQuery {
+	return storable.NewBaseQuery()
+}
+
+// Find performs a find on the collection using the given query, every
+// document is returned as the model of its kind. See storable.Store.FindKinds.
+func (s *QueryFamilyStore) Find(query storable.Query) (*storable.KindResultSet, error) {
+	return s.Store.FindKinds(query, QueryKinds)
+}
+
+// FindOne performs a find on the collection using the given query returning
+// the first document from the resultset.
+func (s *QueryFamilyStore) FindOne(query storable.Query) (storable.DocumentBase, error) {
+	resultSet, err := s.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultSet.One()
+}
+
+// EventKinds are the kinds of the models stored on the "event"
+// collection.
+var EventKinds = storable.Kinds{
+	"EventsFixture":     reflect.TypeOf(EventsFixture{}),
+	"EventsSaveFixture": reflect.TypeOf(EventsSaveFixture{}),
+}
+
+// EventFamilyStore is the store of all the models sharing the "event"
+// collection, the documents are decoded into the model of its kind.
+type EventFamilyStore struct {
+	storable.Store
+}
+
+func NewEventFamilyStore(db *mgo.Database) *EventFamilyStore {
+	return &EventFamilyStore{*storable.NewStore(db, "event")}
+}
+
+// Query return a new query matching the documents of any kind.
+func (s *EventFamilyStore) Query() *storable.BaseQuery {
+	return storable.NewBaseQuery()
+}
+
+// Find performs a find on the collection using the given query, every
+// document is returned as the model of its kind. See storable.Store.FindKinds.
+func (s *EventFamilyStore) Find(query storable.Query) (*storable.KindResultSet, error) {
+	return s.Store.FindKinds(query, EventKinds)
+}
+
+// FindOne performs a find on the collection using the given query returning
+// the first document from the resultset.
+func (s *EventFamilyStore) FindOne(query storable.Query) (storable.DocumentBase, error) {
+	resultSet, err := s.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultSet.One()
+}
+
+// AnimalsKinds are the kinds of the models stored on the "animals"
+// collection.
+var AnimalsKinds = storable.Kinds{
+	"bird": reflect.TypeOf(FamilyBirdFixture{}),
+	"cat":  reflect.TypeOf(FamilyCatFixture{}),
+	"dog":  reflect.TypeOf(FamilyDogFixture{}),
+}
+
+// AnimalsFamilyStore is the store of all the models sharing the "animals"
+// collection, the documents are decoded into the model of its kind.
+type AnimalsFamilyStore struct {
+	storable.Store
+}
+
+func NewAnimalsFamilyStore(db *mgo.Database) *AnimalsFamilyStore {
+	return &AnimalsFamilyStore{*storable.NewStore(db, "animals")}
+}
+
+// Query return a new query matching the documents of any kind.
+func (s *AnimalsFamilyStore) Query() *storable.BaseQuery {
+	return storable.NewBaseQuery()
+}
+
+// Find performs a find on the collection using the given query, every
+// document is returned as the model of its kind. See storable.Store.FindKinds.
+func (s *AnimalsFamilyStore) Find(query storable.Query) (*storable.KindResultSet, error) {
+	return s.Store.FindKinds(query, AnimalsKinds)
+}
+
+// FindOne performs a find on the collection using the given query returning
+// the first document from the resultset.
+func (s *AnimalsFamilyStore) FindOne(query storable.Query) (storable.DocumentBase, error) {
+	resultSet, err := s.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultSet.One()
+}
+
+// ResultsetKinds are the kinds of the models stored on the "resultset"
+// collection.
+var ResultsetKinds = storable.Kinds{
+	"ResultSetFixture":     reflect.TypeOf(ResultSetFixture{}),
+	"ResultSetInitFixture": reflect.TypeOf(ResultSetInitFixture{}),
+}
+
+// ResultsetFamilyStore is the store of all the models sharing the "resultset"
+// collection, the documents are decoded into the model of its kind.
+type ResultsetFamilyStore struct {
+	storable.Store
+}
+
+func NewResultsetFamilyStore(db *mgo.Database) *ResultsetFamilyStore {
+	return &ResultsetFamilyStore{*storable.NewStore(db, "resultset")}
+}
+
+// Query return a new query matching the documents of any kind.
+func (s *ResultsetFamilyStore) Query() *storable.BaseQuery {
+	return storable.NewBaseQuery()
+}
+
+// Find performs a find on the collection using the given query, every
+// document is returned as the model of its kind. See storable.Store.FindKinds.
+func (s *ResultsetFamilyStore) Find(query storable.Query) (*storable.KindResultSet, error) {
+	return s.Store.FindKinds(query, ResultsetKinds)
+}
+
+// FindOne performs a find on the collection using the given query returning
+// the first document from the resultset.
+func (s *ResultsetFamilyStore) FindOne(query storable.Query) (storable.DocumentBase, error) {
+	resultSet, err := s.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultSet.One()
+}
+
+func init() {
+	storable.Register(&storable.ModelInfo{
+		Name:       "BitsFixture",
+		Collection: "query",
+		Kind:       "BitsFixture",
+		Type:       reflect.TypeOf(BitsFixture{}),
+		Schema:     Schema.BitsFixture,
+		Fields: []*storable.FieldInfo{
+			{Name: "Id", Path: "_id", Type: "bson.ObjectId", Findable: true},
+			{Name: "Flags", Path: "flags", Type: "int", Findable: true},
+		},
+	})
+	storable.Register(&storable.ModelInfo{
+		Name:       "CappedFixture",
+		Collection: "capped",
+		Type:       reflect.TypeOf(CappedFixture{}),
+		Schema:     Schema.CappedFixture,
+		Fields: []*storable.FieldInfo{
+			{Name: "Id", Path: "_id", Type: "bson.ObjectId", Findable: true},
+			{Name: "Message", Path: "message", Type: "string", Findable: true},
+		},
+	})
+	storable.Register(&storable.ModelInfo{
+		Name:       "DiffFixture",
+		Collection: "diff",
+		Type:       reflect.TypeOf(DiffFixture{}),
+		Schema:     Schema.DiffFixture,
+		Fields: []*storable.FieldInfo{
+			{Name: "Id", Path: "_id", Type: "bson.ObjectId", Findable: true},
+			{Name: "Name", Path: "name", Type: "string", Findable: true},
+			{Name: "Address", Path: "address", Type: "DiffAddress", Findable: true, Fields: []*storable.FieldInfo{
+				{Name: "Street", Path: "address.street", Type: "string", Findable: true},
+				{Name: "City", Path: "address.city", Type: "string", Findable: true},
+			}},
+			{Name: "DiffAudit", Path: "", Type: "DiffAudit", Findable: true, Inline: true, Fields: []*storable.FieldInfo{
+				{Name: "Revision", Path: "revision", Type: "int", Findable: true},
+			}},
+			{Name: "Tags", Path: "tags", Type: "[]string", Findable: true},
+			{Name: "Labels", Path: "labels.[map]", Type: "map[string]string", Findable: true, Map: true},
+		},
+	})
+	storable.Register(&storable.ModelInfo{
+		Name:       "ElemMatchFixture",
+		Collection: "query",
+		Kind:       "ElemMatchFixture",
+		Type:       reflect.TypeOf(ElemMatchFixture{}),
+		Schema:     Schema.ElemMatchFixture,
+		Fields: []*storable.FieldInfo{
+			{Name: "Id", Path: "_id", Type: "bson.ObjectId", Findable: true},
+			{Name: "Items", Path: "items", Type: "[]ElemMatchItem", Findable: true, Fields: []*storable.FieldInfo{
+				{Name: "Name", Path: "items.name", Type: "string", Findable: true},
+				{Name: "Quantity", Path: "items.quantity", Type: "int", Findable: true},
+			}},
+			{Name: "Scores", Path: "scores", Type: "[]int", Findable: true},
+		},
+	})
+	storable.Register(&storable.ModelInfo{
+		Name:       "EventsFixture",
+		Collection: "event",
+		Kind:       "EventsFixture",
+		Type:       reflect.TypeOf(EventsFixture{}),
+		Schema:     Schema.EventsFixture,
+		Events:     []string{"BeforeInsert", "AfterInsert", "BeforeUpdate", "AfterUpdate"},
+		Fields: []*storable.FieldInfo{
+			{Name: "Id", Path: "_id", Type: "bson.ObjectId", Findable: true},
+			{Name: "Checks", Path: "checks.[map]", Type: "map[string]bool", Findable: true, Map: true},
+			{Name: "MustFailBefore", Path: "mustfailbefore", Type: "error"},
+			{Name: "MustFailAfter", Path: "mustfailafter", Type: "error"},
+		},
+	})
+	storable.Register(&storable.ModelInfo{
+		Name:       "EventsSaveFixture",
+		Collection: "event",
+		Kind:       "EventsSaveFixture",
+		Type:       reflect.TypeOf(EventsSaveFixture{}),
+		Schema:     Schema.EventsSaveFixture,
+		Events:     []string{"BeforeSave", "AfterSave"},
+		Fields: []*storable.FieldInfo{
+			{Name: "Id", Path: "_id", Type: "bson.ObjectId", Findable: true},
+			{Name: "Checks", Path: "checks.[map]", Type: "map[string]bool", Findable: true, Map: true},
+			{Name: "MustFailBefore", Path: "mustfailbefore", Type: "error"},
+			{Name: "MustFailAfter", Path: "mustfailafter", Type: "error"},
+		},
+	})
+	storable.Register(&storable.ModelInfo{
+		Name:       "ExprFixture",
+		Collection: "query",
+		Kind:       "ExprFixture",
+		Type:       reflect.TypeOf(ExprFixture{}),
+		Schema:     Schema.ExprFixture,
+		Fields: []*storable.FieldInfo{
+			{Name: "Id", Path: "_id", Type: "bson.ObjectId", Findable: true},
+			{Name: "Price", Path: "price", Type: "ExprPrice", Findable: true, Fields: []*storable.FieldInfo{
+				{Name: "Amount", Path: "price.amount", Type: "float64", Findable: true},
+			}},
+			{Name: "Discount", Path: "discount", Type: "float64", Findable: true},
+			{Name: "CreatedAt", Path: "createdat", Type: "time.Time", Findable: true},
+		},
+	})
+	storable.Register(&storable.ModelInfo{
+		Name:       "FamilyBirdFixture",
+		Collection: "animals",
+		Kind:       "bird",
+		Type:       reflect.TypeOf(FamilyBirdFixture{}),
+		Schema:     Schema.FamilyBirdFixture,
+		Fields: []*storable.FieldInfo{
+			{Name: "Id", Path: "_id", Type: "bson.ObjectId", Findable: true},
+			{Name: "Name", Path: "name", Type: "string", Findable: true},
+			{Name: "Wings", Path: "wings", Type: "int", Findable: true},
+		},
+	})
+	storable.Register(&storable.ModelInfo{
+		Name:       "FamilyCatFixture",
+		Collection: "animals",
+		Kind:       "cat",
+		Type:       reflect.TypeOf(FamilyCatFixture{}),
+		Schema:     Schema.FamilyCatFixture,
+		Fields: []*storable.FieldInfo{
+			{Name: "Id", Path: "_id", Type: "bson.ObjectId", Findable: true},
+			{Name: "Name", Path: "name", Type: "string", Findable: true},
+			{Name: "Lives", Path: "lives", Type: "int", Findable: true},
+		},
+	})
+	storable.Register(&storable.ModelInfo{
+		Name:       "FamilyDogFixture",
+		Collection: "animals",
+		Kind:       "dog",
+		Type:       reflect.TypeOf(FamilyDogFixture{}),
+		Schema:     Schema.FamilyDogFixture,
+		Fields: []*storable.FieldInfo{
+			{Name: "Id", Path: "_id", Type: "bson.ObjectId", Findable: true},
+			{Name: "Name", Path: "name", Type: "string", Findable: true},
+			{Name: "Breed", Path: "breed", Type: "string", Findable: true},
+		},
+	})
+	storable.Register(&storable.ModelInfo{
+		Name:       "FindersFixture",
+		Collection: "finders",
+		Type:       reflect.TypeOf(FindersFixture{}),
+		Schema:     Schema.FindersFixture,
+		Fields: []*storable.FieldInfo{
+			{Name: "Id", Path: "_id", Type: "bson.ObjectId", Findable: true},
+			{Name: "Name", Path: "name", Type: "string", Findable: true},
+			{Name: "Status", Path: "status", Type: "int", Findable: true},
+			{Name: "Tags", Path: "tags", Type: "[]string", Findable: true},
+			{Name: "Price", Path: "price", Type: "struct{Amount float64}", Findable: true, Fields: []*storable.FieldInfo{
+				{Name: "Amount", Path: "price.amount", Type: "float64", Findable: true},
+			}},
+			{Name: "CreatedAt", Path: "createdat", Type: "time.Time", Findable: true},
+		},
+	})
+	storable.Register(&storable.ModelInfo{
+		Name:       "GeoFixture",
+		Collection: "geo",
+		Type:       reflect.TypeOf(GeoFixture{}),
+		Schema:     Schema.GeoFixture,
+		Fields: []*storable.FieldInfo{
+			{Name: "Id", Path: "_id", Type: "bson.ObjectId", Findable: true},
+			{Name: "Name", Path: "name", Type: "string", Findable: true},
+			{Name: "Location", Path: "location", Type: "storable.Point", Findable: true},
+			{Name: "Area", Path: "area", Type: "*storable.Polygon", Findable: true},
+		},
+	})
+	storable.Register(&storable.ModelInfo{
+		Name:       "HistoryFixture",
+		Collection: "history",
+		Type:       reflect.TypeOf(HistoryFixture{}),
+		Schema:     Schema.HistoryFixture,
+		Fields: []*storable.FieldInfo{
+			{Name: "Id", Path: "_id", Type: "bson.ObjectId", Findable: true},
+			{Name: "Name", Path: "name", Type: "string", Findable: true},
+			{Name: "Count", Path: "count", Type: "int", Findable: true},
+			{Name: "Note", Path: "note", Type: "string", Findable: true},
+		},
+	})
+	storable.Register(&storable.ModelInfo{
+		Name:       "MultiKeySortFixture",
+		Collection: "query",
+		Kind:       "MultiKeySortFixture",
+		Type:       reflect.TypeOf(MultiKeySortFixture{}),
+		Schema:     Schema.MultiKeySortFixture,
+		Fields: []*storable.FieldInfo{
+			{Name: "Id", Path: "_id", Type: "bson.ObjectId", Findable: true},
+			{Name: "Name", Path: "name", Type: "string", Findable: true},
+			{Name: "Start", Path: "start", Type: "time.Time", Findable: true},
+			{Name: "End", Path: "end", Type: "time.Time", Findable: true},
+		},
+	})
+	storable.Register(&storable.ModelInfo{
+		Name:       "OutboxFixture",
+		Collection: "outbox",
+		Type:       reflect.TypeOf(OutboxFixture{}),
+		Schema:     Schema.OutboxFixture,
+		Fields: []*storable.FieldInfo{
+			{Name: "Id", Path: "_id", Type: "bson.ObjectId", Findable: true},
+			{Name: "Total", Path: "total", Type: "float64", Findable: true},
+		},
+	})
+	storable.Register(&storable.ModelInfo{
+		Name:       "QueryFixture",
+		Collection: "query",
+		Kind:       "QueryFixture",
+		Type:       reflect.TypeOf(QueryFixture{}),
+		Schema:     Schema.QueryFixture,
+		Fields: []*storable.FieldInfo{
+			{Name: "Id", Path: "_id", Type: "bson.ObjectId", Findable: true},
+			{Name: "Foo", Path: "foo", Type: "string", Findable: true},
+		},
+	})
+	storable.Register(&storable.ModelInfo{
+		Name:       "RefCustomerFixture",
+		Collection: "customers",
+		Type:       reflect.TypeOf(RefCustomerFixture{}),
+		Schema:     Schema.RefCustomerFixture,
+		Fields: []*storable.FieldInfo{
+			{Name: "Id", Path: "_id", Type: "bson.ObjectId", Findable: true},
+			{Name: "Name", Path: "name", Type: "string", Findable: true},
+		},
+	})
+	storable.Register(&storable.ModelInfo{
+		Name:       "RefOrderFixture",
+		Collection: "orders",
+		Type:       reflect.TypeOf(RefOrderFixture{}),
+		Schema:     Schema.RefOrderFixture,
+		Fields: []*storable.FieldInfo{
+			{Name: "Id", Path: "_id", Type: "bson.ObjectId", Findable: true},
+			{Name: "CustomerId", Path: "customerid", Type: "bson.ObjectId", Findable: true},
+			{Name: "WatcherIds", Path: "watcherids", Type: "[]bson.ObjectId", Findable: true},
+		},
+	})
+	storable.Register(&storable.ModelInfo{
+		Name:       "ResultSetFixture",
+		Collection: "resultset",
+		Kind:       "ResultSetFixture",
+		Type:       reflect.TypeOf(ResultSetFixture{}),
+		Schema:     Schema.ResultSetFixture,
+		Fields: []*storable.FieldInfo{
+			{Name: "Id", Path: "_id", Type: "bson.ObjectId", Findable: true},
+			{Name: "Foo", Path: "foo", Type: "string", Findable: true},
+		},
+	})
+	storable.Register(&storable.ModelInfo{
+		Name:       "ResultSetInitFixture",
+		Collection: "resultset",
+		Kind:       "ResultSetInitFixture",
+		Type:       reflect.TypeOf(ResultSetInitFixture{}),
+		Schema:     Schema.ResultSetInitFixture,
+		Fields: []*storable.FieldInfo{
+			{Name: "Id", Path: "_id", Type: "bson.ObjectId", Findable: true},
+			{Name: "Foo", Path: "foo", Type: "string", Findable: true},
+		},
+	})
+	storable.Register(&storable.ModelInfo{
+		Name:       "SchemaFixture",
+		Collection: "schema",
+		Type:       reflect.TypeOf(SchemaFixture{}),
+		Schema:     Schema.SchemaFixture,
+		Fields: []*storable.FieldInfo{
+			{Name: "Id", Path: "_id", Type: "bson.ObjectId", Findable: true},
+			{Name: "String", Path: "string", Type: "string", Findable: true},
+			{Name: "Int", Path: "foo", Type: "int", Findable: true},
+			{Name: "Nested", Path: "nested", Type: "*SchemaFixture", Findable: true, Fields: []*storable.FieldInfo{
+				{Name: "Id", Path: "nested._id", Type: "bson.ObjectId", Findable: true},
+				{Name: "String", Path: "nested.string", Type: "string", Findable: true},
+				{Name: "Int", Path: "nested.foo", Type: "int", Findable: true},
+				{Name: "Nested", Path: "nested.nested", Type: "*SchemaFixture", Findable: true},
+				{Name: "Inline", Path: "nested", Type: "struct{Inline string}", Findable: true, Inline: true, Fields: []*storable.FieldInfo{
+					{Name: "Inline", Path: "nested.inline", Type: "string", Findable: true},
+				}},
+				{Name: "MapOfString", Path: "nested.mapofstring.[map]", Type: "map[string]string", Findable: true, Map: true},
+				{Name: "MapOfInterface", Path: "nested.mapofinterface.[map]", Type: "map[string]interface{}", Findable: true, Map: true},
+				{Name: "MapOfSomeType", Path: "nested.mapofsometype.[map]", Type: "map[string]struct{Foo string}", Findable: true, Map: true, Fields: []*storable.FieldInfo{
+					{Name: "Foo", Path: "nested.mapofsometype.[map].foo", Type: "string", Findable: true, Map: true},
+				}},
+			}},
+			{Name: "Inline", Path: "", Type: "struct{Inline string}", Findable: true, Inline: true, Fields: []*storable.FieldInfo{
+				{Name: "Inline", Path: "inline", Type: "string", Findable: true},
+			}},
+			{Name: "MapOfString", Path: "mapofstring.[map]", Type: "map[string]string", Findable: true, Map: true},
+			{Name: "MapOfInterface", Path: "mapofinterface.[map]", Type: "map[string]interface{}", Findable: true, Map: true},
+			{Name: "MapOfSomeType", Path: "mapofsometype.[map]", Type: "map[string]struct{Foo string}", Findable: true, Map: true, Fields: []*storable.FieldInfo{
+				{Name: "Foo", Path: "mapofsometype.[map].foo", Type: "string", Findable: true, Map: true},
+			}},
+		},
+	})
+	storable.Register(&storable.ModelInfo{
+		Name:       "ScopesFixture",
+		Collection: "scopes",
+		Type:       reflect.TypeOf(ScopesFixture{}),
+		Schema:     Schema.ScopesFixture,
+		Fields: []*storable.FieldInfo{
+			{Name: "Id", Path: "_id", Type: "bson.ObjectId", Findable: true},
+			{Name: "Name", Path: "name", Type: "string", Findable: true},
+			{Name: "Price", Path: "price", Type: "float64", Findable: true},
+			{Name: "Published", Path: "published", Type: "bool", Findable: true},
+			{Name: "Deleted", Path: "deleted", Type: "bool", Findable: true},
+		},
+	})
+	storable.Register(&storable.ModelInfo{
+		Name:       "StoreFixture",
+		Collection: "store",
+		Type:       reflect.TypeOf(StoreFixture{}),
+		Schema:     Schema.StoreFixture,
+		Fields: []*storable.FieldInfo{
+			{Name: "Id", Path: "_id", Type: "bson.ObjectId", Findable: true},
+			{Name: "Foo", Path: "foo", Type: "string", Findable: true},
+		},
+	})
+	storable.Register(&storable.ModelInfo{
+		Name:       "StoreWithConstructFixture",
+		Collection: "store_construct",
+		Type:       reflect.TypeOf(StoreWithConstructFixture{}),
+		Schema:     Schema.StoreWithConstructFixture,
+		Fields: []*storable.FieldInfo{
+			{Name: "Id", Path: "_id", Type: "bson.ObjectId", Findable: true},
+			{Name: "Foo", Path: "foo", Type: "string", Findable: true},
+		},
+	})
+	storable.Register(&storable.ModelInfo{
+		Name:       "StoreWithNewFixture",
+		Collection: "store_new",
+		Type:       reflect.TypeOf(StoreWithNewFixture{}),
+		Schema:     Schema.StoreWithNewFixture,
+		Fields: []*storable.FieldInfo{
+			{Name: "Id", Path: "_id", Type: "bson.ObjectId", Findable: true},
+			{Name: "Foo", Path: "foo", Type: "string", Findable: true},
+			{Name: "Bar", Path: "bar", Type: "string", Findable: true},
+		},
+	})
+	storable.Register(&storable.ModelInfo{
+		Name:       "TextFixture",
+		Collection: "text",
+		Type:       reflect.TypeOf(TextFixture{}),
+		Schema:     Schema.TextFixture,
+		Fields: []*storable.FieldInfo{
+			{Name: "Id", Path: "_id", Type: "bson.ObjectId", Findable: true},
+			{Name: "Title", Path: "title", Type: "string", Findable: true},
+			{Name: "Score", Path: "score", Type: "float64", Findable: true},
+		},
+	})
+	storable.Register(&storable.ModelInfo{
+		Name:       "WatchFixture",
+		Collection: "watch",
+		Type:       reflect.TypeOf(WatchFixture{}),
+		Schema:     Schema.WatchFixture,
+		Fields: []*storable.FieldInfo{
+			{Name: "Id", Path: "_id", Type: "bson.ObjectId", Findable: true},
+			{Name: "Name", Path: "name", Type: "string", Findable: true},
+			{Name: "Count", Path: "count", Type: "int", Findable: true},
+		},
+	})
 }
@@ -0,0 +1,9 @@
+package tests
+
+import "gopkg.in/src-d/storable.v1"
+
+type TextFixture struct {
+	storable.Document `bson:",inline" collection:"text"`
+	Title             string
+	Score             float64 `bson:",omitempty"`
+}
@@ -0,0 +1,62 @@
+package tests
+
+import (
+	. "gopkg.in/check.v1"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/src-d/storable.v1"
+	"gopkg.in/src-d/storable.v1/operators"
+)
+
+func (s *MongoSuite) insertTextFixtures(c *C) *TextFixtureStore {
+	store := NewTextFixtureStore(s.db)
+	c.Assert(store.EnsureIndex(mgo.Index{Key: []string{
+		storable.TextIndex.Key(Schema.TextFixture.Title),
+	}}), IsNil)
+
+	for _, title := range []string{
+		"the quick brown fox",
+		"fox and dog, fox and cat",
+		"a lazy dog",
+	} {
+		doc := store.New()
+		doc.Title = title
+		c.Assert(store.Insert(doc), IsNil)
+	}
+
+	return store
+}
+
+func (s *MongoSuite) TestTextSortByScore(c *C) {
+	store := s.insertTextFixtures(c)
+
+	q := store.Query()
+	q.AddCriteria(operators.Text("fox", ""))
+	q.Sort(storable.Sort{{Schema.TextFixture.Score, storable.TextScore}})
+
+	docs, err := store.MustFind(q).All()
+	c.Assert(err, IsNil)
+	c.Assert(docs, HasLen, 2)
+	c.Assert(docs[0].Title, Equals, "fox and dog, fox and cat")
+	c.Assert(docs[0].Score > docs[1].Score, Equals, true)
+}
+
+func (s *MongoSuite) TestTextWithOptions(c *C) {
+	store := s.insertTextFixtures(c)
+
+	q := store.Query()
+	q.AddCriteria(operators.TextWithOptions("DOG", operators.TextOptions{
+		Language:      "english",
+		CaseSensitive: true,
+	}))
+
+	count, err := store.Count(q)
+	c.Assert(err, IsNil)
+	c.Assert(count, Equals, 0)
+
+	q = store.Query()
+	q.AddCriteria(operators.TextWithOptions("DOG", operators.TextOptions{}))
+
+	count, err = store.Count(q)
+	c.Assert(err, IsNil)
+	c.Assert(count, Equals, 2)
+}
@@ -0,0 +1,102 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	. "gopkg.in/check.v1"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/src-d/storable.v1"
+)
+
+// skipWithoutTransactions skips the test if the server does not support
+// transactions, they require a replica set. The test fails instead if the
+// STORABLE_TRANSACTIONS environment variable is set, as in the CI job with a
+// replica set.
+func (s *MongoSuite) skipWithoutTransactions(c *C, err error) {
+	if qerr, ok := err.(*mgo.QueryError); ok && (qerr.Code == 20 || qerr.Code == 263) {
+		if os.Getenv("STORABLE_TRANSACTIONS") != "" {
+			c.Fatal("transactions are not supported: " + qerr.Message)
+		}
+
+		c.Skip("transactions are not supported: " + qerr.Message)
+	}
+}
+
+func (s *MongoSuite) TestWithTransactionCommit(c *C) {
+	store := NewEventsFixtureStore(s.db)
+	c.Assert(s.db.C("event").Create(&mgo.CollectionInfo{}), IsNil)
+
+	doc := store.New()
+	err := storable.WithTransaction(context.Background(), s.db, func(tx *storable.Tx) error {
+		return store.InTx(tx).Insert(doc)
+	})
+
+	s.skipWithoutTransactions(c, err)
+	c.Assert(err, IsNil)
+	c.Assert(doc.Checks["BeforeInsert"], Equals, true)
+	c.Assert(doc.Checks["AfterInsert"], Equals, true)
+
+	count, err := store.Count(store.Query())
+	c.Assert(err, IsNil)
+	c.Assert(count, Equals, 1)
+}
+
+func (s *MongoSuite) TestWithTransactionAbort(c *C) {
+	store := NewEventsFixtureStore(s.db)
+	c.Assert(s.db.C("event").Create(&mgo.CollectionInfo{}), IsNil)
+
+	failure := errors.New("failure")
+	err := storable.WithTransaction(context.Background(), s.db, func(tx *storable.Tx) error {
+		txStore := store.InTx(tx)
+		if err := txStore.Insert(txStore.New()); err != nil {
+			return err
+		}
+
+		count, err := txStore.Count(txStore.Query())
+		if err != nil {
+			return err
+		}
+
+		c.Assert(count, Equals, 1)
+		return failure
+	})
+
+	s.skipWithoutTransactions(c, err)
+	c.Assert(err, Equals, failure)
+
+	count, err := store.Count(store.Query())
+	c.Assert(err, IsNil)
+	c.Assert(count, Equals, 0)
+}
+
+func (s *MongoSuite) TestWithTransactionFindBatches(c *C) {
+	store := NewEventsFixtureStore(s.db)
+	c.Assert(s.db.C("event").Create(&mgo.CollectionInfo{}), IsNil)
+	for i := 0; i < 5; i++ {
+		c.Assert(store.Insert(store.New()), IsNil)
+	}
+
+	err := storable.WithTransaction(context.Background(), s.db, func(tx *storable.Tx) error {
+		txStore := store.InTx(tx)
+		q := txStore.Query()
+		q.BatchSize(2)
+
+		rs, err := txStore.Find(q)
+		if err != nil {
+			return err
+		}
+
+		docs, err := rs.All()
+		if err != nil {
+			return err
+		}
+
+		c.Assert(docs, HasLen, 5)
+		return nil
+	})
+
+	s.skipWithoutTransactions(c, err)
+	c.Assert(err, IsNil)
+}
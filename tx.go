@@ -0,0 +1,320 @@
+package storable
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"io"
+	"time"
+
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+var (
+	// ErrTxFinished is returned when a transaction is used after being
+	// committed or aborted
+	ErrTxFinished = errors.New("The transaction is already finished.")
+)
+
+// MaxTransactionRetryTime is the time after which WithTransaction stops
+// retrying a transaction failed with a transient error.
+var MaxTransactionRetryTime = 120 * time.Second
+
+const (
+	// txRetryBackoff is the wait before the first retry of a transaction,
+	// doubled on every retry up to maxTxRetryBackoff.
+	txRetryBackoff    = 10 * time.Millisecond
+	maxTxRetryBackoff = time.Second
+)
+
+const (
+	// transientTxLabel is the error label of the errors aborting a
+	// transaction without being caused by its operations, as a write
+	// conflict, the transaction can be retried.
+	transientTxLabel = "TransientTransactionError"
+	// unknownCommitLabel is the error label of a commit that may have been
+	// applied, the commit can be retried.
+	unknownCommitLabel = "UnknownTransactionCommitResult"
+)
+
+// Tx is a multi-document transaction, see WithTransaction. The operations
+// of a store bound to the transaction with Store.InTx are committed or
+// aborted together. Transactions require a replica set or a sharded cluster
+// with MongoDB 4.0 or above.
+//
+// The transactions are experimental, mgo does not support the sessions, so
+// the session protocol is implemented on top of the database commands.
+type Tx struct {
+	ctx       context.Context
+	session   *mgo.Session
+	lsid      bson.M
+	txnNumber int64
+	started   bool
+	finished  bool
+	committed []func() error
+
+	// failed is the error of the last failed command, with the error labels
+	// of its reply, not returned by mgo
+	failed error
+	labels []string
+}
+
+// Context returns the context given to WithTransaction.
+func (tx *Tx) Context() context.Context {
+	return tx.ctx
+}
+
+// WithTransaction runs fn inside a transaction, committed if fn returns no
+// error and aborted otherwise. The whole transaction is retried if it fails
+// with a network error or an error labeled by the server as transient, as a
+// write conflict or a primary step down, so fn may be called more than once
+// and should not have other side effects:
+//
+//	err := storable.WithTransaction(ctx, db, func(tx *storable.Tx) error {
+//		if err := orders.InTx(tx).Insert(order); err != nil {
+//			return err
+//		}
+//
+//		return stock.InTx(tx).Update(item)
+//	})
+//
+// The retries stop when the context is done or after MaxTransactionRetryTime.
+func WithTransaction(ctx context.Context, db *mgo.Database, fn func(tx *Tx) error) error {
+	sess := db.Session.Copy()
+	defer sess.Close()
+	sess.SetMode(mgo.Primary, true)
+
+	lsid, err := newSessionId()
+	if err != nil {
+		return err
+	}
+
+	defer endSession(sess, lsid)
+
+	start := time.Now()
+	var backoff time.Duration
+	retrying := func() bool {
+		if ctx.Err() != nil || time.Since(start) >= MaxTransactionRetryTime {
+			return false
+		}
+
+		// the session keeps its socket, broken after a network error
+		sess.Refresh()
+		backoff = nextBackoff(backoff)
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(backoff):
+			return true
+		}
+	}
+
+	var txnNumber int64
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		txnNumber++
+		tx := &Tx{ctx: ctx, session: sess, lsid: lsid, txnNumber: txnNumber}
+		if err := fn(tx); err != nil {
+			tx.abort()
+			if tx.isTransient(err) && retrying() {
+				continue
+			}
+
+			return err
+		}
+
+		err := tx.commit()
+		for err != nil && tx.isUnknownCommit(err) && retrying() {
+			err = tx.commitCmd()
+		}
+
+		if err != nil && tx.isTransient(err) && retrying() {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return tx.runCommitted()
+	}
+}
+
+// nextBackoff returns the wait before the next retry of a transaction.
+func nextBackoff(backoff time.Duration) time.Duration {
+	if backoff == 0 {
+		return txRetryBackoff
+	}
+
+	if backoff *= 2; backoff > maxTxRetryBackoff {
+		return maxTxRetryBackoff
+	}
+
+	return backoff
+}
+
+// newSessionId returns a logical session id, a random UUID.
+func newSessionId() (bson.M, error) {
+	id := make([]byte, 16)
+	if _, err := io.ReadFull(rand.Reader, id); err != nil {
+		return nil, err
+	}
+
+	id[6] = (id[6] & 0x0f) | 0x40
+	id[8] = (id[8] & 0x3f) | 0x80
+	return bson.M{"id": bson.Binary{Kind: 0x04, Data: id}}, nil
+}
+
+func endSession(sess *mgo.Session, lsid bson.M) {
+	sess.DB("admin").Run(bson.D{{Name: "endSessions", Value: []bson.M{lsid}}}, nil)
+}
+
+// run runs the given command inside the transaction, the first command
+// starts the transaction.
+func (tx *Tx) run(db *mgo.Database, cmd bson.D, result interface{}) error {
+	if tx.finished {
+		return ErrTxFinished
+	}
+
+	if err := tx.ctx.Err(); err != nil {
+		return err
+	}
+
+	cmd = append(cmd, tx.fields()...)
+	if !tx.started {
+		cmd = append(cmd, bson.DocElem{Name: "startTransaction", Value: true})
+		tx.started = true
+	}
+
+	return tx.runCmd(db.Name, cmd, result)
+}
+
+// runCmd runs the given command on the database with the given name, the
+// error labels of the reply are recorded if it fails.
+func (tx *Tx) runCmd(db string, cmd bson.D, result interface{}) error {
+	var reply bson.Raw
+	if err := tx.session.DB(db).Run(cmd, &reply); err != nil {
+		tx.failed, tx.labels = err, replyLabels(reply)
+		return err
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	return reply.Unmarshal(result)
+}
+
+// replyLabels returns the error labels of a command reply, mgo unmarshals
+// the reply before returning its error.
+func replyLabels(reply bson.Raw) []string {
+	var r struct {
+		Labels []string `bson:"errorLabels"`
+	}
+
+	if reply.Kind != 0x03 || reply.Unmarshal(&r) != nil {
+		return nil
+	}
+
+	return r.Labels
+}
+
+func (tx *Tx) fields() bson.D {
+	return bson.D{
+		{Name: "lsid", Value: tx.lsid},
+		{Name: "txnNumber", Value: tx.txnNumber},
+		{Name: "autocommit", Value: false},
+	}
+}
+
+// afterCommit adds a function called once the transaction is committed, as
+// the writes of the oplog entries, see Store.EnableOplog.
+func (tx *Tx) afterCommit(fn func() error) {
+	tx.committed = append(tx.committed, fn)
+}
+
+func (tx *Tx) runCommitted() error {
+	for _, fn := range tx.committed {
+		if err := fn(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// commit commits the transaction, nothing is done if it was not started.
+func (tx *Tx) commit() error {
+	if tx.finished {
+		return ErrTxFinished
+	}
+
+	tx.finished = true
+	if !tx.started {
+		return nil
+	}
+
+	return tx.commitCmd()
+}
+
+func (tx *Tx) commitCmd() error {
+	cmd := append(bson.D{{Name: "commitTransaction", Value: 1}}, tx.fields()...)
+	return tx.runCmd("admin", cmd, nil)
+}
+
+// abort aborts the transaction, nothing is done if it was not started.
+func (tx *Tx) abort() error {
+	if tx.finished {
+		return ErrTxFinished
+	}
+
+	tx.finished = true
+	if !tx.started {
+		return nil
+	}
+
+	cmd := append(bson.D{{Name: "abortTransaction", Value: 1}}, tx.fields()...)
+	return tx.session.DB("admin").Run(cmd, nil)
+}
+
+// isTransient returns if the transaction failed with the given error can be
+// retried, the network errors are transient.
+func (tx *Tx) isTransient(err error) bool {
+	return isNetworkError(err) || tx.hasLabel(err, transientTxLabel)
+}
+
+// isUnknownCommit returns if the commit failed with the given error can be
+// retried, the network errors may happen after the commit is applied.
+func (tx *Tx) isUnknownCommit(err error) bool {
+	return isNetworkError(err) || tx.hasLabel(err, unknownCommitLabel)
+}
+
+// hasLabel returns if the given error is the one of the last failed command
+// of the transaction, with the given error label.
+func (tx *Tx) hasLabel(err error, label string) bool {
+	if err == nil || err != tx.failed {
+		return false
+	}
+
+	return containsString(tx.labels, label)
+}
+
+func isNetworkError(err error) bool {
+	return err == io.EOF || err == io.ErrUnexpectedEOF
+}
+
+func errorCode(err error) (int, bool) {
+	switch e := err.(type) {
+	case *mgo.QueryError:
+		return e.Code, true
+	case *mgo.LastError:
+		return e.Code, true
+	}
+
+	return 0, false
+}
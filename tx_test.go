@@ -0,0 +1,130 @@
+package storable
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	. "gopkg.in/check.v1"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+func (s *BaseSuite) TestNewSessionId(c *C) {
+	lsid, err := newSessionId()
+	c.Assert(err, IsNil)
+
+	id, ok := lsid["id"].(bson.Binary)
+	c.Assert(ok, Equals, true)
+	c.Assert(id.Kind, Equals, byte(0x04))
+	c.Assert(id.Data, HasLen, 16)
+	c.Assert(id.Data[6]>>4, Equals, byte(4))
+
+	other, err := newSessionId()
+	c.Assert(err, IsNil)
+	c.Assert(other, Not(DeepEquals), lsid)
+}
+
+func (s *BaseSuite) TestTx_IsTransient(c *C) {
+	conflict := &mgo.QueryError{Code: 112}
+	tx := &Tx{failed: conflict, labels: []string{transientTxLabel}}
+	c.Assert(tx.isTransient(conflict), Equals, true)
+	c.Assert(tx.isTransient(&mgo.QueryError{Code: 112}), Equals, false)
+	c.Assert(tx.isTransient(io.EOF), Equals, true)
+	c.Assert(tx.isTransient(errors.New("foo")), Equals, false)
+	c.Assert(tx.isUnknownCommit(conflict), Equals, false)
+
+	tx.labels = []string{unknownCommitLabel}
+	c.Assert(tx.isUnknownCommit(conflict), Equals, true)
+	c.Assert(tx.isUnknownCommit(io.EOF), Equals, true)
+	c.Assert(tx.isTransient(conflict), Equals, false)
+}
+
+func (s *BaseSuite) TestReplyLabels(c *C) {
+	data, err := bson.Marshal(bson.M{
+		"ok":          0,
+		"code":        112,
+		"errorLabels": []string{transientTxLabel},
+	})
+	c.Assert(err, IsNil)
+
+	c.Assert(replyLabels(bson.Raw{Kind: 0x03, Data: data}), DeepEquals, []string{transientTxLabel})
+	c.Assert(replyLabels(bson.Raw{}), HasLen, 0)
+}
+
+func (s *BaseSuite) TestNextBackoff(c *C) {
+	c.Assert(nextBackoff(0), Equals, txRetryBackoff)
+	c.Assert(nextBackoff(txRetryBackoff), Equals, 2*txRetryBackoff)
+	c.Assert(nextBackoff(maxTxRetryBackoff), Equals, maxTxRetryBackoff)
+}
+
+func (s *BaseSuite) TestTx_NotStarted(c *C) {
+	tx := &Tx{ctx: context.Background()}
+	c.Assert(tx.commit(), IsNil)
+	c.Assert(tx.commit(), Equals, ErrTxFinished)
+	c.Assert(tx.abort(), Equals, ErrTxFinished)
+	c.Assert(tx.run(nil, bson.D{}, nil), Equals, ErrTxFinished)
+}
+
+func (s *BaseSuite) TestTx_Fields(c *C) {
+	lsid := bson.M{"id": "foo"}
+	tx := &Tx{lsid: lsid, txnNumber: 2}
+	c.Assert(tx.fields(), DeepEquals, bson.D{
+		{Name: "lsid", Value: lsid},
+		{Name: "txnNumber", Value: int64(2)},
+		{Name: "autocommit", Value: false},
+	})
+}
+
+func (s *BaseSuite) TestStore_InTx(c *C) {
+	st := NewStore(s.db, "test")
+	tx := &Tx{}
+
+	bound := st.InTx(tx)
+	c.Assert(bound.Tx(), Equals, tx)
+	c.Assert(st.Tx(), IsNil)
+
+	_, err := bound.Tail(NewBaseQuery(), 0)
+	c.Assert(err, Equals, ErrTailInTx)
+}
+
+func (s *BaseSuite) TestWithTransactionRetry(c *C) {
+	var calls int
+	err := WithTransaction(context.Background(), s.db, func(tx *Tx) error {
+		calls++
+		c.Assert(tx.txnNumber, Equals, int64(calls))
+		if calls == 1 {
+			return io.ErrUnexpectedEOF
+		}
+
+		return nil
+	})
+
+	c.Assert(err, IsNil)
+	c.Assert(calls, Equals, 2)
+}
+
+func (s *BaseSuite) TestWithTransactionError(c *C) {
+	failure := errors.New("failure")
+
+	var calls int
+	err := WithTransaction(context.Background(), s.db, func(tx *Tx) error {
+		calls++
+		return failure
+	})
+
+	c.Assert(err, Equals, failure)
+	c.Assert(calls, Equals, 1)
+}
+
+func (s *BaseSuite) TestWithTransactionContext(c *C) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := WithTransaction(ctx, s.db, func(tx *Tx) error {
+		c.Fatal("should not be called")
+		return nil
+	})
+
+	c.Assert(err, Equals, context.Canceled)
+}
@@ -0,0 +1,447 @@
+package storable
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/mgo.v2/bson"
+	"gopkg.in/src-d/storable.v1/operators/expr"
+)
+
+var (
+	// ErrUnknownPath the query references a path not present on the schema.
+	ErrUnknownPath = errors.New("unknown path")
+	// ErrIncompatibleValue the type of a value conflicts with the field type.
+	ErrIncompatibleValue = errors.New("incompatible value")
+)
+
+// ValidationError is returned by Query.Validate pointing to the part of the
+// query not matching the schema.
+type ValidationError struct {
+	// Part of the query where the error was found: criteria, default scope,
+	// sort or select.
+	Part string
+	// Clause is the index of the offending criteria on the query, as were
+	// added with AddCriteria, or on the criteria of the default scopes, or
+	// the index on the sort or select.
+	Clause int
+	// Path of the field.
+	Path string
+	// Value is the offending value, if any.
+	Value interface{}
+	// Err is the cause of the error, ErrUnknownPath or ErrIncompatibleValue.
+	Err error
+}
+
+func (e *ValidationError) Error() string {
+	msg := fmt.Sprintf("%s %d: %s %q", e.Part, e.Clause, e.Err, e.Path)
+	if e.Err == ErrIncompatibleValue {
+		msg += fmt.Sprintf(": %#v", e.Value)
+	}
+
+	return msg
+}
+
+// SchemaFields returns the fields and maps of a schema, usually the schema of
+// a model from the generated Schema variable, eg: `Schema.Product`, or a
+// *ModelInfo from the registry.
+func SchemaFields(schema interface{}) (fields []Field, maps []Map) {
+	if m, ok := schema.(*ModelInfo); ok {
+		schema = m.Schema
+	}
+
+	collectSchemaFields(reflect.ValueOf(schema), &fields, &maps)
+	return
+}
+
+var (
+	fieldType = reflect.TypeOf(Field{})
+	mapType   = reflect.TypeOf(Map{})
+)
+
+func collectSchemaFields(v reflect.Value, fields *[]Field, maps *[]Map) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+
+		v = v.Elem()
+	}
+
+	switch {
+	case v.Kind() != reflect.Struct:
+		return
+	case v.Type() == fieldType:
+		*fields = append(*fields, v.Interface().(Field))
+		return
+	case v.Type() == mapType:
+		*maps = append(*maps, v.Interface().(Map))
+		return
+	}
+
+	for i := 0; i < v.NumField(); i++ {
+		if v.Type().Field(i).PkgPath == "" {
+			collectSchemaFields(v.Field(i), fields, maps)
+		}
+	}
+}
+
+// Validate checks that the criteria, sort and select of the query only
+// reference paths present on the given schema, see SchemaFields, and that the
+// values used on the criteria are compatible with the type of the fields. The
+// returned error, if any, is a *ValidationError.
+func (q *BaseQuery) Validate(schema interface{}) error {
+	v := newValidator(schema)
+	if q.scoped() {
+		for i, c := range q.defaults.clauses {
+			if err := v.validateCriteria(c); err != nil {
+				err.Part, err.Clause = "default scope", i
+				return err
+			}
+		}
+	}
+
+	for i, c := range q.clauses {
+		if err := v.validateCriteria(c); err != nil {
+			err.Part, err.Clause = "criteria", i
+			return err
+		}
+	}
+
+	for i, fs := range q.GetSort() {
+		if fs.D == TextScore {
+			continue
+		}
+
+		if err := v.validatePath(fs.F.String()); err != nil {
+			err.Part, err.Clause = "sort", i
+			return err
+		}
+	}
+
+	for i, fs := range q.selector {
+		if err := v.validatePath(fs.F.String()); err != nil {
+			err.Part, err.Clause = "select", i
+			return err
+		}
+	}
+
+	for i, p := range q.projections {
+		for path, value := range p {
+			if isTextScoreMeta(value) {
+				continue
+			}
+
+			if err := v.validatePath(path); err != nil {
+				err.Part, err.Clause = "select", len(q.selector)+i
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+type validator struct {
+	fields map[string]string
+	maps   []Map
+}
+
+func newValidator(schema interface{}) *validator {
+	fields, maps := SchemaFields(schema)
+
+	v := &validator{
+		fields: map[string]string{
+			IdField.String():   IdField.Type(),
+			KindField.String(): KindField.Type(),
+		},
+		maps: maps,
+	}
+
+	for _, f := range fields {
+		v.fields[f.String()] = f.Type()
+	}
+
+	return v
+}
+
+// lookup returns the type of the field with the given path, the type is empty
+// if the path is a valid path of unknown type, eg: a struct.
+func (v *validator) lookup(path string) (typ string, ok bool) {
+	path = normalizePath(path)
+	if typ, ok := v.fields[path]; ok {
+		return typ, true
+	}
+
+	for _, m := range v.maps {
+		if matchMapPath(m.bson, path) {
+			return m.Type(), true
+		}
+	}
+
+	prefix := path + "."
+	for p := range v.fields {
+		if strings.HasPrefix(p, prefix) {
+			return "", true
+		}
+	}
+
+	for _, m := range v.maps {
+		if strings.HasPrefix(m.bson, prefix) {
+			return "", true
+		}
+	}
+
+	return "", false
+}
+
+// normalizePath removes the array indexes and positional operators from the
+// path, eg: `items.0.name` or `items.$.name` becomes `items.name`.
+func normalizePath(path string) string {
+	parts := strings.Split(path, ".")
+	normalized := parts[:0]
+	for _, p := range parts {
+		if _, err := strconv.Atoi(p); err == nil || strings.HasPrefix(p, "$") {
+			continue
+		}
+
+		normalized = append(normalized, p)
+	}
+
+	return strings.Join(normalized, ".")
+}
+
+func matchMapPath(pattern, path string) bool {
+	patterns := strings.Split(pattern, ".")
+	parts := strings.Split(path, ".")
+	if len(patterns) != len(parts) {
+		return false
+	}
+
+	for i, p := range patterns {
+		if p != mapPlaceholder && p != parts[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (v *validator) validatePath(path string) *ValidationError {
+	if _, ok := v.lookup(path); !ok {
+		return &ValidationError{Path: path, Err: ErrUnknownPath}
+	}
+
+	return nil
+}
+
+func (v *validator) validateCriteria(c bson.M) *ValidationError {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+	for _, key := range keys {
+		if err := v.validateElem(key, c[key]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (v *validator) validateElem(key string, value interface{}) *ValidationError {
+	switch key {
+	case "$and", "$or", "$nor":
+		return v.validateClauses(value)
+	case "$expr":
+		return v.validateExpr(value)
+	}
+
+	if strings.HasPrefix(key, "$") {
+		// top-level operators not referencing fields, eg: $text or $where
+		return nil
+	}
+
+	typ, ok := v.lookup(key)
+	if !ok {
+		return &ValidationError{Path: key, Err: ErrUnknownPath}
+	}
+
+	return v.validateValue(key, typ, value)
+}
+
+func (v *validator) validateClauses(clauses interface{}) *ValidationError {
+	rv := reflect.ValueOf(clauses)
+	if rv.Kind() != reflect.Slice {
+		return nil
+	}
+
+	for i := 0; i < rv.Len(); i++ {
+		if c, ok := asDocument(rv.Index(i).Interface()); ok {
+			if err := v.validateCriteria(c); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateExpr checks the field references of an aggregation expression.
+func (v *validator) validateExpr(value interface{}) *ValidationError {
+	if expr.IsRef(value) {
+		return v.validatePath(value.(string)[1:])
+	}
+
+	if doc, ok := asDocument(value); ok {
+		for op, arg := range doc {
+			if op == "$literal" {
+				continue
+			}
+
+			if err := v.validateExpr(arg); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.Slice {
+		for i := 0; i < rv.Len(); i++ {
+			if err := v.validateExpr(rv.Index(i).Interface()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (v *validator) validateValue(path, typ string, value interface{}) *ValidationError {
+	if doc, ok := asDocument(value); ok && isOperatorDocument(doc) {
+		for op, arg := range doc {
+			if err := v.validateOperator(path, typ, op, arg); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if !isCompatible(typ, value) {
+		return &ValidationError{Path: path, Value: value, Err: ErrIncompatibleValue}
+	}
+
+	return nil
+}
+
+func (v *validator) validateOperator(path, typ, op string, arg interface{}) *ValidationError {
+	switch op {
+	case "$eq", "$ne", "$gt", "$gte", "$lt", "$lte", "$not":
+		return v.validateValue(path, typ, arg)
+	case "$in", "$nin", "$all":
+		rv := reflect.ValueOf(arg)
+		if rv.Kind() != reflect.Slice {
+			break
+		}
+
+		for i := 0; i < rv.Len(); i++ {
+			if err := v.validateValue(path, typ, rv.Index(i).Interface()); err != nil {
+				return err
+			}
+		}
+	case "$regex":
+		if !isCompatible(typ, bson.RegEx{}) {
+			return &ValidationError{Path: path, Value: arg, Err: ErrIncompatibleValue}
+		}
+	}
+
+	return nil
+}
+
+func asDocument(v interface{}) (bson.M, bool) {
+	switch doc := v.(type) {
+	case bson.M:
+		return doc, true
+	case map[string]interface{}:
+		return bson.M(doc), true
+	case bson.D:
+		return doc.Map(), true
+	}
+
+	return nil, false
+}
+
+func isOperatorDocument(doc bson.M) bool {
+	for key := range doc {
+		if !strings.HasPrefix(key, "$") {
+			return false
+		}
+	}
+
+	return len(doc) != 0
+}
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	objectIdType = reflect.TypeOf(bson.ObjectId(""))
+	regExType    = reflect.TypeOf(bson.RegEx{})
+)
+
+// isCompatible returns if the value can be stored, or compared, with a field
+// of the given type, the types are the ones returned by Field.Type.
+func isCompatible(typ string, value interface{}) bool {
+	if value == nil {
+		return true
+	}
+
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.Slice && rv.Type() != reflect.TypeOf([]byte(nil)) {
+		// array fields are matched by its elements or by the whole array
+		for i := 0; i < rv.Len(); i++ {
+			if !isCompatible(typ, rv.Index(i).Interface()) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	rt := rv.Type()
+	switch typ {
+	case "string":
+		return (rt.Kind() == reflect.String && rt != objectIdType) || rt == regExType
+	case "bool":
+		return rt.Kind() == reflect.Bool
+	case "int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64",
+		"float32", "float64":
+		return isNumber(rt.Kind())
+	case "time.Time":
+		return rt == timeType
+	case "bson.ObjectId":
+		return rt == objectIdType
+	}
+
+	return true
+}
+
+func isNumber(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,179 @@
+package storable
+
+import (
+	"time"
+
+	. "gopkg.in/check.v1"
+	"gopkg.in/mgo.v2/bson"
+	"gopkg.in/src-d/storable.v1/operators"
+	"gopkg.in/src-d/storable.v1/operators/expr"
+)
+
+type schemaPerson struct {
+	FirstName Field
+	Age       Field
+	CreatedAt Field
+	Owner     Field
+	Tags      Field
+	Address   *schemaPersonAddress
+	Meta      Map
+}
+
+type schemaPersonAddress struct {
+	Street Field
+	Number Field
+}
+
+var personSchema = &schemaPerson{
+	FirstName: NewField("firstname", "string"),
+	Age:       NewField("age", "int"),
+	CreatedAt: NewField("createdat", "time.Time"),
+	Owner:     NewField("owner", "bson.ObjectId"),
+	Tags:      NewField("tags", "string"),
+	Address: &schemaPersonAddress{
+		Street: NewField("address.street", "string"),
+		Number: NewField("address.number", "int"),
+	},
+	Meta: NewMap("meta.[map]", "string"),
+}
+
+func (s *BaseSuite) TestSchemaFields(c *C) {
+	fields, maps := SchemaFields(personSchema)
+	c.Assert(fields, HasLen, 7)
+	c.Assert(fields[6], Equals, personSchema.Address.Number)
+	c.Assert(maps, DeepEquals, []Map{personSchema.Meta})
+
+	fields, _ = SchemaFields(&ModelInfo{Schema: personSchema})
+	c.Assert(fields, HasLen, 7)
+}
+
+func (s *BaseSuite) TestBaseQuery_Validate(c *C) {
+	q := NewBaseQuery()
+	q.AddCriteria(operators.Eq(IdField, bson.NewObjectId()))
+	q.AddCriteria(operators.Eq(personSchema.FirstName, "foo"))
+	q.AddCriteria(operators.Gt(personSchema.Age, 18))
+	q.AddCriteria(operators.Lt(personSchema.Age, 65.5))
+	q.AddCriteria(operators.Lt(personSchema.CreatedAt, time.Now()))
+	q.AddCriteria(operators.In(personSchema.Owner, bson.NewObjectId(), nil))
+	q.AddCriteria(operators.All(personSchema.Tags, "foo", "bar"))
+	q.AddCriteria(bson.M{"tags": []string{"foo", "bar"}})
+	q.AddCriteria(bson.M{"tags.0": "foo"})
+	q.AddCriteria(operators.RegEx(personSchema.Address.Street, "^foo", ""))
+	q.AddCriteria(operators.Exists(personSchema.Address.Number, true))
+	q.AddCriteria(bson.M{"address": bson.M{"street": "foo", "number": 1}})
+	q.AddCriteria(operators.Eq(personSchema.Meta.Key("foo"), "bar"))
+	q.AddCriteria(operators.Or(
+		operators.Eq(personSchema.FirstName, "foo"),
+		operators.Not(operators.Eq(personSchema.Age, 42)),
+	))
+	q.AddCriteria(bson.M{"$text": bson.M{"$search": "foo"}})
+	q.Sort(Sort{{personSchema.Address.Street, Asc}})
+	q.Select(Select{{personSchema.Meta.Key("foo"), Include}})
+
+	c.Assert(q.Validate(personSchema), IsNil)
+}
+
+func (s *BaseSuite) TestBaseQuery_ValidateExpr(c *C) {
+	q := NewBaseQuery()
+	q.AddCriteria(operators.Expr(expr.And(
+		expr.Gt(expr.Ref(personSchema.Age), expr.Ref(personSchema.Address.Number)),
+		expr.Eq(expr.Year(expr.Ref(personSchema.CreatedAt)), 2016),
+		expr.Ne(expr.Ref(personSchema.FirstName), expr.Literal("$foo")),
+	)))
+	c.Assert(q.Validate(personSchema), IsNil)
+
+	q.AddCriteria(operators.Expr(expr.Gt(expr.Ref(personSchema.Age), "$address.foo")))
+	c.Assert(q.Validate(personSchema), ErrorMatches, `criteria 1: unknown path "address.foo"`)
+}
+
+func (s *BaseSuite) TestBaseQuery_ValidateUnknownPath(c *C) {
+	q := NewBaseQuery()
+	q.AddCriteria(operators.Eq(personSchema.FirstName, "foo"))
+	q.AddCriteria(operators.Or(
+		operators.Eq(personSchema.Age, 42),
+		operators.Eq(NewField("lastname", "string"), "foo"),
+	))
+
+	err := q.Validate(personSchema)
+	c.Assert(err, DeepEquals, &ValidationError{
+		Part: "criteria", Clause: 1, Path: "lastname", Err: ErrUnknownPath,
+	})
+
+	c.Assert(err, ErrorMatches, `criteria 1: unknown path "lastname"`)
+}
+
+func (s *BaseSuite) TestBaseQuery_ValidateDefaultScope(c *C) {
+	defaults := NewBaseQuery()
+	defaults.AddCriteria(operators.Eq(personSchema.Age, 42))
+
+	q := NewBaseQuery()
+	q.SetDefaultScope(defaults)
+	q.AddCriteria(operators.Eq(personSchema.FirstName, "foo"))
+	q.AddCriteria(operators.Eq(NewField("lastname", "string"), "foo"))
+	c.Assert(q.Validate(personSchema), DeepEquals, &ValidationError{
+		Part: "criteria", Clause: 1, Path: "lastname", Err: ErrUnknownPath,
+	})
+
+	defaults.AddCriteria(operators.Eq(NewField("deleted", "bool"), false))
+	c.Assert(q.Validate(personSchema), ErrorMatches, `default scope 1: unknown path "deleted"`)
+
+	q.Unscoped()
+	c.Assert(q.Validate(personSchema), ErrorMatches, `criteria 1: unknown path "lastname"`)
+}
+
+func (s *BaseSuite) TestBaseQuery_ValidateSortAndSelect(c *C) {
+	q := NewBaseQuery()
+	q.Sort(Sort{{personSchema.Age, Asc}, {NewField("address.foo", ""), Desc}})
+	c.Assert(q.Validate(personSchema), ErrorMatches, `sort 1: unknown path "address.foo"`)
+
+	q = NewBaseQuery()
+	q.Select(Select{{NewField("foo", ""), Include}})
+	c.Assert(q.Validate(personSchema), ErrorMatches, `select 0: unknown path "foo"`)
+
+	q = NewBaseQuery()
+	q.Sort(Sort{{NewField("score", ""), TextScore}})
+	c.Assert(q.Validate(personSchema), IsNil)
+}
+
+func (s *BaseSuite) TestBaseQuery_ValidateIncompatibleValue(c *C) {
+	for i, criteria := range []bson.M{
+		operators.Eq(personSchema.CreatedAt, "2016-01-02"),
+		operators.Gt(personSchema.Age, "18"),
+		operators.Eq(personSchema.FirstName, 42),
+		operators.Eq(personSchema.Owner, "56f6a0a67edfc6d4ad4a3e35"),
+		operators.In(personSchema.Address.Number, 1, "2"),
+		operators.RegEx(personSchema.Age, "^4", ""),
+		bson.M{"tags": []interface{}{"foo", 42}},
+		operators.Eq(personSchema.Meta.Key("foo"), true),
+	} {
+		q := NewBaseQuery()
+		q.AddCriteria(criteria)
+
+		err := q.Validate(personSchema)
+		c.Assert(err, NotNil, Commentf("%d", i))
+		c.Assert(err.(*ValidationError).Err, Equals, ErrIncompatibleValue, Commentf("%d", i))
+	}
+
+	q := NewBaseQuery()
+	q.AddCriteria(operators.Eq(personSchema.CreatedAt, "2016-01-02"))
+	c.Assert(q.Validate(personSchema), ErrorMatches,
+		`criteria 0: incompatible value "createdat": "2016-01-02"`,
+	)
+}
+
+func (s *BaseSuite) TestStore_SetStrict(c *C) {
+	st := NewStore(s.db, "people")
+	st.SetStrict(personSchema)
+
+	q := NewBaseQuery()
+	q.AddCriteria(operators.Eq(NewField("foo", ""), 42))
+
+	_, err := st.Find(q)
+	c.Assert(err, ErrorMatches, `criteria 0: unknown path "foo"`)
+
+	_, err = st.Count(q)
+	c.Assert(err, NotNil)
+
+	c.Assert(st.RawUpdate(q, bson.M{"foo": 1}, false), NotNil)
+	c.Assert(st.RawDelete(q, false), NotNil)
+}